@@ -4,18 +4,26 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/knadh/koanf/providers/env"
 	"github.com/knadh/koanf/providers/structs"
 	"github.com/knadh/koanf/v2"
 	flag "github.com/spf13/pflag"
 
+	"go.breu.io/quantm/internal/core/notify"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/hooks/email"
+	"go.breu.io/quantm/internal/hooks/gitea"
 	"go.breu.io/quantm/internal/hooks/github"
+	"go.breu.io/quantm/internal/hooks/gitlab"
+	"go.breu.io/quantm/internal/hooks/local"
 	"go.breu.io/quantm/internal/hooks/slack"
+	"go.breu.io/quantm/internal/hooks/teams"
 	"go.breu.io/quantm/internal/nomad"
 	"go.breu.io/quantm/internal/pulse"
+	"go.breu.io/quantm/internal/pulse/migrations"
 )
 
 type (
@@ -24,25 +32,50 @@ type (
 	Config struct {
 		DB      *db.Config      `koanf:"DB" json:"db"`           // Configuration for the database.
 		Durable *durable.Config `koanf:"DURABLE" json:"durable"` // Configuration for the durable.
-		Pulse   *pulse.Config   `koanf:"PULSE" json:"pulse"`     // Configuration for the pulse.
+		Pulse   *pulse.Config   `koanf:"PULSE" json:"pulse"`     // Configuration for the pulse, PULSE__SINK selects the sink.
 		Nomad   *nomad.Config   `koanf:"NOMAD" json:"nomad"`     // Configuration for Nomad.
 		Github  *github.Config  `koanf:"GITHUB" json:"github"`   // Configuration for the github.
-		Slack   *slack.Config   `koanf:"SLACK" json:"slack"`     // Configuration for the slack.
+		Gitea   *gitea.Config   `koanf:"GITEA" json:"gitea"`     // Configuration for the gitea, optional.
+		Gitlab  *gitlab.Config  `koanf:"GITLAB" json:"gitlab"`   // Configuration for the gitlab, optional.
+		Local   *local.Config   `koanf:"LOCAL" json:"local"`     // Configuration for the local bare repos, simulate mode only.
+		Slack   *slack.Config   `koanf:"SLACK" json:"slack"`     // Configuration for the slack, optional.
+		Teams   *teams.Config   `koanf:"TEAMS" json:"teams"`     // Configuration for the microsoft teams.
+		Email   *email.Config   `koanf:"EMAIL" json:"email"`     // Configuration for the smtp server, optional.
+		Notify  *notify.Config  `koanf:"NOTIFY" json:"notify"`   // Configuration for the limits of the notifications.
 
 		Secret  string `koanf:"SECRET" json:"secret"`   // Secret key for JWE.
 		Debug   bool   `koanf:"DEBUG" json:"debug"`     // Flag to enable debug mode.
 		Migrate bool   `koanf:"MIGRATE" json:"migrate"` // Flag to enable database migration.
 
+		// Migration is the migration to run on the pulse tables of the orgs in migrate mode, i.e. up, down or status. The
+		// migrations of the database always run up before it.
+		Migration migrations.Command `koanf:"MIGRATION" json:"migration"`
+
+		// Replay selects the events signalled again in replay mode.
+		Replay *Replay `koanf:"REPLAY" json:"replay"`
+
 		Mode Mode `koanf:"MODE" json:"mode"`
 	}
+
+	// Replay selects the persisted events of a repo, from inclusive, to exclusive, in RFC3339. A dry run reports the
+	// decisions of the workflows, waiting for settle after the last one, without acting on them.
+	Replay struct {
+		Repo   string        `koanf:"REPO" json:"repo"`
+		From   string        `koanf:"FROM" json:"from"`
+		To     string        `koanf:"TO" json:"to"`
+		DryRun bool          `koanf:"DRY_RUN" json:"dry_run"`
+		Settle time.Duration `koanf:"SETTLE" json:"settle"`
+	}
 )
 
 const (
-	ModeMigrate Mode = "migrate"
-	ModeWebhook Mode = "webhook"
-	ModeGRPC    Mode = "grpc"
-	ModeWorkers Mode = "queues"
-	ModeDefault Mode = "default"
+	ModeMigrate  Mode = "migrate"
+	ModeReplay   Mode = "replay"
+	ModeWebhook  Mode = "webhook"
+	ModeGRPC     Mode = "grpc"
+	ModeWorkers  Mode = "queues"
+	ModeSimulate Mode = "simulate"
+	ModeDefault  Mode = "default"
 )
 
 func (c *Config) Load() {
@@ -51,7 +84,15 @@ func (c *Config) Load() {
 	c.Nomad = &nomad.DefaultConfig
 	c.Pulse = &pulse.DefaultConfig
 	c.Github = &github.Config{}
+	c.Gitlab = &gitlab.Config{URL: gitlab.DefaultURL}
+	c.Gitea = &gitea.Config{}
+	c.Local = &local.Config{}
 	c.Slack = &slack.Config{}
+	c.Teams = &teams.Config{LoginURL: teams.DefaultLoginURL, Scope: teams.DefaultScope}
+	c.Email = &email.Config{Port: email.DefaultPort}
+	c.Notify = &notify.DefaultConfig
+	c.Migration = migrations.CommandUp
+	c.Replay = &Replay{}
 
 	k := koanf.New("__")
 
@@ -84,23 +125,43 @@ func (c *Config) Parse() {
 	selected := ""
 
 	modes := map[string]Mode{
-		"migrate": ModeMigrate,
-		"webhook": ModeWebhook,
-		"grpc":    ModeGRPC,
-		"queues":  ModeWorkers,
+		"migrate":  ModeMigrate,
+		"replay":   ModeReplay,
+		"webhook":  ModeWebhook,
+		"grpc":     ModeGRPC,
+		"queues":   ModeWorkers,
+		"simulate": ModeSimulate,
 	}
 
 	flag.BoolVarP(&help, "help", "h", false, "show help message")
 
 	flags := map[string]*bool{
-		"migrate": flag.BoolP("migrate", "m", false, "run database migrations"),
-		"webhook": flag.BoolP("webhook", "w", false, "start webhook server"),
-		"grpc":    flag.BoolP("grpc", "g", false, "start gRPC server (nomad)"),
-		"queues":  flag.BoolP("queues", "q", false, "start queues worker"),
+		"migrate":  flag.BoolP("migrate", "m", false, "run database migrations, followed by up, down or status for the pulse tables"),
+		"replay":   flag.BoolP("replay", "r", false, "replay the persisted events of a repo, given by --repo, --from and --to"),
+		"webhook":  flag.BoolP("webhook", "w", false, "start webhook server"),
+		"grpc":     flag.BoolP("grpc", "g", false, "start gRPC server (nomad)"),
+		"queues":   flag.BoolP("queues", "q", false, "start queues worker"),
+		"simulate": flag.BoolP("simulate", "s", false, "start everything against local bare repos, without github"),
 	}
 
+	flag.StringVar(&c.Replay.Repo, "repo", c.Replay.Repo, "id of the repo to replay")
+	flag.StringVar(&c.Replay.From, "from", c.Replay.From, "replay the events from, in RFC3339")
+	flag.StringVar(&c.Replay.To, "to", c.Replay.To, "replay the events until, in RFC3339, now if empty")
+	flag.BoolVar(&c.Replay.DryRun, "dry-run", c.Replay.DryRun, "report the decisions of the replay without acting on them")
+	flag.DurationVar(&c.Replay.Settle, "settle", c.Replay.Settle, "time to wait for the decisions of a dry run after the last one")
+
 	flag.Parse()
 
+	// simulate is also accepted as a command, i.e. `quantm simulate`.
+	if flag.Arg(0) == string(ModeSimulate) {
+		*flags["simulate"] = true
+	}
+
+	// the migration is given as a command after the flag, i.e. `quantm -m status`.
+	if *flags["migrate"] && flag.NArg() > 0 {
+		c.Migration = migrations.Command(flag.Arg(0))
+	}
+
 	if help {
 		flag.Usage()
 		os.Exit(0)
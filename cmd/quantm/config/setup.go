@@ -9,10 +9,17 @@ import (
 	"go.breu.io/quantm/cmd/quantm/workers"
 	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/notify"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/hooks/email"
+	"go.breu.io/quantm/internal/hooks/gitea"
 	"go.breu.io/quantm/internal/hooks/github"
+	"go.breu.io/quantm/internal/hooks/gitlab"
+	"go.breu.io/quantm/internal/hooks/local"
 	"go.breu.io/quantm/internal/hooks/slack"
+	"go.breu.io/quantm/internal/hooks/teams"
+	"go.breu.io/quantm/internal/hooks/webhook"
 	"go.breu.io/quantm/internal/nomad"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 	"go.breu.io/quantm/internal/pulse"
@@ -20,10 +27,15 @@ import (
 
 const (
 	ServiceGithub     = "github"
+	ServiceGitlab     = "gitlab"
+	ServiceGitea      = "gitea"
+	ServiceLocal      = "local"
 	ServiceSlack      = "slack"
+	ServiceEmail      = "email"
 	ServiceKernel     = "kernel"
 	ServiceDB         = "db"
 	ServicePulse      = "pulse"
+	ServicePulseBatch = "pulse_batch"
 	ServiceDurable    = "durable"
 	ServiceWebhook    = "webhook"
 	ServiceNomad      = "nomad"
@@ -61,8 +73,25 @@ func (c *Config) Setup(app *graceful.Graceful) error {
 		workers.Core()
 		workers.Hooks()
 
-		app.Add(ServiceCoreQueue, durable.OnCore(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
-		app.Add(ServiceHooksQueue, durable.OnHooks(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
+		app.Add(ServicePulseBatch, pulse.Writer(), ServicePulse)
+		app.Add(ServiceCoreQueue, durable.OnCore(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse, ServicePulseBatch)
+		app.Add(ServiceHooksQueue, durable.OnHooks(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse, ServicePulseBatch)
+	case ModeSimulate:
+		if err := c.SetupSimulate(app); err != nil {
+			return err
+		}
+
+		workers.Core()
+		workers.Hooks()
+
+		webhook := NewWebhookServer()
+		webhook.POST("/webhooks/local", (&local.Webhook{}).Handler)
+
+		app.Add(ServiceWebhook, webhook, ServiceDurable)
+		app.Add(ServiceNomad, nomad.New(nomad.WithConfig(c.Nomad)), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
+		app.Add(ServicePulseBatch, pulse.Writer(), ServicePulse)
+		app.Add(ServiceCoreQueue, durable.OnCore(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse, ServicePulseBatch)
+		app.Add(ServiceHooksQueue, durable.OnHooks(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse, ServicePulseBatch)
 	case ModeDefault:
 		if err := c.SetupServices(app); err != nil {
 			return err
@@ -73,8 +102,9 @@ func (c *Config) Setup(app *graceful.Graceful) error {
 
 		app.Add(ServiceWebhook, NewWebhookServer(), ServiceDurable)
 		app.Add(ServiceNomad, nomad.New(nomad.WithConfig(c.Nomad)), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
-		app.Add(ServiceCoreQueue, durable.OnCore(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
-		app.Add(ServiceHooksQueue, durable.OnHooks(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse)
+		app.Add(ServicePulseBatch, pulse.Writer(), ServicePulse)
+		app.Add(ServiceCoreQueue, durable.OnCore(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse, ServicePulseBatch)
+		app.Add(ServiceHooksQueue, durable.OnHooks(), ServiceKernel, ServiceDB, ServiceDurable, ServicePulse, ServicePulseBatch)
 	default:
 	}
 
@@ -111,16 +141,71 @@ func (c *Config) SetupServices(app *graceful.Graceful) error {
 
 	github.Configure(github.WithConfig(c.Github))
 
-	if err := c.Slack.Validate(); err != nil {
+	if err := c.Teams.Validate(); err != nil {
 		return err
 	}
 
-	slack.Configure(slack.WithConfig(c.Slack))
+	teams.Configure(teams.WithConfig(c.Teams))
+
+	if err := c.Notify.Validate(); err != nil {
+		return err
+	}
+
+	notify.Configure(notify.WithConfig(c.Notify))
 
-	kernel.Configure(
+	hooks := []kernel.Option{
 		kernel.WithRepoHook(eventsv1.RepoHook_REPO_HOOK_GITHUB, &github.KernelImpl{}),
-		kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_SLACK, &slack.KernelImpl{}),
-	)
+		kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_TEAMS, &teams.KernelImpl{}),
+		kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_WEBHOOK, &webhook.KernelImpl{}),
+	}
+
+	if c.Email.Enabled() {
+		if err := c.Email.Validate(); err != nil {
+			return err
+		}
+
+		email.Configure(email.WithConfig(c.Email))
+
+		hooks = append(hooks, kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_EMAIL, &email.KernelImpl{}))
+
+		app.Add(ServiceEmail, email.Configure())
+	}
+
+	slack.Configure(slack.WithConfig(c.Slack))
+
+	if c.Slack.Enabled() {
+		if err := c.Slack.Validate(); err != nil {
+			return err
+		}
+
+		hooks = append(hooks, kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_SLACK, &slack.KernelImpl{}))
+	}
+
+	if c.Gitlab.Enabled() {
+		if err := c.Gitlab.Validate(); err != nil {
+			return err
+		}
+
+		gitlab.Configure(gitlab.WithConfig(c.Gitlab))
+
+		hooks = append(hooks, kernel.WithRepoHook(eventsv1.RepoHook_REPO_HOOK_GITLAB, &gitlab.KernelImpl{}))
+
+		app.Add(ServiceGitlab, gitlab.Get())
+	}
+
+	if c.Gitea.Enabled() {
+		if err := c.Gitea.Validate(); err != nil {
+			return err
+		}
+
+		gitea.Configure(gitea.WithConfig(c.Gitea))
+
+		hooks = append(hooks, kernel.WithRepoHook(eventsv1.RepoHook_REPO_HOOK_GITEA, &gitea.KernelImpl{}))
+
+		app.Add(ServiceGitea, gitea.Get())
+	}
+
+	kernel.Configure(hooks...)
 
 	if err := c.SetupDB(); err != nil {
 		return err
@@ -144,6 +229,72 @@ func (c *Config) SetupServices(app *graceful.Graceful) error {
 	return nil
 }
 
+// SetupSimulate configures the services to run against the bare repositories of the local hook, for offline development
+// and integration tests. Unlike SetupServices, GitHub is not required. The chat hooks are only registered when
+// configured.
+func (c *Config) SetupSimulate(app *graceful.Graceful) error {
+	c.SetupLogger()
+	auth.SetSecret(c.Secret)
+
+	if err := c.Local.Validate(); err != nil {
+		return err
+	}
+
+	local.Configure(local.WithConfig(c.Local))
+
+	if err := c.Notify.Validate(); err != nil {
+		return err
+	}
+
+	notify.Configure(notify.WithConfig(c.Notify))
+
+	hooks := []kernel.Option{
+		kernel.WithRepoHook(eventsv1.RepoHook_REPO_HOOK_LOCAL, &local.KernelImpl{}),
+	}
+
+	if err := c.Teams.Validate(); err == nil {
+		teams.Configure(teams.WithConfig(c.Teams))
+
+		hooks = append(hooks, kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_TEAMS, &teams.KernelImpl{}))
+	}
+
+	hooks = append(hooks, kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_WEBHOOK, &webhook.KernelImpl{}))
+
+	if err := c.Email.Validate(); err == nil {
+		email.Configure(email.WithConfig(c.Email))
+
+		hooks = append(hooks, kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_EMAIL, &email.KernelImpl{}))
+	}
+
+	if err := c.Slack.Validate(); err == nil {
+		slack.Configure(slack.WithConfig(c.Slack))
+
+		hooks = append(hooks, kernel.WithChatHook(eventsv1.ChatHook_CHAT_HOOK_SLACK, &slack.KernelImpl{}))
+	}
+
+	kernel.Configure(hooks...)
+
+	if err := c.SetupDB(); err != nil {
+		return err
+	}
+
+	if err := c.SetupDurable(); err != nil {
+		return err
+	}
+
+	if err := c.SetupPulse(); err != nil {
+		return err
+	}
+
+	app.Add(ServiceLocal, local.Get())
+	app.Add(ServiceKernel, kernel.Get(), ServiceLocal)
+	app.Add(ServiceDB, db.Get())
+	app.Add(ServicePulse, pulse.Get())
+	app.Add(ServiceDurable, durable.Get())
+
+	return nil
+}
+
 // SetupDB configures the database.
 func (c *Config) SetupDB() error {
 	if err := c.DB.Validate(); err != nil {
@@ -174,5 +325,7 @@ func (c *Config) SetupPulse() error {
 
 	pulse.Get(pulse.WithConfig(c.Pulse))
 
+	slog.Info("pulse: events are written to the sink", "sink", c.Pulse.Sink)
+
 	return nil
 }
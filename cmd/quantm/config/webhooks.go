@@ -8,7 +8,10 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"go.breu.io/quantm/internal/hooks/gitea"
 	"go.breu.io/quantm/internal/hooks/github"
+	"go.breu.io/quantm/internal/hooks/gitlab"
+	"go.breu.io/quantm/internal/hooks/slack"
 )
 
 type (
@@ -43,5 +46,18 @@ func NewWebhookServer() *WebhookService {
 
 	webhook.POST("/webhooks/github", github.Handler)
 
+	gitlab := &gitlab.Webhook{}
+
+	webhook.POST("/webhooks/gitlab", gitlab.Handler)
+
+	gitea := &gitea.Webhook{}
+
+	webhook.POST("/webhooks/gitea", gitea.Handler)
+
+	slack := &slack.Webhook{}
+
+	webhook.POST("/webhooks/slack/interactions", slack.Interactions)
+	webhook.POST("/webhooks/slack/commands", slack.Commands)
+
 	return &WebhookService{webhook}
 }
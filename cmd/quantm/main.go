@@ -2,16 +2,29 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"go.breu.io/graceful"
 
 	"go.breu.io/quantm/cmd/quantm/config"
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/repos"
 	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/db/migrations"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/hooks/slack"
+	"go.breu.io/quantm/internal/pulse"
+	pulsemigrations "go.breu.io/quantm/internal/pulse/migrations"
 )
 
 func main() {
@@ -22,7 +35,7 @@ func main() {
 	conf.Parse()
 
 	if conf.Mode == config.ModeMigrate {
-		if err := migrations.Run(ctx, db.Get(db.WithConfig(conf.DB))); err != nil {
+		if err := migrate(ctx, conf); err != nil {
 			slog.Error("unable to run migrations", "error", err.Error())
 
 			os.Exit(1)
@@ -31,6 +44,16 @@ func main() {
 		os.Exit(0)
 	}
 
+	if conf.Mode == config.ModeReplay {
+		if err := replay(ctx, conf); err != nil {
+			slog.Error("unable to replay events", "error", err.Error())
+
+			os.Exit(1)
+		}
+
+		os.Exit(0)
+	}
+
 	quit := make(chan os.Signal, 1)
 	app := graceful.New()
 
@@ -59,3 +82,143 @@ func main() {
 
 	os.Exit(0)
 }
+
+// migrate runs the migrations of the database and conceals the slack tokens stored with the former scheme again, then
+// runs the migration of the config on the pulse tables of the orgs. The database always migrates up, since its
+// migrations do not depend on pulse.
+func migrate(ctx context.Context, conf *config.Config) error {
+	auth.SetSecret(conf.Secret)
+
+	connection := db.Get(db.WithConfig(conf.DB))
+	if err := connection.Start(ctx); err != nil {
+		return err
+	}
+
+	defer func() { _ = connection.Stop(ctx) }()
+
+	if err := migrations.Run(ctx, connection); err != nil {
+		return err
+	}
+
+	if err := slack.MigrateTokens(ctx); err != nil {
+		return err
+	}
+
+	return migrate_pulse(ctx, conf, conf.Migration)
+}
+
+// migrate_pulse runs the migration on the pulse tables of the orgs. The database must be started, since the orgs are
+// listed from it, but its migrations are left as is. Only the clickhouse sink has tables of its own.
+func migrate_pulse(ctx context.Context, conf *config.Config, command pulsemigrations.Command) error {
+	if conf.Pulse.Sink != pulse.SinkClickhouse {
+		slog.Info("pulse: no tables to migrate", "sink", conf.Pulse.Sink)
+		return nil
+	}
+
+	if err := conf.SetupPulse(); err != nil {
+		return err
+	}
+
+	if err := pulse.Get().Start(ctx); err != nil {
+		return err
+	}
+
+	defer func() { _ = pulse.Get().Stop(ctx) }()
+
+	all, err := pulse.MigrateEventsTables(ctx, command)
+
+	for _, status := range all {
+		slog.Info("pulse: migrations", "table", status.Table, "version", status.Version, "latest", status.Latest)
+	}
+
+	return err
+}
+
+// replay signals the persisted events of the repo of the config again, then prints the result of the replay. Without a
+// dry run, the events are signalled to the live workflows of the repo, e.g. to recover them after the loss of the
+// temporal namespace. The workers must be running.
+func replay(ctx context.Context, conf *config.Config) error {
+	conf.SetupLogger()
+
+	id, err := uuid.Parse(conf.Replay.Repo)
+	if err != nil {
+		return fmt.Errorf("invalid repo: %w", err)
+	}
+
+	from, err := time.Parse(time.RFC3339, conf.Replay.From)
+	if err != nil {
+		return fmt.Errorf("invalid from: %w", err)
+	}
+
+	to := time.Now()
+	if conf.Replay.To != "" {
+		if to, err = time.Parse(time.RFC3339, conf.Replay.To); err != nil {
+			return fmt.Errorf("invalid to: %w", err)
+		}
+	}
+
+	if !from.Before(to) {
+		return errors.New("from must be before to")
+	}
+
+	if err := conf.SetupDB(); err != nil {
+		return err
+	}
+
+	if err := db.Get().Start(ctx); err != nil {
+		return err
+	}
+
+	defer func() { _ = db.Get().Stop(ctx) }()
+
+	if err := conf.SetupDurable(); err != nil {
+		return err
+	}
+
+	repo, err := db.Queries().GetRepo(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var chat *entities.ChatLink
+
+	link, err := db.Queries().GetChatLink(ctx, repo.ID)
+	if err == nil {
+		chat = &link
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	slug, err := db.Queries().GetOrgSlugByID(ctx, repo.OrgID)
+	if err != nil {
+		return err
+	}
+
+	payload := &repos.ReplayPayload{
+		ID:       uuid.New().String(),
+		Repo:     &repo,
+		ChatLink: chat,
+		Slug:     slug,
+		From:     from,
+		To:       to,
+		DryRun:   conf.Replay.DryRun,
+		Settle:   conf.Replay.Settle,
+	}
+
+	slog.Info("replay: started", "id", payload.ID, "repo", repo.ID, "from", from, "to", to, "dry_run", payload.DryRun)
+
+	run, err := durable.OnCore().ExecuteWorkflow(ctx, repos.ReplayWorkflowOptions(&repo, payload.ID), repos.ReplayWorkflow, payload)
+	if err != nil {
+		return err
+	}
+
+	result := &repos.ReplayResult{}
+	if err := run.Get(ctx, result); err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
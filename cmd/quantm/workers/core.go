@@ -3,6 +3,8 @@ package workers
 import (
 	"go.breu.io/durex/queues"
 
+	"go.breu.io/quantm/internal/core/notify"
+	"go.breu.io/quantm/internal/core/reports"
 	"go.breu.io/quantm/internal/core/repos"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/pulse"
@@ -30,5 +32,23 @@ func Core() {
 		// Register branch workflows and activities
 		q.RegisterWorkflow(repos.BranchWorkflow)
 		q.RegisterActivity(repos.NewBranchActivities())
+
+		// Register trunk workflows
+		q.RegisterWorkflow(repos.TrunkWorkflow)
+
+		// Register the replay of the persisted events of a repo
+		q.RegisterWorkflow(repos.ReplayWorkflow)
+		q.RegisterActivity(repos.NewReplayActivities())
+
+		// Register activities acting on the repo through its hook
+		q.RegisterActivity(repos.NewKernelActivities())
+
+		// Register the digest of the deferred notifications
+		q.RegisterWorkflow(notify.DigestWorkflow)
+		q.RegisterActivity(notify.NewDigestActivities())
+
+		// Register the scheduled reports of the repos and the teams
+		q.RegisterWorkflow(reports.ReportsWorkflow)
+		q.RegisterActivity(reports.NewReportsActivities())
 	}
 }
@@ -2,7 +2,11 @@ package workers
 
 import (
 	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/hooks/gitea"
 	"go.breu.io/quantm/internal/hooks/github"
+	"go.breu.io/quantm/internal/hooks/gitlab"
+	"go.breu.io/quantm/internal/hooks/local"
+	"go.breu.io/quantm/internal/hooks/slack"
 	"go.breu.io/quantm/internal/pulse"
 )
 
@@ -32,5 +36,61 @@ func Hooks() {
 		// Register github ref workflow and activity
 		q.RegisterWorkflow(github.RefWorkflow)
 		q.RegisterActivity(&github.RefActivity{})
+
+		// Register github pull request workflow and activity
+		q.RegisterWorkflow(github.PullRequestWorkflow)
+		q.RegisterActivity(&github.PullRequestActivity{})
+
+		// Register github pull request command workflow and activity
+		q.RegisterWorkflow(github.CommandWorkflow)
+		q.RegisterActivity(&github.CommandActivity{})
+
+		// Register gitlab install workflow and activity
+		q.RegisterWorkflow(gitlab.InstallWorkflow)
+		q.RegisterActivity(&gitlab.InstallActivity{})
+
+		// Register gitlab push workflow and activity
+		q.RegisterWorkflow(gitlab.PushWorkflow)
+		q.RegisterActivity(&gitlab.PushActivity{})
+
+		// Register gitlab merge request workflow and activity
+		q.RegisterWorkflow(gitlab.MergeRequestWorkflow)
+		q.RegisterActivity(&gitlab.MergeRequestActivity{})
+
+		// Register gitlab note workflow and activity
+		q.RegisterWorkflow(gitlab.NoteWorkflow)
+		q.RegisterActivity(&gitlab.NoteActivity{})
+
+		// Register gitlab pipeline workflow and activity
+		q.RegisterWorkflow(gitlab.PipelineWorkflow)
+		q.RegisterActivity(&gitlab.PipelineActivity{})
+
+		// Register gitea install workflow and activity
+		q.RegisterWorkflow(gitea.InstallWorkflow)
+		q.RegisterActivity(&gitea.InstallActivity{})
+
+		// Register gitea sync repos workflow and activity
+		q.RegisterWorkflow(gitea.SyncReposWorkflow)
+		q.RegisterActivity(&gitea.InstallReposActivity{})
+
+		// Register gitea push workflow and activity
+		q.RegisterWorkflow(gitea.PushWorkflow)
+		q.RegisterActivity(&gitea.PushActivity{})
+
+		// Register gitea ref workflow and activity
+		q.RegisterWorkflow(gitea.RefWorkflow)
+		q.RegisterActivity(&gitea.RefActivity{})
+
+		// Register gitea pull request workflow and activity
+		q.RegisterWorkflow(gitea.PullRequestWorkflow)
+		q.RegisterActivity(&gitea.PullRequestActivity{})
+
+		// Register slack command workflow and activity
+		q.RegisterWorkflow(slack.CommandWorkflow)
+		q.RegisterActivity(&slack.CommandActivity{})
+
+		// Register local receive workflow and activity
+		q.RegisterWorkflow(local.ReceiveWorkflow)
+		q.RegisterActivity(&local.ReceiveActivity{})
 	}
 }
@@ -28,6 +28,7 @@ require (
 	go.breu.io/graceful v0.1.0
 	go.opentelemetry.io/otel/trace v1.33.0
 	go.step.sm/crypto v0.56.0
+	go.temporal.io/api v1.43.0
 	go.temporal.io/sdk v1.31.0
 	golang.org/x/crypto v0.32.0
 	golang.org/x/net v0.34.0
@@ -105,7 +106,6 @@ require (
 	github.com/xanzy/ssh-agent v0.3.3 // indirect
 	go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp v0.55.0 // indirect
 	go.opentelemetry.io/otel v1.33.0 // indirect
-	go.temporal.io/api v1.43.0 // indirect
 	go.uber.org/atomic v1.11.0 // indirect
 	golang.org/x/exp v0.0.0-20240409090435-93d18d7e34b8 // indirect
 	golang.org/x/sync v0.10.0 // indirect
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"go.breu.io/quantm/internal/auth/config"
+	"go.breu.io/quantm/internal/auth/crypto"
 	"go.breu.io/quantm/internal/auth/nomad"
 )
 
@@ -10,6 +11,14 @@ var (
 	SetSecret = config.SetSecret
 )
 
+var (
+	// Conceal encrypts a secret, e.g. the access token of a hook, to store it. It is revealed with the same salt.
+	Conceal = crypto.Conceal
+
+	// Reveal decrypts a secret concealed with Conceal.
+	Reveal = crypto.Reveal
+)
+
 var (
 	NomadAuthContext           = nomad.GetAuthContext
 	NomadInterceptor           = nomad.AuthInterceptor
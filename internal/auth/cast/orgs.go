@@ -0,0 +1,31 @@
+package cast
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/db/entities"
+	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
+)
+
+// OrgToProto converts an Org entity to its protobuf representation.
+func OrgToProto(org *entities.Org) (*authv1.Org, error) {
+	hooks := &authv1.OrgHooks{}
+
+	if len(org.Hooks) > 0 {
+		if err := json.Unmarshal(org.Hooks, hooks); err != nil {
+			return nil, err
+		}
+	}
+
+	return &authv1.Org{
+		Id:        org.ID.String(),
+		CreatedAt: timestamppb.New(org.CreatedAt),
+		UpdatedAt: timestamppb.New(org.UpdatedAt),
+		Name:      org.Name,
+		Domain:    org.Domain,
+		Slug:      org.Slug,
+		Hooks:     hooks,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package cast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/auth/cast"
+	"go.breu.io/quantm/internal/db/entities"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func TestOrgToProto(t *testing.T) {
+	t.Parallel()
+
+	org := &entities.Org{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+		Name:      "breu",
+		Domain:    "breu.io",
+		Slug:      "breu-io-x9mu",
+		Hooks:     []byte(`{"repo":1001,"chat":2001}`),
+	}
+
+	pb, err := cast.OrgToProto(org)
+	require.NoError(t, err)
+
+	assert.Equal(t, org.ID.String(), pb.GetId())
+	assert.Equal(t, "breu.io", pb.GetDomain())
+	assert.Equal(t, "breu-io-x9mu", pb.GetSlug())
+	assert.Equal(t, eventsv1.RepoHook_REPO_HOOK_GITHUB, pb.GetHooks().GetRepo())
+	assert.Equal(t, eventsv1.ChatHook_CHAT_HOOK_SLACK, pb.GetHooks().GetChat())
+
+	org.Hooks = nil
+
+	pb, err = cast.OrgToProto(org)
+	require.NoError(t, err)
+	assert.Equal(t, eventsv1.RepoHook_REPO_HOOK_UNSPECIFIED, pb.GetHooks().GetRepo())
+}
@@ -0,0 +1,75 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"io"
+
+	"go.breu.io/quantm/internal/auth/config"
+)
+
+var (
+	ErrCipherText = errors.New("ciphertext too short")
+)
+
+// Conceal encrypts the secret, e.g. the access token of a hook, with AES-256-GCM and returns it base64 encoded. The key
+// is derived from the application secret and the given salt, so the same salt must be used to reveal the secret.
+func Conceal(secret, salt string) (string, error) {
+	gcm, err := aead(salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Reveal decrypts the secret concealed with Conceal.
+func Reveal(concealed, salt string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(concealed)
+	if err != nil {
+		return "", err
+	}
+
+	gcm, err := aead(salt)
+	if err != nil {
+		return "", err
+	}
+
+	if len(decoded) < gcm.NonceSize() {
+		return "", ErrCipherText
+	}
+
+	nonce, sealed := decoded[:gcm.NonceSize()], decoded[gcm.NonceSize():]
+
+	secret, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(secret), nil
+}
+
+// aead creates the AES-256-GCM cipher. The key is the first 32 bytes of the SHA-512 hash of the application secret
+// and the salt.
+func aead(salt string) (cipher.AEAD, error) {
+	h := sha512.New()
+	h.Write([]byte(config.Secret() + salt))
+
+	block, err := aes.NewCipher(h.Sum(nil)[:32])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
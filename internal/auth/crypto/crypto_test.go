@@ -0,0 +1,29 @@
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/auth/crypto"
+)
+
+func TestConcealReveal(t *testing.T) {
+	auth.SetSecret("secret")
+
+	concealed, err := crypto.Conceal("glpat-token", "org")
+	require.NoError(t, err)
+	assert.NotContains(t, concealed, "glpat-token")
+
+	revealed, err := crypto.Reveal(concealed, "org")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-token", revealed)
+
+	_, err = crypto.Reveal(concealed, "other")
+	assert.Error(t, err)
+
+	_, err = crypto.Reveal("c2hvcnQ=", "org")
+	assert.ErrorIs(t, err, crypto.ErrCipherText)
+}
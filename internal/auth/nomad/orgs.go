@@ -9,11 +9,13 @@ import (
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"go.breu.io/quantm/internal/auth/cast"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/erratic"
 	authv1 "go.breu.io/quantm/internal/proto/ctrlplane/auth/v1"
 	"go.breu.io/quantm/internal/proto/ctrlplane/auth/v1/authv1connect"
+	"go.breu.io/quantm/internal/pulse"
 )
 
 type (
@@ -22,6 +24,55 @@ type (
 	}
 )
 
+// CreateOrg creates an org along with its events table, so that the events of the org are recorded from the start. The
+// slug is derived from the domain if not given.
+func (s *OrgService) CreateOrg(
+	ctx context.Context, req *connect.Request[authv1.CreateOrgRequest],
+) (*connect.Response[authv1.CreateOrgResponse], error) {
+	domain := req.Msg.GetDomain()
+	if domain == "" {
+		return nil, erratic.NewBadRequestError(erratic.AuthModule).WithReason("domain is required")
+	}
+
+	name := req.Msg.GetName()
+	if name == "" {
+		name = domain
+	}
+
+	slug := req.Msg.GetSlug()
+	if slug == "" {
+		slug = db.CreateSlug(domain)
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	org, err := qtx.CreateOrg(ctx, entities.CreateOrgParams{Name: name, Lower: domain, Slug: slug})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to create org").Wrap(err)
+	}
+
+	// the org is only committed once its events table exists.
+	if err := pulse.CreateEventsTable(ctx, org.Slug); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).WithReason("unable to create events table").Wrap(err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	proto, err := cast.OrgToProto(&org)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.AuthModule).Wrap(err)
+	}
+
+	return connect.NewResponse(&authv1.CreateOrgResponse{Org: proto}), nil
+}
+
 func (s *OrgService) SetOrgHooks(
 	ctx context.Context, req *connect.Request[authv1.SetOrgHooksRequest],
 ) (*connect.Response[emptypb.Empty], error) {
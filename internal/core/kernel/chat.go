@@ -18,5 +18,43 @@ type (
 		//
 		// This method must not be called from the workflow.
 		NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error
+
+		// NotifyBranchDrift sends a message indicating that a branch has drifted too far from the default branch.
+		//
+		// This method must not be called from the workflow.
+		NotifyBranchDrift(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) error
+	}
+
+	// ChatFollowUp is implemented by the chat hooks able to follow up on the earlier notifications of a branch, e.g. as
+	// replies in a thread. The follow ups are not sent to the hooks not implementing it.
+	ChatFollowUp interface {
+		// NotifyConflictResolved follows up on a merge conflict once the branch rebases cleanly again.
+		//
+		// This method must not be called from the workflow.
+		NotifyConflictResolved(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error
+
+		// NotifyPullRequestMerged follows up on the notifications of the branch once its pull request is merged.
+		//
+		// This method must not be called from the workflow.
+		NotifyPullRequestMerged(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest]) error
+	}
+
+	// ChatDigest is implemented by the chat hooks able to deliver the notifications deferred by the notification
+	// preferences of the recipient, e.g. the daily digest. The deferred notifications are dropped for the hooks not
+	// implementing it.
+	ChatDigest interface {
+		// NotifyDigest sends the deferred notifications together.
+		//
+		// This method must not be called from the workflow.
+		NotifyDigest(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) error
+	}
+
+	// ChatReport is implemented by the chat hooks able to deliver the scheduled reports of the repos and of the teams.
+	// The reports are not delivered on the hooks not implementing it.
+	ChatReport interface {
+		// NotifyReport sends the report.
+		//
+		// This method must not be called from the workflow.
+		NotifyReport(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Report]) error
 	}
 )
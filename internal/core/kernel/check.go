@@ -0,0 +1,55 @@
+package kernel
+
+type (
+	// CheckStatus is the status of a check run.
+	CheckStatus string
+
+	// CheckConclusion is the conclusion of a completed check run.
+	CheckConclusion string
+
+	// AnnotationLevel is the severity of a check annotation.
+	AnnotationLevel string
+
+	// CheckAnnotation points a check run at a range of lines of a file. Lines are 1-based and inclusive.
+	CheckAnnotation struct {
+		Path      string          `json:"path"`
+		StartLine int             `json:"start_line"`
+		EndLine   int             `json:"end_line"`
+		Level     AnnotationLevel `json:"level"`
+		Title     string          `json:"title"`
+		Message   string          `json:"message"`
+	}
+
+	// CheckRun is a finding of quantm reported against a commit, e.g. a conflict with the default branch. A check run is
+	// identified by its name on the commit, so reporting the same name again updates or supersedes the earlier one.
+	CheckRun struct {
+		Sha         string             `json:"sha"`
+		Name        string             `json:"name"`
+		Status      CheckStatus        `json:"status"`
+		Conclusion  CheckConclusion    `json:"conclusion"` // Only for completed check runs.
+		Title       string             `json:"title"`
+		Summary     string             `json:"summary"`
+		Text        string             `json:"text"`
+		DetailsUrl  string             `json:"details_url"`
+		Annotations []*CheckAnnotation `json:"annotations"`
+	}
+)
+
+const (
+	CheckStatusQueued     CheckStatus = "queued"
+	CheckStatusInProgress CheckStatus = "in_progress"
+	CheckStatusCompleted  CheckStatus = "completed"
+)
+
+const (
+	CheckConclusionSuccess   CheckConclusion = "success"
+	CheckConclusionFailure   CheckConclusion = "failure"
+	CheckConclusionNeutral   CheckConclusion = "neutral"
+	CheckConclusionCancelled CheckConclusion = "cancelled"
+)
+
+const (
+	AnnotationLevelNotice  AnnotationLevel = "notice"
+	AnnotationLevelWarning AnnotationLevel = "warning"
+	AnnotationLevelFailure AnnotationLevel = "failure"
+)
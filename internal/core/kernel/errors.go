@@ -0,0 +1,14 @@
+package kernel
+
+import (
+	"errors"
+)
+
+var (
+	// ErrNotSupported is returned by a hook for an action the provider does not support.
+	ErrNotSupported = errors.New("action not supported by the hook")
+
+	// ErrNotFound is returned by a hook when the object acted upon no longer exists on the provider, e.g. a comment
+	// deleted by a user.
+	ErrNotFound = errors.New("not found on the provider")
+)
@@ -3,14 +3,179 @@ package kernel
 import (
 	"context"
 
+	"github.com/google/uuid"
+
 	"go.breu.io/quantm/internal/db/entities"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
 type (
+	// MergeMethod is the method used to merge a pull request.
+	MergeMethod string
+
+	// CommitState is the state of a commit status.
+	CommitState string
+
+	// PullRequestOptions are the options to open or update a pull request. When updating, empty fields are left as is,
+	// and Head is ignored.
+	PullRequestOptions struct {
+		Title string `json:"title"`
+		Body  string `json:"body"`
+		Head  string `json:"head"` // Branch name of the changes.
+		Base  string `json:"base"` // Branch name the changes are merged into.
+		Draft bool   `json:"draft"`
+	}
+
+	// MergeOptions are the options to merge a pull request.
+	MergeOptions struct {
+		Method  MergeMethod `json:"method"`
+		Sha     string      `json:"sha"` // When set, the merge fails unless the head of the pull request matches.
+		Title   string      `json:"title"`
+		Message string      `json:"message"`
+	}
+
+	// CommitStatus is the status reported against a commit, e.g. the state of the merge queue for the head of a pull
+	// request. Providers without commit statuses may report it as a check run.
+	CommitStatus struct {
+		Sha         string      `json:"sha"`
+		State       CommitState `json:"state"`
+		Name        string      `json:"name"` // Unique name of the status, a later status with the same name replaces it.
+		Description string      `json:"description"`
+		TargetUrl   string      `json:"target_url"`
+	}
+
+	// Repo is the abstraction over the git providers. The core only ever talks to a provider through it, so that adding a
+	// provider never touches the core. Refs are full refs, e.g. refs/heads/main, branches are names.
+	//
+	// None of the methods must be called from the workflow. A provider not supporting an action returns ErrNotSupported.
 	Repo interface {
 		// TokenizedCloneUrl returns the tokenized clone URL for the repository with the given ID.
-		//
-		// This method must not be called from the workflow.
 		TokenizedCloneUrl(ctx context.Context, repo *entities.Repo) (string, error)
+
+		// CreateRef creates the ref pointing to the given sha.
+		CreateRef(ctx context.Context, repo *entities.Repo, ref, sha string) error
+
+		// UpdateRef points the ref to the given sha. Unless forced, the update must be a fast-forward.
+		UpdateRef(ctx context.Context, repo *entities.Repo, ref, sha string, force bool) error
+
+		// DeleteRef deletes the ref.
+		DeleteRef(ctx context.Context, repo *entities.Repo, ref string) error
+
+		// ListBranches returns the names of all the branches of the repository.
+		ListBranches(ctx context.Context, repo *entities.Repo) ([]string, error)
+
+		// OpenPullRequest opens a pull request.
+		OpenPullRequest(ctx context.Context, repo *entities.Repo, opts *PullRequestOptions) (*eventsv1.PullRequest, error)
+
+		// UpdatePullRequest updates the title, body or base branch of the pull request.
+		UpdatePullRequest(ctx context.Context, repo *entities.Repo, number int64, opts *PullRequestOptions) (*eventsv1.PullRequest, error)
+
+		// MergePullRequest merges the pull request, returning the sha of the resulting commit.
+		MergePullRequest(ctx context.Context, repo *entities.Repo, number int64, opts *MergeOptions) (string, error)
+
+		// ListOpenPullRequests returns all the open pull requests of the repository.
+		ListOpenPullRequests(ctx context.Context, repo *entities.Repo) ([]*eventsv1.PullRequest, error)
+
+		// AddLabels adds the labels to the pull request.
+		AddLabels(ctx context.Context, repo *entities.Repo, number int64, labels ...string) error
+
+		// RemoveLabel removes the label from the pull request.
+		RemoveLabel(ctx context.Context, repo *entities.Repo, number int64, label string) error
+
+		// CreateComment posts a comment on the pull request, returning the id of the comment.
+		CreateComment(ctx context.Context, repo *entities.Repo, number int64, body string) (int64, error)
+
+		// UpdateComment replaces the body of the comment. Returns ErrNotFound if the comment has been deleted.
+		UpdateComment(ctx context.Context, repo *entities.Repo, id int64, body string) error
+
+		// SetCommitStatus reports the status against the commit.
+		SetCommitStatus(ctx context.Context, repo *entities.Repo, status *CommitStatus) error
+
+		// SetCheckRun reports the check run on the commit, updating the check run with the same name where the provider
+		// allows it without keeping the annotations of the earlier report.
+		SetCheckRun(ctx context.Context, repo *entities.Repo, check *CheckRun) error
+
+		// CanWrite reports whether the quantm user, through the account on the provider it is linked to, has write access
+		// to the repository. A user without a linked account cannot write.
+		CanWrite(ctx context.Context, repo *entities.Repo, user_id uuid.UUID) (bool, error)
 	}
 )
+
+const (
+	MergeMethodMerge  MergeMethod = "merge"
+	MergeMethodSquash MergeMethod = "squash"
+	MergeMethodRebase MergeMethod = "rebase"
+)
+
+const (
+	CommitStatePending CommitState = "pending"
+	CommitStateSuccess CommitState = "success"
+	CommitStateFailure CommitState = "failure"
+	CommitStateError   CommitState = "error"
+)
+
+type (
+	// BaseRepo implements every action of Repo, except TokenizedCloneUrl, by returning ErrNotSupported. Hooks embed it,
+	// overriding the actions the provider supports.
+	BaseRepo struct{}
+)
+
+func (BaseRepo) CreateRef(context.Context, *entities.Repo, string, string) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) UpdateRef(context.Context, *entities.Repo, string, string, bool) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) DeleteRef(context.Context, *entities.Repo, string) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) ListBranches(context.Context, *entities.Repo) ([]string, error) {
+	return nil, ErrNotSupported
+}
+
+func (BaseRepo) OpenPullRequest(context.Context, *entities.Repo, *PullRequestOptions) (*eventsv1.PullRequest, error) {
+	return nil, ErrNotSupported
+}
+
+func (BaseRepo) UpdatePullRequest(context.Context, *entities.Repo, int64, *PullRequestOptions) (*eventsv1.PullRequest, error) {
+	return nil, ErrNotSupported
+}
+
+func (BaseRepo) MergePullRequest(context.Context, *entities.Repo, int64, *MergeOptions) (string, error) {
+	return "", ErrNotSupported
+}
+
+func (BaseRepo) ListOpenPullRequests(context.Context, *entities.Repo) ([]*eventsv1.PullRequest, error) {
+	return nil, ErrNotSupported
+}
+
+func (BaseRepo) AddLabels(context.Context, *entities.Repo, int64, ...string) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) RemoveLabel(context.Context, *entities.Repo, int64, string) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) CreateComment(context.Context, *entities.Repo, int64, string) (int64, error) {
+	return 0, ErrNotSupported
+}
+
+func (BaseRepo) UpdateComment(context.Context, *entities.Repo, int64, string) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) SetCommitStatus(context.Context, *entities.Repo, *CommitStatus) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) SetCheckRun(context.Context, *entities.Repo, *CheckRun) error {
+	return ErrNotSupported
+}
+
+func (BaseRepo) CanWrite(context.Context, *entities.Repo, uuid.UUID) (bool, error) {
+	return false, ErrNotSupported
+}
@@ -0,0 +1,11 @@
+// Package lineage traces the events of the repos back to what caused them, e.g. from a push to the merge conflict it
+// raised and the notification sent on chat, using the parents recorded on each event and persisted by pulse.
+package lineage
+
+import (
+	"go.breu.io/quantm/internal/core/lineage/nomad"
+)
+
+var (
+	NomadHandler = nomad.NewLineageServiceHandler
+)
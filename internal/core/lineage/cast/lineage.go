@@ -0,0 +1,64 @@
+package cast
+
+import (
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// HookName returns the name of the repo or chat hook, the number itself if unknown.
+func HookName(hook int32) string {
+	if name, ok := eventsv1.ChatHook_name[hook]; ok && hook != 0 {
+		return name
+	}
+
+	return eventsv1.RepoHook(hook).String()
+}
+
+// HopToProto converts a hop of a lineage to a LineageEvent proto.
+func HopToProto(hop *pulse.Hop) *corev1.LineageEvent {
+	proto := &corev1.LineageEvent{
+		Id:          hop.Event.ID.String(),
+		Parents:     ids(hop.Event.Parents),
+		Hook:        HookName(hop.Event.Hook),
+		Scope:       hop.Event.Scope,
+		Action:      hop.Event.Action,
+		Source:      hop.Event.Source,
+		SubjectId:   hop.Event.SubjectID.String(),
+		SubjectName: hop.Event.SubjectName,
+		UserId:      hop.Event.UserID.String(),
+		Timestamp:   timestamppb.New(hop.Event.Timestamp),
+		Elapsed:     durationpb.New(hop.Elapsed),
+	}
+
+	if hop.Duration != nil {
+		proto.ParentId = hop.Parent.String()
+		proto.Duration = durationpb.New(*hop.Duration)
+	}
+
+	return proto
+}
+
+// LineageToProto converts a lineage to a Lineage proto.
+func LineageToProto(lineage *pulse.Lineage) *corev1.Lineage {
+	proto := &corev1.Lineage{Roots: ids(lineage.Roots), Events: make([]*corev1.LineageEvent, 0, len(lineage.Hops))}
+
+	for _, hop := range lineage.Hops {
+		proto.Events = append(proto.Events, HopToProto(hop))
+	}
+
+	return proto
+}
+
+func ids(all []uuid.UUID) []string {
+	result := make([]string, 0, len(all))
+	for _, id := range all {
+		result = append(result, id.String())
+	}
+
+	return result
+}
@@ -0,0 +1,109 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/lineage/cast"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/erratic"
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/core/v1/corev1connect"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	LineageService struct {
+		corev1connect.UnimplementedLineageServiceHandler
+	}
+)
+
+// GetLineage returns the causal graph of the event, or of the events of the pull request or the branch of the repo, in
+// the org of the authenticated user.
+func (s *LineageService) GetLineage(
+	ctx context.Context, req *connect.Request[corev1.GetLineageRequest],
+) (*connect.Response[corev1.GetLineageResponse], error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	query, err := s.query(ctx, req.Msg, org_id)
+	if err != nil {
+		return nil, err
+	}
+
+	slug, err := db.Queries().GetOrgSlugByID(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("org_id", org_id.String()).Wrap(err)
+	}
+
+	lineage, err := pulse.TraceLineage(ctx, slug, query)
+	if err != nil {
+		if errors.Is(err, pulse.ErrUnsupported) {
+			return nil, erratic.NewNotImplementedError(erratic.CoreModule).WithReason("lineage not supported by the sink")
+		}
+
+		return nil, erratic.NewSystemError(erratic.CoreModule).Wrap(err)
+	}
+
+	if lineage == nil {
+		return nil, erratic.NewNotFoundError(erratic.CoreModule, "events")
+	}
+
+	return connect.NewResponse(&corev1.GetLineageResponse{Lineage: cast.LineageToProto(lineage)}), nil
+}
+
+// query validates the request. Exactly one of the event, the pull request or the branch must be given, and the repo
+// of the last two must belong to the org.
+func (s *LineageService) query(
+	ctx context.Context, msg *corev1.GetLineageRequest, org_id uuid.UUID,
+) (*pulse.LineageQuery, error) {
+	given := 0
+	for _, ok := range []bool{msg.GetEventId() != "", msg.GetPrNumber() > 0, msg.GetBranch() != ""} {
+		if ok {
+			given++
+		}
+	}
+
+	if given != 1 {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).
+			WithReason("exactly one of event_id, pr_number or branch is required")
+	}
+
+	if msg.GetEventId() != "" {
+		id, err := uuid.Parse(msg.GetEventId())
+		if err != nil {
+			return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("event_id", msg.GetEventId()).Wrap(err)
+		}
+
+		return &pulse.LineageQuery{EventID: id}, nil
+	}
+
+	repo_id, err := uuid.Parse(msg.GetRepoId())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("repo_id", msg.GetRepoId()).Wrap(err)
+	}
+
+	repo, err := db.Queries().GetRepo(ctx, repo_id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, erratic.NewNotFoundError(erratic.CoreModule, "repo").AddHint("repo_id", msg.GetRepoId())
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("repo_id", msg.GetRepoId()).Wrap(err)
+	}
+
+	if repo.OrgID != org_id {
+		return nil, erratic.NewNotFoundError(erratic.CoreModule, "repo").AddHint("repo_id", msg.GetRepoId())
+	}
+
+	return &pulse.LineageQuery{RepoID: repo_id, PRNumber: msg.GetPrNumber(), Branch: msg.GetBranch()}, nil
+}
+
+func NewLineageServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return corev1connect.NewLineageServiceHandler(&LineageService{}, opts...)
+}
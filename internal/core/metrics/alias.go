@@ -0,0 +1,11 @@
+// Package metrics serves the DORA metrics of the repos, the teams and the orgs, computed by pulse from the events of
+// the repos: the deployment frequency, the lead time for changes, the change failure rate and the time to restore.
+package metrics
+
+import (
+	"go.breu.io/quantm/internal/core/metrics/nomad"
+)
+
+var (
+	NomadHandler = nomad.NewMetricsServiceHandler
+)
@@ -0,0 +1,69 @@
+package cast
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+var (
+	scopes = map[corev1.MetricsScope]pulse.ReportBy{
+		corev1.MetricsScope_METRICS_SCOPE_REPO: pulse.ReportByRepo,
+		corev1.MetricsScope_METRICS_SCOPE_TEAM: pulse.ReportByTeam,
+		corev1.MetricsScope_METRICS_SCOPE_ORG:  pulse.ReportByOrg,
+	}
+
+	intervals = map[corev1.MetricsInterval]pulse.Interval{
+		corev1.MetricsInterval_METRICS_INTERVAL_UNSPECIFIED: pulse.IntervalNone,
+		corev1.MetricsInterval_METRICS_INTERVAL_DAY:         pulse.IntervalDay,
+		corev1.MetricsInterval_METRICS_INTERVAL_WEEK:        pulse.IntervalWeek,
+		corev1.MetricsInterval_METRICS_INTERVAL_MONTH:       pulse.IntervalMonth,
+	}
+)
+
+// ProtoToReportBy converts a MetricsScope to the column the events are selected by, false if unknown.
+func ProtoToReportBy(scope corev1.MetricsScope) (pulse.ReportBy, bool) {
+	by, ok := scopes[scope]
+	return by, ok
+}
+
+// ProtoToInterval converts a MetricsInterval to the interval the metrics are grouped by, false if unknown.
+func ProtoToInterval(interval corev1.MetricsInterval) (pulse.Interval, bool) {
+	i, ok := intervals[interval]
+	return i, ok
+}
+
+// DoraToProto converts the DORA metrics of a period to a DoraMetrics proto. The unknown durations are left unset.
+func DoraToProto(dora *pulse.Dora) *corev1.DoraMetrics {
+	proto := &corev1.DoraMetrics{
+		From:                timestamppb.New(dora.From),
+		To:                  timestamppb.New(dora.To),
+		Deployments:         dora.Deployments,
+		DeploymentFrequency: dora.Frequency(),
+		Pipelines:           dora.Pipelines,
+		FailedPipelines:     dora.FailedPipelines,
+		ChangeFailureRate:   dora.FailureRate(),
+	}
+
+	if dora.LeadTime != nil {
+		proto.LeadTime = durationpb.New(*dora.LeadTime)
+	}
+
+	if dora.TimeToRestore != nil {
+		proto.TimeToRestore = durationpb.New(*dora.TimeToRestore)
+	}
+
+	return proto
+}
+
+// DorasToProto converts the DORA metrics of the periods to DoraMetrics protos, in order.
+func DorasToProto(doras []*pulse.Dora) []*corev1.DoraMetrics {
+	protos := make([]*corev1.DoraMetrics, 0, len(doras))
+	for _, dora := range doras {
+		protos = append(protos, DoraToProto(dora))
+	}
+
+	return protos
+}
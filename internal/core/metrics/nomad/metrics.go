@@ -0,0 +1,122 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/metrics/cast"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/core/v1/corev1connect"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	MetricsService struct {
+		corev1connect.UnimplementedMetricsServiceHandler
+	}
+)
+
+// GetDoraMetrics returns the DORA metrics of the repo, the team or the org of the authenticated user over the range,
+// one per period of the interval.
+func (s *MetricsService) GetDoraMetrics(
+	ctx context.Context, req *connect.Request[corev1.GetDoraMetricsRequest],
+) (*connect.Response[corev1.GetDoraMetricsResponse], error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	by, ok := cast.ProtoToReportBy(req.Msg.GetScope())
+	if !ok {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("scope", req.Msg.GetScope().String())
+	}
+
+	interval, ok := cast.ProtoToInterval(req.Msg.GetInterval())
+	if !ok {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("interval", req.Msg.GetInterval().String())
+	}
+
+	from, to := req.Msg.GetFrom().AsTime(), req.Msg.GetTo().AsTime()
+	if !from.Before(to) {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).
+			AddHint("from", from.String()).
+			AddHint("to", to.String()).
+			WithReason("from must be before to")
+	}
+
+	id := org_id
+	if by != pulse.ReportByOrg {
+		parsed, err := uuid.Parse(req.Msg.GetId())
+		if err != nil {
+			return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("id", req.Msg.GetId()).Wrap(err)
+		}
+
+		if err := s.owned(ctx, by, parsed, org_id); err != nil {
+			return nil, err
+		}
+
+		id = parsed
+	}
+
+	slug, err := db.Queries().GetOrgSlugByID(ctx, org_id)
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("org_id", org_id.String()).Wrap(err)
+	}
+
+	query := &pulse.DoraQuery{By: by, ID: id, From: from, To: to, Interval: interval}
+
+	doras, err := pulse.DoraMetrics(ctx, slug, query)
+	if err != nil {
+		if errors.Is(err, pulse.ErrUnsupported) {
+			return nil, erratic.NewNotImplementedError(erratic.CoreModule).WithReason("metrics not supported by the sink")
+		}
+
+		return nil, erratic.NewSystemError(erratic.CoreModule).AddHint("id", id.String()).Wrap(err)
+	}
+
+	return connect.NewResponse(&corev1.GetDoraMetricsResponse{Metrics: cast.DorasToProto(doras)}), nil
+}
+
+// owned checks that the repo or the team belongs to the org of the authenticated user.
+func (s *MetricsService) owned(ctx context.Context, by pulse.ReportBy, id, org_id uuid.UUID) error {
+	kind, owner, err := "repo", uuid.Nil, error(nil)
+
+	switch by {
+	case pulse.ReportByTeam:
+		kind = "team"
+
+		var team entities.Team
+		if team, err = db.Queries().GetTeam(ctx, id); err == nil {
+			owner = team.OrgID
+		}
+	default:
+		var repo entities.Repo
+		if repo, err = db.Queries().GetRepo(ctx, id); err == nil {
+			owner = repo.OrgID
+		}
+	}
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return erratic.NewNotFoundError(erratic.CoreModule, kind).AddHint("id", id.String())
+		}
+
+		return erratic.NewDatabaseError(erratic.CoreModule).AddHint("id", id.String()).Wrap(err)
+	}
+
+	if owner != org_id {
+		return erratic.NewNotFoundError(erratic.CoreModule, kind).AddHint("id", id.String())
+	}
+
+	return nil
+}
+
+func NewMetricsServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return corev1connect.NewMetricsServiceHandler(&MetricsService{}, opts...)
+}
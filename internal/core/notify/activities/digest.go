@@ -0,0 +1,116 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Digest groups the activities delivering the notifications deferred by the notification preferences.
+	Digest struct{}
+)
+
+// SendNotificationDigest delivers the notices of the recipient due on the channel as one digest, and returns the time
+// the next notice is due at, or the zero time if there is none left. The notices are removed only once delivered, so
+// that a retry delivers them again. Without a chat to deliver them with, the notices are dropped.
+func (a *Digest) SendNotificationDigest(ctx context.Context, payload *defs.DigestPayload) (time.Time, error) {
+	rows, err := db.Queries().ListDueNotificationDigests(ctx, entities.ListDueNotificationDigestsParams{
+		Recipient: payload.Recipient,
+		Channel:   payload.Channel,
+		Now:       time.Now(),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(rows) > 0 {
+		if err := a.send(ctx, payload, rows); err != nil {
+			return time.Time{}, err
+		}
+
+		ids := make([]uuid.UUID, len(rows))
+		for i, row := range rows {
+			ids[i] = row.ID
+		}
+
+		if err := db.Queries().DeleteNotificationDigests(ctx, ids); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	next, err := db.Queries().GetNextNotificationDigest(ctx, entities.GetNextNotificationDigestParams{
+		Recipient: payload.Recipient,
+		Channel:   payload.Channel,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, nil
+	}
+
+	return next, err
+}
+
+// send delivers the notices as one digest.
+func (a *Digest) send(ctx context.Context, payload *defs.DigestPayload, rows []entities.ListDueNotificationDigestsRow) error {
+	items := make([]*eventsv1.DigestItem, len(rows))
+	for i, row := range rows {
+		items[i] = &eventsv1.DigestItem{
+			Kind:      row.Kind,
+			Repo:      row.RepoName,
+			Source:    row.RepoUrl,
+			Branch:    row.Branch,
+			Summary:   row.Summary,
+			CreatedAt: timestamppb.New(row.CreatedAt),
+		}
+	}
+
+	subject := events.Subject{
+		Name:   events.SubjectNameUsers,
+		ID:     payload.Recipient,
+		OrgID:  payload.OrgID,
+		UserID: payload.Recipient,
+	}
+
+	// on the channel of the team, the recipient is the repo.
+	if payload.Channel == defs.ChannelTeam {
+		subject.Name = events.SubjectNameRepos
+		subject.UserID = uuid.Nil
+	}
+
+	event := events.
+		New[eventsv1.ChatHook, eventsv1.Digest]().
+		SetScope(events.ScopeDigest).
+		SetAction(events.ActionCreated).
+		SetSubject(subject).
+		SetPayload(&eventsv1.Digest{Items: items})
+
+	chat, err := Route(ctx, event, payload.Channel)
+	if err != nil || chat == nil {
+		return err
+	}
+
+	digest, ok := chat.(kernel.ChatDigest)
+	if !ok {
+		slog.Warn("chat: hook does not support digests, dropping notices", "hook", event.Context.Hook.String(), "count", len(items))
+		return nil
+	}
+
+	if err := digest.NotifyDigest(ctx, event); err != nil {
+		slog.Warn("unable to deliver digest on chat", "hook", event.Context.Hook.String(), "error", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/core/notify/config"
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/core/notify/fns"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+// Sent records the notice as sent on the channel of the decision, for the deduplication and the rate limit of the
+// recipient. Recorded once delivered, so that a retry of a failed delivery is not taken for a duplicate.
+func Sent(ctx context.Context, decision *defs.Decision, notice *defs.Notice) error {
+	now := time.Now()
+
+	if err := remember(ctx, notice, now); err != nil {
+		return err
+	}
+
+	cfg := config.Instance()
+	if !cfg.Throttled() {
+		return nil
+	}
+
+	_, err := db.Queries().IncrementNotificationRate(ctx, entities.IncrementNotificationRateParams{
+		Recipient: notice.Recipient,
+		Channel:   decision.Channel,
+		Now:       now,
+		Expired:   now.Add(-cfg.RateWindow),
+	})
+
+	return err
+}
+
+// is_duplicate reports whether the notice was already sent within the cooldown.
+func is_duplicate(ctx context.Context, notice *defs.Notice, now time.Time) (bool, error) {
+	cfg := config.Instance()
+	if cfg.Cooldown <= 0 {
+		return false, nil
+	}
+
+	last, err := db.Queries().GetNotificationDedup(ctx, entities.GetNotificationDedupParams{
+		RepoID: notice.RepoID,
+		Branch: notice.Branch,
+		Kind:   notice.Kind,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return fns.IsDuplicate(notice.Fingerprint, last.Fingerprint, last.SentAt, cfg.Cooldown, now), nil
+}
+
+// throttle defers the decision to send to the end of the window of the rate limit, if the recipient has reached it on
+// the channel.
+func throttle(ctx context.Context, decision *defs.Decision, notice *defs.Notice, now time.Time) error {
+	cfg := config.Instance()
+	if decision.Action != defs.ActionSend || !cfg.Throttled() {
+		return nil
+	}
+
+	rate, err := db.Queries().GetNotificationRate(ctx, entities.GetNotificationRateParams{
+		Recipient: notice.Recipient,
+		Channel:   decision.Channel,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	fns.Throttle(decision, int(rate.Count), cfg.RateLimit, rate.WindowStart, cfg.RateWindow, now)
+
+	return nil
+}
+
+// remember records the fingerprint of the notice as the last one sent of its kind on the branch.
+func remember(ctx context.Context, notice *defs.Notice, now time.Time) error {
+	_, err := db.Queries().UpsertNotificationDedup(ctx, entities.UpsertNotificationDedupParams{
+		RepoID:      notice.RepoID,
+		Branch:      notice.Branch,
+		Kind:        notice.Kind,
+		Fingerprint: notice.Fingerprint,
+		SentAt:      now,
+	})
+
+	return err
+}
+
+// forget clears the notices sent on the branch whose state ends with the notice, so that they are no longer taken for
+// duplicates.
+func forget(ctx context.Context, notice *defs.Notice) error {
+	kinds := fns.Ends(notice.Kind)
+	if len(kinds) == 0 {
+		return nil
+	}
+
+	return db.Queries().DeleteNotificationDedup(ctx, entities.DeleteNotificationDedupParams{
+		RepoID: notice.RepoID,
+		Branch: notice.Branch,
+		Kinds:  kinds,
+	})
+}
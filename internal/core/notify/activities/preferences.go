@@ -0,0 +1,197 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/notify/cast"
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/core/notify/fns"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+const (
+	WorkflowDigest = "NotificationDigest" // WorkflowDigest is string representation of workflows.NotificationDigest
+)
+
+// Consult consults the notification preferences of the user the subject belongs to, falling back to the preferences
+// of the team of the user, and decides what to do with the notice. The preferences do not apply to the notifications
+// without a user, they are sent right away on the channel of the repo. The notices already sent within the cooldown
+// are skipped, and the ones above the rate limit of the recipient on the channel are deferred to the digest. Consult
+// sets the recipient of the notice for the channel decided on.
+func Consult(ctx context.Context, subject events.Subject, notice *defs.Notice) (*defs.Decision, error) {
+	now := time.Now()
+
+	if err := forget(ctx, notice); err != nil {
+		return nil, err
+	}
+
+	decision := &defs.Decision{Action: defs.ActionSend, Channel: defs.ChannelTeam}
+
+	if subject.UserID != uuid.Nil {
+		pref, err := Preference(ctx, subject.UserID)
+		if err != nil {
+			return nil, err
+		}
+
+		decision = fns.Decide(pref, notice.Kind, now)
+	}
+
+	if decision.Action == defs.ActionSkip {
+		slog.Debug("chat: muted, skipping notification", "kind", notice.Kind, "user_id", subject.UserID)
+		return decision, nil
+	}
+
+	notice.Recipient = subject.UserID
+	if decision.Channel == defs.ChannelTeam {
+		notice.Recipient = subject.ID
+	}
+
+	duplicate, err := is_duplicate(ctx, notice, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if duplicate {
+		slog.Debug("chat: already sent, skipping notification", "kind", notice.Kind, "repo_id", notice.RepoID, "branch", notice.Branch)
+
+		decision.Action = defs.ActionSkip
+
+		return decision, nil
+	}
+
+	if err := throttle(ctx, decision, notice, now); err != nil {
+		return nil, err
+	}
+
+	return decision, nil
+}
+
+// Preference returns the effective notification preference of the user.
+func Preference(ctx context.Context, user_id uuid.UUID) (*defs.Preference, error) {
+	own, err := db.Queries().GetNotificationPreference(ctx, user_id)
+	if err == nil {
+		return cast.EntityToPreference(&own), nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	team, err := db.Queries().GetTeamNotificationPreferenceByUser(ctx, user_id)
+	if err == nil {
+		return cast.EntityToPreference(&team), nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	return defs.DefaultPreference(), nil
+}
+
+// Defer saves the notice for the digest of the recipient on the channel of the decision, and signals the workflow
+// delivering the digest, starting it if it is not running. The notice counts as sent for the deduplication.
+func Defer(ctx context.Context, decision *defs.Decision, notice *defs.Notice) error {
+	if err := remember(ctx, notice, time.Now()); err != nil {
+		return err
+	}
+
+	_, err := db.Queries().CreateNotificationDigest(ctx, entities.CreateNotificationDigestParams{
+		Recipient:    notice.Recipient,
+		Channel:      decision.Channel,
+		RepoID:       notice.RepoID,
+		Kind:         notice.Kind,
+		Branch:       notice.Branch,
+		Summary:      notice.Summary,
+		DeliverAfter: decision.DeliverAfter,
+	})
+	if err != nil {
+		return err
+	}
+
+	state := &defs.DigestState{
+		OrgID:     notice.OrgID,
+		Recipient: notice.Recipient,
+		Channel:   decision.Channel,
+		Next:      decision.DeliverAfter,
+	}
+
+	_, err = durable.OnCore().SignalWithStartWorkflow(
+		ctx,
+		defs.DigestWorkflowOptions(notice.OrgID, notice.Recipient, decision.Channel),
+		defs.SignalDigest,
+		decision.DeliverAfter,
+		WorkflowDigest,
+		state,
+	)
+
+	return err
+}
+
+// Route sets the subject of the event up for delivery on the channel, and returns the chat hook delivering it. On the
+// channel of the team, the notification goes to the channel linked to the repo instead of the user. On email, the
+// notification goes to the email hook whatever the chat linked to the user. Returns a nil chat if there is nothing to
+// deliver the notification with.
+func Route[P events.Payload](
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, P], channel string,
+) (kernel.Chat, error) {
+	if channel == defs.ChannelTeam {
+		event.Subject.UserID = uuid.Nil
+	}
+
+	chat, hook, err := Chat(ctx, event.Subject, channel)
+	if err != nil || chat == nil {
+		return nil, err
+	}
+
+	event.SetHook(hook)
+
+	return chat, nil
+}
+
+// Chat returns the chat hook delivering the notifications of the subject on the channel. The chat linked to the user
+// the subject belongs to is used if set, else the chat linked to the subject itself, i.e. the repo. Returns a nil chat
+// if the subject is not linked to a chat, or if the hook is not registered.
+func Chat(ctx context.Context, subject events.Subject, channel string) (kernel.Chat, eventsv1.ChatHook, error) {
+	if channel == defs.ChannelEmail {
+		return chat(eventsv1.ChatHook_CHAT_HOOK_EMAIL, subject.UserID)
+	}
+
+	link_to := subject.ID
+	if subject.UserID != uuid.Nil {
+		link_to = subject.UserID
+	}
+
+	link, err := db.Queries().GetChatLink(ctx, link_to)
+	if errors.Is(err, pgx.ErrNoRows) {
+		slog.Debug("chat: no link, skipping notification", "link_to", link_to)
+		return nil, eventsv1.ChatHook_CHAT_HOOK_UNSPECIFIED, nil
+	}
+
+	if err != nil {
+		return nil, eventsv1.ChatHook_CHAT_HOOK_UNSPECIFIED, err
+	}
+
+	return chat(eventsv1.ChatHook(link.Hook), link_to)
+}
+
+func chat(hook eventsv1.ChatHook, link_to uuid.UUID) (kernel.Chat, eventsv1.ChatHook, error) {
+	chat := kernel.Get().ChatHook(hook)
+	if chat == nil {
+		slog.Warn("chat: hook not registered, skipping notification", "link_to", link_to, "hook", hook.String())
+		return nil, hook, nil
+	}
+
+	return chat, hook, nil
+}
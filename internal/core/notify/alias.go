@@ -0,0 +1,106 @@
+// Package notify consults the notification preferences of the users and of the teams before the notifications are
+// sent on chat, and delivers the notifications deferred by them as a digest. The notifications already sent are not
+// sent again within a cooldown, and the notifications to a recipient are rate limited per channel.
+package notify
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/notify/activities"
+	"go.breu.io/quantm/internal/core/notify/config"
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/core/notify/fns"
+	"go.breu.io/quantm/internal/core/notify/nomad"
+	"go.breu.io/quantm/internal/core/notify/workflows"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Config holds the limits applied to the notifications before they are sent on chat.
+	Config = config.Config
+
+	// Decision tells what to do with a notification, and where to deliver it.
+	Decision = defs.Decision
+
+	// Notice describes a notification on a branch, as deduplicated and as deferred to the digest.
+	Notice = defs.Notice
+)
+
+const (
+	KindLinesExceeded     = defs.KindLinesExceeded
+	KindMergeConflict     = defs.KindMergeConflict
+	KindBranchDrift       = defs.KindBranchDrift
+	KindConflictResolved  = defs.KindConflictResolved
+	KindPullRequestMerged = defs.KindPullRequestMerged
+)
+
+const (
+	ChannelDM    = defs.ChannelDM
+	ChannelTeam  = defs.ChannelTeam
+	ChannelEmail = defs.ChannelEmail
+)
+
+const (
+	ActionSend  = defs.ActionSend
+	ActionDefer = defs.ActionDefer
+	ActionSkip  = defs.ActionSkip
+)
+
+var (
+	DefaultConfig = config.DefaultConfig
+
+	WithConfig = config.WithConfig
+	Configure  = config.Instance
+)
+
+var (
+	// Consult decides what to do with a notification according to the preferences and the limits of its recipient.
+	Consult = activities.Consult
+
+	// Defer saves a notification for the digest of its recipient.
+	Defer = activities.Defer
+
+	// Sent records a notification as sent, for the deduplication and the rate limit of its recipient.
+	Sent = activities.Sent
+
+	// Chat returns the chat hook delivering the notifications of the subject on the channel.
+	Chat = activities.Chat
+)
+
+var (
+	// DiffNotice describes a change exceeding the line threshold.
+	DiffNotice = fns.DiffNotice
+
+	// MergeConflictNotice describes a merge conflict.
+	MergeConflictNotice = fns.MergeConflictNotice
+
+	// ConflictResolvedNotice describes a resolved merge conflict.
+	ConflictResolvedNotice = fns.ConflictResolvedNotice
+
+	// DriftNotice describes a branch drifting behind the default branch.
+	DriftNotice = fns.DriftNotice
+
+	// PullRequestMergedNotice describes a merged pull request.
+	PullRequestMergedNotice = fns.PullRequestMergedNotice
+)
+
+var (
+	// DigestWorkflow delivers the deferred notifications of a recipient on a channel.
+	DigestWorkflow = workflows.NotificationDigest
+
+	NomadHandler = nomad.NewNotificationServiceHandler
+)
+
+// NewDigestActivities returns the activities delivering the deferred notifications.
+func NewDigestActivities() *activities.Digest {
+	return &activities.Digest{}
+}
+
+// Route sets the subject of the event up for delivery on the channel, and returns the chat hook delivering it.
+func Route[P events.Payload](
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, P], channel string,
+) (kernel.Chat, error) {
+	return activities.Route(ctx, event, channel)
+}
@@ -0,0 +1,88 @@
+package cast
+
+import (
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/db/entities"
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+)
+
+var (
+	channels = map[string]corev1.NotificationChannel{
+		defs.ChannelDM:    corev1.NotificationChannel_NOTIFICATION_CHANNEL_DM,
+		defs.ChannelTeam:  corev1.NotificationChannel_NOTIFICATION_CHANNEL_TEAM,
+		defs.ChannelEmail: corev1.NotificationChannel_NOTIFICATION_CHANNEL_EMAIL,
+	}
+)
+
+// EntityToPreference converts a NotificationPreference entity to the effective preference.
+func EntityToPreference(entity *entities.NotificationPreference) *defs.Preference {
+	return &defs.Preference{
+		Muted:      entity.Muted,
+		Channel:    entity.Channel,
+		Timezone:   entity.Timezone,
+		QuietStart: entity.QuietStart,
+		QuietEnd:   entity.QuietEnd,
+		Digest:     entity.Digest,
+		DigestHour: entity.DigestHour,
+	}
+}
+
+// PreferenceToProto converts the preference of the user or the team to a NotificationPreference proto.
+func PreferenceToProto(link_to uuid.UUID, pref *defs.Preference) *corev1.NotificationPreference {
+	return &corev1.NotificationPreference{
+		LinkTo:     link_to.String(),
+		Muted:      pref.Muted,
+		Channel:    ChannelToProto(pref.Channel),
+		Timezone:   pref.Timezone,
+		QuietStart: pref.QuietStart,
+		QuietEnd:   pref.QuietEnd,
+		Digest:     pref.Digest,
+		DigestHour: pref.DigestHour,
+	}
+}
+
+// ProtoToUpsertPreferenceParams converts a NotificationPreference proto to the params saving it for the owner. The
+// unspecified channel falls back to direct messages, and the empty timezone to UTC.
+func ProtoToUpsertPreferenceParams(
+	owner string, link_to uuid.UUID, proto *corev1.NotificationPreference,
+) entities.UpsertNotificationPreferenceParams {
+	params := entities.UpsertNotificationPreferenceParams{
+		Kind:       owner,
+		LinkTo:     link_to,
+		Muted:      proto.GetMuted(),
+		Channel:    ProtoToChannel(proto.GetChannel()),
+		Timezone:   proto.GetTimezone(),
+		QuietStart: proto.GetQuietStart(),
+		QuietEnd:   proto.GetQuietEnd(),
+		Digest:     proto.GetDigest(),
+		DigestHour: proto.GetDigestHour(),
+	}
+
+	if params.Muted == nil {
+		params.Muted = []string{}
+	}
+
+	if params.Timezone == "" {
+		params.Timezone = defs.DefaultTimezone
+	}
+
+	return params
+}
+
+// ChannelToProto converts a channel to a NotificationChannel proto.
+func ChannelToProto(channel string) corev1.NotificationChannel {
+	return channels[channel]
+}
+
+// ProtoToChannel converts a NotificationChannel proto to a channel.
+func ProtoToChannel(proto corev1.NotificationChannel) string {
+	for channel, value := range channels {
+		if value == proto {
+			return channel
+		}
+	}
+
+	return defs.ChannelDM
+}
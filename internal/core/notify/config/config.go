@@ -0,0 +1,66 @@
+package config
+
+import (
+	"sync"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	_once sync.Once
+	_c    *Config
+)
+
+type (
+	// Config holds the limits applied to the notifications before they are sent on chat.
+	Config struct {
+		Cooldown   time.Duration `koanf:"COOLDOWN" validate:"min=0"`    // Time an identical notification is not sent again for, 0 disables it.
+		RateLimit  int           `koanf:"RATE_LIMIT" validate:"min=0"`  // Notifications sent per recipient and channel in a window, 0 disables it.
+		RateWindow time.Duration `koanf:"RATE_WINDOW" validate:"min=0"` // Window the rate limit applies to.
+	}
+
+	ConfigOption func(*Config)
+)
+
+var (
+	// DefaultConfig defines the default limits of the notifications.
+	DefaultConfig = Config{
+		Cooldown:   6 * time.Hour,
+		RateLimit:  20,
+		RateWindow: time.Hour,
+	}
+)
+
+func (c *Config) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// Throttled reports whether the notifications are rate limited.
+func (c *Config) Throttled() bool {
+	return c.RateLimit > 0 && c.RateWindow > 0
+}
+
+// WithConfig copies the values from the given Config into the target Config.
+func WithConfig(cfg *Config) ConfigOption {
+	return func(config *Config) {
+		config.Cooldown = cfg.Cooldown
+		config.RateLimit = cfg.RateLimit
+		config.RateWindow = cfg.RateWindow
+	}
+}
+
+// Instance returns the singleton instance of the notification configuration, the defaults if it is not configured.
+func Instance(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		cfg := DefaultConfig
+		_c = &cfg
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
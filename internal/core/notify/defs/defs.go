@@ -0,0 +1,113 @@
+package defs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.breu.io/durex/queues"
+)
+
+// kinds of notifications, as muted in the preferences.
+const (
+	KindLinesExceeded     = "lines_exceeded"
+	KindMergeConflict     = "merge_conflict"
+	KindBranchDrift       = "branch_drift"
+	KindConflictResolved  = "conflict_resolved"
+	KindPullRequestMerged = "pr_merged"
+)
+
+// channels the notifications are delivered on.
+const (
+	ChannelDM    = "dm"    // direct message on the chat linked to the user.
+	ChannelTeam  = "team"  // the channel linked to the repo.
+	ChannelEmail = "email" // the email address of the user.
+)
+
+// owners of the preferences.
+const (
+	OwnerUser = "user"
+	OwnerTeam = "team"
+)
+
+const (
+	DefaultTimezone   = "UTC"
+	DefaultDigestHour = 9
+)
+
+const (
+	SignalDigest queues.Signal = "digest" // signals a notification deferred to the digest.
+)
+
+// actions taken on a notification.
+const (
+	ActionSend  Action = iota // send the notification right away.
+	ActionDefer               // defer the notification to the digest.
+	ActionSkip                // drop the notification, the kind is muted or the notification was already sent.
+)
+
+type (
+	// Action is the outcome of consulting the preferences for a notification.
+	Action int
+
+	// Preference is the effective notification preference of a recipient.
+	Preference struct {
+		Muted      []string `json:"muted"`
+		Channel    string   `json:"channel"`
+		Timezone   string   `json:"timezone"`
+		QuietStart int32    `json:"quiet_start"` // minutes after midnight.
+		QuietEnd   int32    `json:"quiet_end"`   // minutes after midnight.
+		Digest     bool     `json:"digest"`
+		DigestHour int32    `json:"digest_hour"`
+	}
+
+	// Decision tells what to do with a notification, and where to deliver it.
+	Decision struct {
+		Action       Action    `json:"action"`
+		Channel      string    `json:"channel"`
+		DeliverAfter time.Time `json:"deliver_after"` // only set when deferred.
+	}
+
+	// Notice describes a notification on a branch, as deduplicated and as deferred to the digest.
+	Notice struct {
+		OrgID       uuid.UUID `json:"org_id"`
+		Recipient   uuid.UUID `json:"recipient"` // the user, or the repo when delivered on the channel of the repo.
+		RepoID      uuid.UUID `json:"repo_id"`
+		Kind        string    `json:"kind"`
+		Branch      string    `json:"branch"`
+		Summary     string    `json:"summary"`
+		Fingerprint string    `json:"fingerprint"` // identifies the content, notices with the same content are duplicates.
+	}
+
+	// DigestState is the state of the workflow delivering the digest of a recipient on a channel.
+	DigestState struct {
+		OrgID     uuid.UUID `json:"org_id"`
+		Recipient uuid.UUID `json:"recipient"`
+		Channel   string    `json:"channel"`
+		Next      time.Time `json:"next"` // the earliest deferred notice is due at.
+	}
+
+	// DigestPayload is the payload for delivering the digest of a recipient on a channel.
+	DigestPayload struct {
+		OrgID     uuid.UUID `json:"org_id"`
+		Recipient uuid.UUID `json:"recipient"`
+		Channel   string    `json:"channel"`
+	}
+)
+
+// DefaultPreference returns the preference of the recipients who have not set one, and whose teams have not either.
+// Everything is sent right away as a direct message.
+func DefaultPreference() *Preference {
+	return &Preference{
+		Muted:      []string{},
+		Channel:    ChannelDM,
+		Timezone:   DefaultTimezone,
+		DigestHour: DefaultDigestHour,
+	}
+}
+
+// Earliest moves the next delivery of the digest earlier, if the notice due at the given time is due before it.
+func (s *DigestState) Earliest(at time.Time) {
+	if s.Next.IsZero() || at.Before(s.Next) {
+		s.Next = at
+	}
+}
@@ -0,0 +1,23 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+	"go.breu.io/durex/workflows"
+
+	"go.breu.io/quantm/internal/durable"
+)
+
+// DigestWorkflowOptions returns workflow options for the digest of a recipient, designed for use with the Core Queue.
+// The workflow ID, when used with the Core Queue, is formatted as:
+//
+//	"ai.ctrlplane.core.org.{org}.notifications.{recipient}.channel.{channel}"
+func DigestWorkflowOptions(org_id, recipient uuid.UUID, channel string) workflows.Options {
+	opts := durable.NewWorkflowOptions(
+		durable.WithOrg(org_id.String()),
+		durable.WithSubject("notifications"),
+		durable.WithSubjectID(recipient.String()),
+		durable.WithMeta("channel", channel),
+	)
+
+	return opts
+}
@@ -0,0 +1,40 @@
+package fns
+
+import (
+	"time"
+
+	"go.breu.io/quantm/internal/core/notify/defs"
+)
+
+// Ends returns the kinds of notifications whose state ends with a notification of the kind. Once a conflict is
+// resolved, the same conflict appearing again is no longer a duplicate, and once the pull request is merged, nothing
+// notified on the branch is.
+func Ends(kind string) []string {
+	switch kind {
+	case defs.KindConflictResolved:
+		return []string{defs.KindMergeConflict}
+	case defs.KindPullRequestMerged:
+		return []string{defs.KindLinesExceeded, defs.KindMergeConflict, defs.KindBranchDrift, defs.KindConflictResolved}
+	default:
+		return nil
+	}
+}
+
+// IsDuplicate reports whether the notification with the fingerprint was already sent within the cooldown, given the
+// fingerprint of the last notification of the same kind on the branch and the time it was sent at.
+func IsDuplicate(fingerprint, last string, sent time.Time, cooldown time.Duration, now time.Time) bool {
+	return cooldown > 0 && fingerprint == last && now.Before(sent.Add(cooldown))
+}
+
+// Throttle defers the decision to send to the end of the window, if the recipient was sent as many notifications as
+// the limit within the window starting at the given time.
+func Throttle(decision *defs.Decision, count, limit int, start time.Time, window time.Duration, now time.Time) {
+	end := start.Add(window)
+
+	if decision.Action != defs.ActionSend || limit <= 0 || count < limit || !now.Before(end) {
+		return
+	}
+
+	decision.Action = defs.ActionDefer
+	decision.DeliverAfter = end
+}
@@ -0,0 +1,57 @@
+package fns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/core/notify/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func TestIsDuplicate(t *testing.T) {
+	sent := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+
+	assert.True(t, fns.IsDuplicate("a", "a", sent, time.Hour, sent.Add(59*time.Minute)))
+	assert.False(t, fns.IsDuplicate("a", "a", sent, time.Hour, sent.Add(time.Hour)))
+	assert.False(t, fns.IsDuplicate("b", "a", sent, time.Hour, sent.Add(time.Minute)))
+	assert.False(t, fns.IsDuplicate("a", "a", sent, 0, sent.Add(time.Minute)))
+}
+
+func TestThrottle(t *testing.T) {
+	start := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+
+	decision := &defs.Decision{Action: defs.ActionSend, Channel: defs.ChannelDM}
+	fns.Throttle(decision, 4, 5, start, time.Hour, start.Add(time.Minute))
+	assert.Equal(t, defs.ActionSend, decision.Action)
+
+	fns.Throttle(decision, 5, 5, start, time.Hour, start.Add(2*time.Hour))
+	assert.Equal(t, defs.ActionSend, decision.Action, "the window is over")
+
+	fns.Throttle(decision, 5, 5, start, time.Hour, start.Add(time.Minute))
+	assert.Equal(t, defs.ActionDefer, decision.Action)
+	assert.Equal(t, start.Add(time.Hour), decision.DeliverAfter)
+}
+
+func TestMergeConflictNotice(t *testing.T) {
+	one := fns.MergeConflictNotice(&eventsv1.Merge{
+		HeadBranch: "feature",
+		BaseBranch: "main",
+		BaseCommit: &eventsv1.Commit{Sha: "a1"},
+		Files:      []string{"b.go", "a.go"},
+	})
+	other := fns.MergeConflictNotice(&eventsv1.Merge{
+		HeadBranch: "feature",
+		BaseBranch: "main",
+		BaseCommit: &eventsv1.Commit{Sha: "b2"},
+		Files:      []string{"a.go", "b.go"},
+	})
+	changed := fns.MergeConflictNotice(&eventsv1.Merge{HeadBranch: "feature", BaseBranch: "main", Files: []string{"a.go"}})
+
+	assert.Equal(t, defs.KindMergeConflict, one.Kind)
+	assert.Equal(t, "feature", one.Branch)
+	assert.Equal(t, one.Fingerprint, other.Fingerprint, "the same conflict found on another commit of the default branch")
+	assert.NotEqual(t, one.Fingerprint, changed.Fingerprint)
+}
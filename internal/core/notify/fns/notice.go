@@ -0,0 +1,78 @@
+package fns
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.breu.io/quantm/internal/core/notify/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// DiffNotice describes a change exceeding the line threshold. The change is notified again each time it grows by
+// another threshold.
+func DiffNotice(diff *eventsv1.Diff) *defs.Notice {
+	lines := diff.GetLines().GetAdded() + diff.GetLines().GetRemoved()
+	threshold := max(diff.GetThreshold(), 1)
+
+	return &defs.Notice{
+		Kind:        defs.KindLinesExceeded,
+		Branch:      diff.GetBranch(),
+		Summary:     fmt.Sprintf("%d lines changed, above the threshold of %d", lines, diff.GetThreshold()),
+		Fingerprint: Fingerprint(fmt.Sprint(diff.GetThreshold()), fmt.Sprint(lines/threshold)),
+	}
+}
+
+// MergeConflictNotice describes a merge conflict. The conflict is notified again only if the conflicting files change,
+// whatever the commit of the default branch it was found on.
+func MergeConflictNotice(merge *eventsv1.Merge) *defs.Notice {
+	files := slices.Clone(merge.GetFiles())
+	slices.Sort(files)
+
+	return &defs.Notice{
+		Kind:        defs.KindMergeConflict,
+		Branch:      merge.GetHeadBranch(),
+		Summary:     fmt.Sprintf("conflicts with %s in %d files", merge.GetBaseBranch(), len(merge.GetFiles())),
+		Fingerprint: Fingerprint(append([]string{merge.GetBaseBranch()}, files...)...),
+	}
+}
+
+// ConflictResolvedNotice describes a resolved merge conflict.
+func ConflictResolvedNotice(merge *eventsv1.Merge) *defs.Notice {
+	return &defs.Notice{
+		Kind:        defs.KindConflictResolved,
+		Branch:      merge.GetHeadBranch(),
+		Summary:     fmt.Sprintf("no longer conflicts with %s", merge.GetBaseBranch()),
+		Fingerprint: Fingerprint(merge.GetBaseBranch(), merge.GetHeadCommit().GetSha()),
+	}
+}
+
+// DriftNotice describes a branch drifting behind the default branch. The drift is a reminder, it is notified again
+// once the cooldown is over.
+func DriftNotice(drift *eventsv1.Drift) *defs.Notice {
+	return &defs.Notice{
+		Kind:        defs.KindBranchDrift,
+		Branch:      drift.GetBranch(),
+		Summary:     fmt.Sprintf("%d commits behind %s", drift.GetBehind(), drift.GetTrunk()),
+		Fingerprint: Fingerprint(drift.GetTrunk()),
+	}
+}
+
+// PullRequestMergedNotice describes a merged pull request.
+func PullRequestMergedNotice(pr *eventsv1.PullRequest) *defs.Notice {
+	return &defs.Notice{
+		Kind:        defs.KindPullRequestMerged,
+		Branch:      pr.GetHeadBranch(),
+		Summary:     fmt.Sprintf("#%d merged into %s", pr.GetNumber(), pr.GetBaseBranch()),
+		Fingerprint: Fingerprint(fmt.Sprint(pr.GetNumber())),
+	}
+}
+
+// Fingerprint hashes the parts identifying the content of a notification.
+func Fingerprint(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+
+	return hex.EncodeToString(sum[:16])
+}
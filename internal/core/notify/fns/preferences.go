@@ -0,0 +1,83 @@
+package fns
+
+import (
+	"slices"
+	"time"
+	_ "time/tzdata" // the timezones of the preferences are resolved without relying on the host.
+
+	"go.breu.io/quantm/internal/core/notify/defs"
+)
+
+const (
+	minutes_per_day = 24 * 60
+)
+
+// IsLowPriority reports whether the notifications of the kind can wait for the digest, i.e. the size warnings and the
+// stale branch reminders.
+func IsLowPriority(kind string) bool {
+	return kind == defs.KindLinesExceeded || kind == defs.KindBranchDrift
+}
+
+// Decide decides what to do with a notification of the kind at the given time. Muted kinds are skipped. With the
+// digest on, the low priority notifications are deferred to the next digest. Any other notification is deferred to
+// the end of the quiet hours if given within them, and sent right away otherwise.
+func Decide(pref *defs.Preference, kind string, now time.Time) *defs.Decision {
+	decision := &defs.Decision{Action: defs.ActionSend, Channel: pref.Channel}
+
+	if slices.Contains(pref.Muted, kind) {
+		decision.Action = defs.ActionSkip
+		return decision
+	}
+
+	local := now.In(Location(pref.Timezone))
+
+	switch {
+	case pref.Digest && IsLowPriority(kind):
+		decision.Action = defs.ActionDefer
+		decision.DeliverAfter = next(local, pref.DigestHour*60)
+	case IsQuiet(pref, local):
+		decision.Action = defs.ActionDefer
+		decision.DeliverAfter = next(local, pref.QuietEnd)
+	}
+
+	return decision
+}
+
+// IsQuiet reports whether the time is within the quiet hours of the preference. The quiet hours may span midnight,
+// e.g. from 22:00 to 07:00.
+func IsQuiet(pref *defs.Preference, now time.Time) bool {
+	if pref.QuietStart == pref.QuietEnd {
+		return false
+	}
+
+	local := now.In(Location(pref.Timezone))
+	minute := int32(local.Hour()*60 + local.Minute())
+
+	if pref.QuietStart < pref.QuietEnd {
+		return minute >= pref.QuietStart && minute < pref.QuietEnd
+	}
+
+	return minute >= pref.QuietStart || minute < pref.QuietEnd
+}
+
+// Location returns the timezone with the given IANA name, falling back to UTC for an unknown one.
+func Location(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// next returns the next time after now at the given minute of the day, in the timezone of now.
+func next(now time.Time, minute int32) time.Time {
+	minute = minute % minutes_per_day
+	at := time.Date(now.Year(), now.Month(), now.Day(), int(minute/60), int(minute%60), 0, 0, now.Location())
+
+	if !at.After(now) {
+		at = time.Date(now.Year(), now.Month(), now.Day()+1, int(minute/60), int(minute%60), 0, 0, now.Location())
+	}
+
+	return at
+}
@@ -0,0 +1,88 @@
+package fns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/core/notify/fns"
+)
+
+func TestDecide(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	pref := &defs.Preference{
+		Muted:      []string{defs.KindPullRequestMerged},
+		Channel:    defs.ChannelEmail,
+		Timezone:   "Europe/Berlin",
+		QuietStart: 22 * 60,
+		QuietEnd:   7*60 + 30,
+		Digest:     true,
+		DigestHour: 9,
+	}
+
+	tests := []struct {
+		name   string
+		kind   string
+		now    time.Time
+		action defs.Action
+		after  time.Time
+	}{
+		{
+			name:   "muted",
+			kind:   defs.KindPullRequestMerged,
+			now:    time.Date(2024, 5, 2, 12, 0, 0, 0, berlin),
+			action: defs.ActionSkip,
+		},
+		{
+			name:   "send outside of the quiet hours",
+			kind:   defs.KindMergeConflict,
+			now:    time.Date(2024, 5, 2, 12, 0, 0, 0, berlin),
+			action: defs.ActionSend,
+		},
+		{
+			name:   "defer to the end of the quiet hours, across midnight",
+			kind:   defs.KindMergeConflict,
+			now:    time.Date(2024, 5, 2, 23, 15, 0, 0, berlin),
+			action: defs.ActionDefer,
+			after:  time.Date(2024, 5, 3, 7, 30, 0, 0, berlin),
+		},
+		{
+			name:   "defer low priority to the digest of the same day",
+			kind:   defs.KindBranchDrift,
+			now:    time.Date(2024, 5, 2, 6, 0, 0, 0, berlin),
+			action: defs.ActionDefer,
+			after:  time.Date(2024, 5, 2, 9, 0, 0, 0, berlin),
+		},
+		{
+			name:   "defer low priority to the digest of the next day",
+			kind:   defs.KindLinesExceeded,
+			now:    time.Date(2024, 5, 2, 9, 0, 0, 0, berlin).In(time.UTC),
+			action: defs.ActionDefer,
+			after:  time.Date(2024, 5, 3, 9, 0, 0, 0, berlin),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision := fns.Decide(pref, tt.kind, tt.now)
+
+			assert.Equal(t, tt.action, decision.Action)
+			assert.Equal(t, defs.ChannelEmail, decision.Channel)
+			assert.True(t, tt.after.Equal(decision.DeliverAfter), "deliver after %s, got %s", tt.after, decision.DeliverAfter)
+		})
+	}
+}
+
+func TestIsQuiet(t *testing.T) {
+	pref := &defs.Preference{Timezone: "UTC", QuietStart: 12 * 60, QuietEnd: 13 * 60}
+
+	assert.False(t, fns.IsQuiet(pref, time.Date(2024, 5, 2, 11, 59, 0, 0, time.UTC)))
+	assert.True(t, fns.IsQuiet(pref, time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)))
+	assert.False(t, fns.IsQuiet(pref, time.Date(2024, 5, 2, 13, 0, 0, 0, time.UTC)))
+
+	// equal start and end, no quiet hours.
+	assert.False(t, fns.IsQuiet(defs.DefaultPreference(), time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)))
+}
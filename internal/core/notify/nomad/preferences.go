@@ -0,0 +1,126 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/notify/cast"
+	"go.breu.io/quantm/internal/core/notify/defs"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/core/v1/corev1connect"
+)
+
+type (
+	NotificationService struct {
+		corev1connect.UnimplementedNotificationServiceHandler
+	}
+)
+
+// GetNotificationPreference returns the preferences of the user or of the team, the defaults if none are set.
+func (s *NotificationService) GetNotificationPreference(
+	ctx context.Context, req *connect.Request[corev1.GetNotificationPreferenceRequest],
+) (*connect.Response[corev1.GetNotificationPreferenceResponse], error) {
+	link_to, err := uuid.Parse(req.Msg.GetLinkTo())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("link_to", req.Msg.GetLinkTo()).Wrap(err)
+	}
+
+	if _, err := s.owner(ctx, link_to, false); err != nil {
+		return nil, err
+	}
+
+	pref := defs.DefaultPreference()
+
+	entity, err := db.Queries().GetNotificationPreference(ctx, link_to)
+	if err == nil {
+		pref = cast.EntityToPreference(&entity)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", req.Msg.GetLinkTo()).Wrap(err)
+	}
+
+	proto := cast.PreferenceToProto(link_to, pref)
+
+	return connect.NewResponse(&corev1.GetNotificationPreferenceResponse{Preference: proto}), nil
+}
+
+// SetNotificationPreference saves the preferences of the user, or of the team if the user is an admin of it.
+func (s *NotificationService) SetNotificationPreference(
+	ctx context.Context, req *connect.Request[corev1.SetNotificationPreferenceRequest],
+) (*connect.Response[corev1.SetNotificationPreferenceResponse], error) {
+	msg := req.Msg.GetPreference()
+
+	link_to, err := uuid.Parse(msg.GetLinkTo())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("link_to", msg.GetLinkTo()).Wrap(err)
+	}
+
+	if _, err := time.LoadLocation(msg.GetTimezone()); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("timezone", msg.GetTimezone()).Wrap(err)
+	}
+
+	owner, err := s.owner(ctx, link_to, true)
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := db.Queries().UpsertNotificationPreference(ctx, cast.ProtoToUpsertPreferenceParams(owner, link_to, msg))
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", msg.GetLinkTo()).Wrap(err)
+	}
+
+	proto := cast.PreferenceToProto(link_to, cast.EntityToPreference(&entity))
+
+	return connect.NewResponse(&corev1.SetNotificationPreferenceResponse{Preference: proto}), nil
+}
+
+// owner returns whether link_to is the authenticated user or one of the teams of the user. Changing the preferences
+// of a team requires the user to be an admin of it.
+func (s *NotificationService) owner(ctx context.Context, link_to uuid.UUID, admin bool) (string, error) {
+	user_id, org_id := auth.NomadAuthContext(ctx)
+
+	if link_to == user_id {
+		return defs.OwnerUser, nil
+	}
+
+	team, err := db.Queries().GetTeam(ctx, link_to)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", erratic.NewNotFoundError(erratic.CoreModule, "team").AddHint("link_to", link_to.String())
+		}
+
+		return "", erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", link_to.String()).Wrap(err)
+	}
+
+	if team.OrgID != org_id {
+		return "", erratic.NewNotFoundError(erratic.CoreModule, "team").AddHint("link_to", link_to.String())
+	}
+
+	member, err := db.Queries().GetTeamUserByTeam(ctx, entities.GetTeamUserByTeamParams{TeamID: team.ID, UserID: user_id})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", erratic.NewAuthzError(erratic.CoreModule).WithReason("not a member of the team")
+		}
+
+		return "", erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", link_to.String()).Wrap(err)
+	}
+
+	if admin && !member.IsAdmin && member.Role != entities.TeamRoleAdmin {
+		return "", erratic.NewAuthzError(erratic.CoreModule).WithReason("not an admin of the team")
+	}
+
+	return defs.OwnerTeam, nil
+}
+
+func NewNotificationServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return corev1connect.NewNotificationServiceHandler(&NotificationService{}, opts...)
+}
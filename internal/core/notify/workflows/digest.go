@@ -0,0 +1,61 @@
+package workflows
+
+import (
+	"time"
+
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/notify/activities"
+	"go.breu.io/quantm/internal/core/notify/defs"
+)
+
+// NotificationDigest delivers the notifications of a recipient on a channel deferred by the notification preferences,
+// i.e. the daily digest and the notifications held back during the quiet hours. The workflow sleeps until the earliest
+// notice is due, delivers every notice due by then as one digest, and sleeps again until the next one. Each deferred
+// notice signals the time it is due at, starting the workflow if it is not running. The workflow completes once no
+// notice is left.
+func NotificationDigest(ctx workflow.Context, state *defs.DigestState) error {
+	acts := &activities.Digest{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	payload := &defs.DigestPayload{OrgID: state.OrgID, Recipient: state.Recipient, Channel: state.Channel}
+	signal := workflow.GetSignalChannel(ctx, defs.SignalDigest.String())
+
+	for !state.Next.IsZero() {
+		if wait := state.Next.Sub(workflow.Now(ctx)); wait > 0 {
+			timer_ctx, cancel := workflow.WithCancel(ctx)
+			due := false
+
+			selector := workflow.NewSelector(ctx)
+			selector.AddFuture(workflow.NewTimer(timer_ctx, wait), func(workflow.Future) { due = true })
+			selector.AddReceive(signal, func(rx workflow.ReceiveChannel, _ bool) {
+				at := time.Time{}
+				rx.Receive(ctx, &at)
+				state.Earliest(at)
+			})
+
+			selector.Select(ctx)
+			cancel()
+
+			if !due {
+				continue
+			}
+		}
+
+		next := time.Time{}
+		if err := workflow.ExecuteActivity(ctx, acts.SendNotificationDigest, payload).Get(ctx, &next); err != nil {
+			return err
+		}
+
+		state.Next = next
+
+		// the notices deferred while delivering are already saved, and may be due before the next one.
+		at := time.Time{}
+		for signal.ReceiveAsync(&at) {
+			state.Earliest(at)
+		}
+	}
+
+	return nil
+}
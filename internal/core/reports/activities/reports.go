@@ -0,0 +1,177 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/notify"
+	"go.breu.io/quantm/internal/core/reports/cast"
+	"go.breu.io/quantm/internal/core/reports/defs"
+	"go.breu.io/quantm/internal/core/reports/fns"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+const (
+	WorkflowReports = "OrgReports" // WorkflowReports is string representation of workflows.OrgReports
+)
+
+type (
+	// Reports groups the activities delivering the scheduled reports of the repos and the teams.
+	Reports struct{}
+
+	// target is the repo or the team a report is on.
+	target struct {
+		subject events.Subject
+		name    string
+		source  string         // url of the repo, empty for a team.
+		by      pulse.ReportBy // column of the events of the repo or the team.
+	}
+)
+
+// SendDueReports delivers the reports of the org due at the given time, and returns the number of schedules enabled.
+// Each report is marked as sent once delivered, so that a retry only delivers the ones that failed. A report without a
+// chat to deliver it with is marked as sent all the same.
+func (a *Reports) SendDueReports(ctx context.Context, payload *defs.ReportsPayload) (int, error) {
+	rows, err := db.Queries().ListEnabledReportSchedulesByOrgID(ctx, payload.OrgID)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+
+	for _, row := range rows {
+		schedule := cast.EntityToSchedule(&row)
+		if !fns.IsDue(schedule, payload.At) {
+			continue
+		}
+
+		if err := a.send(ctx, payload, schedule); err != nil {
+			slog.Warn("reports: unable to send", "link_to", schedule.LinkTo, "kind", schedule.Kind, "error", err.Error())
+			errs = append(errs, err)
+
+			continue
+		}
+
+		params := entities.UpdateReportScheduleSentAtParams{ID: schedule.ID, SentAt: time.Now()}
+		if err := db.Queries().UpdateReportScheduleSentAt(ctx, params); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return len(rows), errors.Join(errs...)
+}
+
+// Start starts the workflow delivering the reports of the org, if it is not running.
+func Start(ctx context.Context, org_id, link_to uuid.UUID) error {
+	_, err := durable.OnCore().SignalWithStartWorkflow(
+		ctx,
+		defs.ReportsWorkflowOptions(org_id),
+		defs.SignalSchedule,
+		link_to,
+		WorkflowReports,
+		&defs.ReportsState{OrgID: org_id},
+	)
+
+	return err
+}
+
+// send delivers the report of the repo or the team of the schedule, on the chat linked to it.
+func (a *Reports) send(ctx context.Context, payload *defs.ReportsPayload, schedule *defs.Schedule) error {
+	target, err := a.target(ctx, payload.OrgID, schedule)
+	if err != nil {
+		return err
+	}
+
+	slug, err := db.Queries().GetOrgSlugByID(ctx, payload.OrgID)
+	if err != nil {
+		return err
+	}
+
+	from, to := fns.Period(schedule, payload.At)
+
+	counts, err := pulse.Report(ctx, slug, target.by, schedule.LinkTo, from, to)
+	if err != nil {
+		return err
+	}
+
+	event := events.
+		New[eventsv1.ChatHook, eventsv1.Report]().
+		SetScope(events.ScopeReport).
+		SetAction(events.ActionCreated).
+		SetSource(target.source).
+		SetSubject(target.subject).
+		SetPayload(cast.CountsToReport(schedule, target.name, target.source, from, to, counts, a.names(ctx, counts)))
+
+	chat, hook, err := notify.Chat(ctx, target.subject, notify.ChannelTeam)
+	if err != nil || chat == nil {
+		return err
+	}
+
+	event.SetHook(hook)
+
+	report, ok := chat.(kernel.ChatReport)
+	if !ok {
+		slog.Warn("reports: hook does not support reports, skipping", "hook", hook.String(), "link_to", schedule.LinkTo)
+		return nil
+	}
+
+	return report.NotifyReport(ctx, event)
+}
+
+// names returns the names of the repos of the largest pull requests. A repo that can not be found is left unnamed.
+func (a *Reports) names(ctx context.Context, counts *pulse.ReportCounts) map[uuid.UUID]string {
+	names := make(map[uuid.UUID]string)
+
+	for _, pr := range counts.Largest {
+		if _, ok := names[pr.SubjectID]; ok {
+			continue
+		}
+
+		repo, err := db.Queries().GetRepo(ctx, pr.SubjectID)
+		if err != nil {
+			slog.Warn("reports: unable to name repo", "repo", pr.SubjectID, "error", err.Error())
+		}
+
+		names[pr.SubjectID] = repo.Name
+	}
+
+	return names
+}
+
+// target returns the repo or the team of the schedule.
+func (a *Reports) target(ctx context.Context, org_id uuid.UUID, schedule *defs.Schedule) (*target, error) {
+	if schedule.Kind == defs.KindTeam {
+		team, err := db.Queries().GetTeam(ctx, schedule.LinkTo)
+		if err != nil {
+			return nil, err
+		}
+
+		return &target{
+			subject: events.Subject{Name: events.SubjectNameTeams, ID: team.ID, OrgID: org_id, TeamID: team.ID},
+			name:    team.Name,
+			by:      pulse.ReportByTeam,
+		}, nil
+	}
+
+	repo, err := db.Queries().GetRepo(ctx, schedule.LinkTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &target{
+		subject: events.Subject{Name: events.SubjectNameRepos, ID: repo.ID, OrgID: org_id},
+		name:    repo.Name,
+		source:  repo.Url,
+		by:      pulse.ReportByRepo,
+	}, nil
+}
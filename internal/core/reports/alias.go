@@ -0,0 +1,42 @@
+// Package reports delivers the scheduled reports of the repos and the teams on the chat linked to them. The reports
+// are built from the events persisted by pulse.
+package reports
+
+import (
+	"go.breu.io/quantm/internal/core/reports/activities"
+	"go.breu.io/quantm/internal/core/reports/defs"
+	"go.breu.io/quantm/internal/core/reports/fns"
+	"go.breu.io/quantm/internal/core/reports/nomad"
+	"go.breu.io/quantm/internal/core/reports/workflows"
+)
+
+type (
+	// Metric is a line of a report, as rendered on chat.
+	Metric = defs.Metric
+)
+
+var (
+	// Title returns the title of a report.
+	Title = fns.Title
+
+	// Span returns the period a report covers.
+	Span = fns.Span
+
+	// Metrics returns the known metrics of a report.
+	Metrics = fns.Metrics
+
+	// Largest returns the largest pull requests of a report, one line each.
+	Largest = fns.Largest
+)
+
+var (
+	// ReportsWorkflow delivers the scheduled reports of an org.
+	ReportsWorkflow = workflows.OrgReports
+
+	NomadHandler = nomad.NewReportServiceHandler
+)
+
+// NewReportsActivities returns the activities delivering the scheduled reports.
+func NewReportsActivities() *activities.Reports {
+	return &activities.Reports{}
+}
@@ -0,0 +1,52 @@
+package cast
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/core/reports/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// CountsToReport converts the counts of the events of the repo or the team over the period to a Report proto. The
+// largest pull requests are named after their repo in names, the median times are left unset when unknown.
+func CountsToReport(
+	schedule *defs.Schedule, name, source string, from, to time.Time, counts *pulse.ReportCounts, names map[uuid.UUID]string,
+) *eventsv1.Report {
+	report := &eventsv1.Report{
+		Frequency:         schedule.Frequency,
+		Name:              name,
+		Source:            source,
+		From:              timestamppb.New(from),
+		To:                timestamppb.New(to),
+		MergedPrs:         int64(counts.MergedPRs),
+		ConflictsDetected: int64(counts.ConflictsDetected),
+		ConflictsResolved: int64(counts.ConflictsResolved),
+		StaleBranches:     int64(counts.StaleBranches),
+		Largest:           make([]*eventsv1.ReportPullRequest, len(counts.Largest)),
+	}
+
+	if counts.CycleTime > 0 {
+		report.CycleTime = durationpb.New(counts.CycleTime)
+	}
+
+	if counts.QueueWait > 0 {
+		report.QueueWait = durationpb.New(counts.QueueWait)
+	}
+
+	for idx, pr := range counts.Largest {
+		report.Largest[idx] = &eventsv1.ReportPullRequest{
+			Number: pr.Number,
+			Repo:   names[pr.SubjectID],
+			Branch: pr.Branch,
+			Lines:  int32(min(pr.Lines, math.MaxInt32)), // nolint: gosec
+		}
+	}
+
+	return report
+}
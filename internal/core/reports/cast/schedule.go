@@ -0,0 +1,92 @@
+package cast
+
+import (
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/core/reports/defs"
+	"go.breu.io/quantm/internal/db/entities"
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+)
+
+var (
+	frequencies = map[string]corev1.ReportFrequency{
+		defs.FrequencyDaily:  corev1.ReportFrequency_REPORT_FREQUENCY_DAILY,
+		defs.FrequencyWeekly: corev1.ReportFrequency_REPORT_FREQUENCY_WEEKLY,
+	}
+)
+
+// EntityToSchedule converts a ReportSchedule entity to a schedule.
+func EntityToSchedule(entity *entities.ReportSchedule) *defs.Schedule {
+	return &defs.Schedule{
+		ID:        entity.ID,
+		Kind:      entity.Kind,
+		LinkTo:    entity.LinkTo,
+		Frequency: entity.Frequency,
+		Timezone:  entity.Timezone,
+		Hour:      entity.Hour,
+		Weekday:   entity.Weekday,
+		SentAt:    entity.SentAt,
+	}
+}
+
+// EntityToProto converts a ReportSchedule entity to a ReportSchedule proto.
+func EntityToProto(entity *entities.ReportSchedule) *corev1.ReportSchedule {
+	return &corev1.ReportSchedule{
+		LinkTo:    entity.LinkTo.String(),
+		Frequency: FrequencyToProto(entity.Frequency),
+		Timezone:  entity.Timezone,
+		Hour:      entity.Hour,
+		Weekday:   entity.Weekday,
+		Enabled:   entity.Enabled,
+	}
+}
+
+// DefaultProto returns the ReportSchedule proto of a repo or a team without a schedule, i.e. a disabled weekly report.
+func DefaultProto(link_to uuid.UUID) *corev1.ReportSchedule {
+	return &corev1.ReportSchedule{
+		LinkTo:    link_to.String(),
+		Frequency: corev1.ReportFrequency_REPORT_FREQUENCY_WEEKLY,
+		Timezone:  defs.DefaultTimezone,
+		Hour:      defs.DefaultHour,
+		Weekday:   defs.DefaultWeekday,
+	}
+}
+
+// ProtoToUpsertScheduleParams converts a ReportSchedule proto to the params saving it for the repo or the team of the
+// org. The empty timezone falls back to UTC.
+func ProtoToUpsertScheduleParams(
+	org_id uuid.UUID, kind string, link_to uuid.UUID, proto *corev1.ReportSchedule,
+) entities.UpsertReportScheduleParams {
+	params := entities.UpsertReportScheduleParams{
+		OrgID:     org_id,
+		Kind:      kind,
+		LinkTo:    link_to,
+		Frequency: ProtoToFrequency(proto.GetFrequency()),
+		Timezone:  proto.GetTimezone(),
+		Hour:      proto.GetHour(),
+		Weekday:   proto.GetWeekday(),
+		Enabled:   proto.GetEnabled(),
+	}
+
+	if params.Timezone == "" {
+		params.Timezone = defs.DefaultTimezone
+	}
+
+	return params
+}
+
+// FrequencyToProto converts a frequency to a ReportFrequency proto.
+func FrequencyToProto(frequency string) corev1.ReportFrequency {
+	return frequencies[frequency]
+}
+
+// ProtoToFrequency converts a ReportFrequency proto to a frequency.
+func ProtoToFrequency(proto corev1.ReportFrequency) string {
+	for frequency, value := range frequencies {
+		if value == proto {
+			return frequency
+		}
+	}
+
+	return defs.FrequencyWeekly
+}
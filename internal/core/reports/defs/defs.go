@@ -0,0 +1,63 @@
+package defs
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.breu.io/durex/queues"
+)
+
+// kinds of the subjects of the reports.
+const (
+	KindRepo = "repo"
+	KindTeam = "team"
+)
+
+// frequencies of the reports.
+const (
+	FrequencyDaily  = "daily"
+	FrequencyWeekly = "weekly"
+)
+
+const (
+	DefaultTimezone = "UTC"
+	DefaultHour     = 9
+	DefaultWeekday  = int32(time.Monday)
+)
+
+const (
+	SignalSchedule queues.Signal = "schedule" // signals a report schedule was enabled.
+)
+
+type (
+	// Schedule is the schedule of the report of a repo or of a team.
+	Schedule struct {
+		ID        uuid.UUID `json:"id"`
+		Kind      string    `json:"kind"`
+		LinkTo    uuid.UUID `json:"link_to"` // the repo or the team.
+		Frequency string    `json:"frequency"`
+		Timezone  string    `json:"timezone"`
+		Hour      int32     `json:"hour"`
+		Weekday   int32     `json:"weekday"` // 0 being Sunday, weekly reports only.
+		SentAt    time.Time `json:"sent_at"` // the last report was sent at.
+	}
+
+	// ReportsState is the state of the workflow delivering the reports of an org.
+	ReportsState struct {
+		OrgID uuid.UUID `json:"org_id"`
+	}
+
+	// ReportsPayload is the payload for delivering the reports of an org due at the given time.
+	ReportsPayload struct {
+		OrgID uuid.UUID `json:"org_id"`
+		At    time.Time `json:"at"`
+	}
+)
+
+type (
+	// Metric is a line of a report, as rendered on chat.
+	Metric struct {
+		Title string `json:"title"`
+		Value string `json:"value"`
+	}
+)
@@ -0,0 +1,21 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+	"go.breu.io/durex/workflows"
+
+	"go.breu.io/quantm/internal/durable"
+)
+
+// ReportsWorkflowOptions returns workflow options for the reports of an org, designed for use with the Core Queue. The
+// workflow ID, when used with the Core Queue, is formatted as:
+//
+//	"ai.ctrlplane.core.org.{org}.reports"
+func ReportsWorkflowOptions(org_id uuid.UUID) workflows.Options {
+	opts := durable.NewWorkflowOptions(
+		durable.WithOrg(org_id.String()),
+		durable.WithSubject("reports"),
+	)
+
+	return opts
+}
@@ -0,0 +1,78 @@
+package fns
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.breu.io/quantm/internal/core/reports/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// Title returns the title of the report, e.g. "Weekly report of quantm".
+func Title(report *eventsv1.Report) string {
+	frequency := report.GetFrequency()
+	if frequency != "" {
+		frequency = strings.ToUpper(frequency[:1]) + frequency[1:]
+	}
+
+	return fmt.Sprintf("%s report of %s", frequency, report.GetName())
+}
+
+// Span returns the period the report covers, e.g. "Apr 29 - May 6".
+func Span(report *eventsv1.Report) string {
+	const layout = "Jan 2"
+
+	return fmt.Sprintf("%s - %s", report.GetFrom().AsTime().Format(layout), report.GetTo().AsTime().Format(layout))
+}
+
+// Metrics returns the metrics of the report in the order they are rendered in. The unknown metrics are left out.
+func Metrics(report *eventsv1.Report) []defs.Metric {
+	metrics := []defs.Metric{
+		{Title: "Merged pull requests", Value: fmt.Sprint(report.GetMergedPrs())},
+		{Title: "Median cycle time", Value: elapsed(report.GetCycleTime())},
+		{Title: "Median queue wait", Value: elapsed(report.GetQueueWait())},
+		{Title: "Conflicts detected", Value: fmt.Sprint(report.GetConflictsDetected())},
+		{Title: "Conflicts resolved", Value: fmt.Sprint(report.GetConflictsResolved())},
+		{Title: "Stale branches", Value: fmt.Sprint(report.GetStaleBranches())},
+	}
+
+	known := make([]defs.Metric, 0, len(metrics))
+
+	for _, metric := range metrics {
+		if metric.Value != "" {
+			known = append(known, metric)
+		}
+	}
+
+	return known
+}
+
+// Largest returns the largest pull requests of the report, one line each, e.g. "#42 feature/login, 1200 lines".
+func Largest(report *eventsv1.Report) []string {
+	lines := make([]string, len(report.GetLargest()))
+
+	for i, pr := range report.GetLargest() {
+		lines[i] = fmt.Sprintf("#%d %s, %d lines", pr.GetNumber(), pr.GetBranch(), pr.GetLines())
+	}
+
+	return lines
+}
+
+// elapsed rounds the duration for display, e.g. "2d 4h" or "3h 05m". An unset duration is unknown.
+func elapsed(duration *durationpb.Duration) string {
+	if duration == nil {
+		return ""
+	}
+
+	rounded := duration.AsDuration().Round(time.Minute)
+	hours := int(rounded.Hours())
+
+	if hours >= 24 {
+		return fmt.Sprintf("%dd %dh", hours/24, hours%24)
+	}
+
+	return fmt.Sprintf("%dh %02dm", hours, int(rounded.Minutes())%60)
+}
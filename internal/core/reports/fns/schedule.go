@@ -0,0 +1,52 @@
+package fns
+
+import (
+	"time"
+	_ "time/tzdata" // the timezones of the schedules are resolved without relying on the host.
+
+	"go.breu.io/quantm/internal/core/reports/defs"
+)
+
+// IsDue reports whether the report is due in the hour of the given time, in the timezone of the schedule, and was not
+// sent in that hour yet. The daily reports are due every day at the hour of the schedule, the weekly ones on the
+// weekday of the schedule only.
+func IsDue(schedule *defs.Schedule, at time.Time) bool {
+	local := at.In(Location(schedule.Timezone))
+
+	if int32(local.Hour()) != schedule.Hour {
+		return false
+	}
+
+	if schedule.Frequency == defs.FrequencyWeekly && int32(local.Weekday()) != schedule.Weekday {
+		return false
+	}
+
+	return schedule.SentAt.Before(hour(local))
+}
+
+// Period returns the period the report due at the given time covers, i.e. the last day or the last week until the
+// hour of the schedule.
+func Period(schedule *defs.Schedule, at time.Time) (time.Time, time.Time) {
+	to := hour(at.In(Location(schedule.Timezone)))
+
+	if schedule.Frequency == defs.FrequencyWeekly {
+		return to.AddDate(0, 0, -7), to
+	}
+
+	return to.AddDate(0, 0, -1), to
+}
+
+// Location returns the location of the timezone, UTC if unknown.
+func Location(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// hour returns the start of the hour of the local time.
+func hour(local time.Time) time.Time {
+	return time.Date(local.Year(), local.Month(), local.Day(), local.Hour(), 0, 0, 0, local.Location())
+}
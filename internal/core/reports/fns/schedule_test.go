@@ -0,0 +1,46 @@
+package fns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/core/reports/defs"
+	"go.breu.io/quantm/internal/core/reports/fns"
+)
+
+func TestIsDue(t *testing.T) {
+	kolkata, _ := time.LoadLocation("Asia/Kolkata")
+
+	weekly := &defs.Schedule{
+		Frequency: defs.FrequencyWeekly,
+		Timezone:  "Asia/Kolkata",
+		Hour:      9,
+		Weekday:   int32(time.Monday),
+	}
+
+	monday := time.Date(2024, 5, 6, 9, 0, 0, 0, kolkata) // 03:30 UTC
+
+	assert.True(t, fns.IsDue(weekly, monday.UTC()))
+	assert.True(t, fns.IsDue(weekly, monday.Add(59*time.Minute)))
+	assert.False(t, fns.IsDue(weekly, monday.Add(time.Hour)), "another hour")
+	assert.False(t, fns.IsDue(weekly, monday.AddDate(0, 0, 1)), "another weekday")
+
+	weekly.SentAt = monday.Add(time.Minute)
+	assert.False(t, fns.IsDue(weekly, monday.Add(30*time.Minute)), "already sent")
+
+	daily := &defs.Schedule{Frequency: defs.FrequencyDaily, Timezone: "Asia/Kolkata", Hour: 9, SentAt: monday}
+	assert.True(t, fns.IsDue(daily, monday.AddDate(0, 0, 1)))
+}
+
+func TestPeriod(t *testing.T) {
+	at := time.Date(2024, 5, 6, 9, 15, 0, 0, time.UTC)
+
+	from, to := fns.Period(&defs.Schedule{Frequency: defs.FrequencyWeekly, Timezone: "UTC", Hour: 9}, at)
+	assert.Equal(t, time.Date(2024, 4, 29, 9, 0, 0, 0, time.UTC), from)
+	assert.Equal(t, time.Date(2024, 5, 6, 9, 0, 0, 0, time.UTC), to)
+
+	from, _ = fns.Period(&defs.Schedule{Frequency: defs.FrequencyDaily, Timezone: "UTC", Hour: 9}, at)
+	assert.Equal(t, time.Date(2024, 5, 5, 9, 0, 0, 0, time.UTC), from)
+}
@@ -0,0 +1,145 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/reports/activities"
+	"go.breu.io/quantm/internal/core/reports/cast"
+	"go.breu.io/quantm/internal/core/reports/defs"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	"go.breu.io/quantm/internal/proto/ctrlplane/core/v1/corev1connect"
+)
+
+type (
+	ReportService struct {
+		corev1connect.UnimplementedReportServiceHandler
+	}
+)
+
+// GetReportSchedule returns the report schedule of the repo or the team, a disabled weekly report if none is set.
+func (s *ReportService) GetReportSchedule(
+	ctx context.Context, req *connect.Request[corev1.GetReportScheduleRequest],
+) (*connect.Response[corev1.GetReportScheduleResponse], error) {
+	link_to, err := uuid.Parse(req.Msg.GetLinkTo())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("link_to", req.Msg.GetLinkTo()).Wrap(err)
+	}
+
+	if _, err := s.kind(ctx, link_to, false); err != nil {
+		return nil, err
+	}
+
+	proto := cast.DefaultProto(link_to)
+
+	entity, err := db.Queries().GetReportSchedule(ctx, link_to)
+	if err == nil {
+		proto = cast.EntityToProto(&entity)
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", req.Msg.GetLinkTo()).Wrap(err)
+	}
+
+	return connect.NewResponse(&corev1.GetReportScheduleResponse{Schedule: proto}), nil
+}
+
+// SetReportSchedule saves the report schedule of the repo, or of the team if the user is an admin of it. Enabling the
+// schedule starts the delivery of the reports of the org.
+func (s *ReportService) SetReportSchedule(
+	ctx context.Context, req *connect.Request[corev1.SetReportScheduleRequest],
+) (*connect.Response[corev1.SetReportScheduleResponse], error) {
+	msg := req.Msg.GetSchedule()
+
+	link_to, err := uuid.Parse(msg.GetLinkTo())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("link_to", msg.GetLinkTo()).Wrap(err)
+	}
+
+	if _, err := time.LoadLocation(msg.GetTimezone()); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("timezone", msg.GetTimezone()).Wrap(err)
+	}
+
+	kind, err := s.kind(ctx, link_to, true)
+	if err != nil {
+		return nil, err
+	}
+
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	entity, err := db.Queries().UpsertReportSchedule(ctx, cast.ProtoToUpsertScheduleParams(org_id, kind, link_to, msg))
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", msg.GetLinkTo()).Wrap(err)
+	}
+
+	if entity.Enabled {
+		if err := activities.Start(ctx, org_id, link_to); err != nil {
+			return nil, erratic.NewSystemError(erratic.CoreModule).AddHint("link_to", msg.GetLinkTo()).Wrap(err)
+		}
+	}
+
+	return connect.NewResponse(&corev1.SetReportScheduleResponse{Schedule: cast.EntityToProto(&entity)}), nil
+}
+
+// kind returns whether link_to is a repo or a team of the org of the authenticated user. Changing the schedule of a
+// team requires the user to be an admin of it.
+func (s *ReportService) kind(ctx context.Context, link_to uuid.UUID, admin bool) (string, error) {
+	user_id, org_id := auth.NomadAuthContext(ctx)
+
+	repo, err := db.Queries().GetRepo(ctx, link_to)
+	if err == nil {
+		if repo.OrgID != org_id {
+			return "", erratic.NewNotFoundError(erratic.CoreModule, "repo").AddHint("link_to", link_to.String())
+		}
+
+		return defs.KindRepo, nil
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return "", erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", link_to.String()).Wrap(err)
+	}
+
+	team, err := db.Queries().GetTeam(ctx, link_to)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", erratic.NewNotFoundError(erratic.CoreModule, "repo or team").AddHint("link_to", link_to.String())
+		}
+
+		return "", erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", link_to.String()).Wrap(err)
+	}
+
+	if team.OrgID != org_id {
+		return "", erratic.NewNotFoundError(erratic.CoreModule, "team").AddHint("link_to", link_to.String())
+	}
+
+	if !admin {
+		return defs.KindTeam, nil
+	}
+
+	member, err := db.Queries().GetTeamUserByTeam(ctx, entities.GetTeamUserByTeamParams{TeamID: team.ID, UserID: user_id})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", erratic.NewAuthzError(erratic.CoreModule).WithReason("not a member of the team")
+		}
+
+		return "", erratic.NewDatabaseError(erratic.CoreModule).AddHint("link_to", link_to.String()).Wrap(err)
+	}
+
+	if !member.IsAdmin && member.Role != entities.TeamRoleAdmin {
+		return "", erratic.NewAuthzError(erratic.CoreModule).WithReason("not an admin of the team")
+	}
+
+	return defs.KindTeam, nil
+}
+
+func NewReportServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return corev1connect.NewReportServiceHandler(&ReportService{}, opts...)
+}
@@ -0,0 +1,56 @@
+package workflows
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/reports/activities"
+	"go.breu.io/quantm/internal/core/reports/defs"
+	"go.breu.io/quantm/internal/durable/periodic"
+)
+
+// OrgReports delivers the scheduled reports of the repos and the teams of an org. Every hour, on the hour, the reports
+// due in the timezone of their schedule are delivered. The workflow completes once the org has no schedule enabled,
+// enabling one starts it again.
+func OrgReports(ctx workflow.Context, state *defs.ReportsState) error {
+	acts := &activities.Reports{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+	logger := workflow.GetLogger(ctx)
+
+	signal := workflow.GetSignalChannel(ctx, defs.SignalSchedule.String())
+	timer := periodic.New(ctx, until_next_hour(periodic.Now(ctx)))
+
+	for {
+		timer.Tick(ctx)
+
+		// the schedules enabled in the meantime are read from the database.
+		link_to := uuid.UUID{}
+		for signal.ReceiveAsync(&link_to) {
+			logger.Debug("reports: schedule enabled", "org_id", state.OrgID, "link_to", link_to)
+		}
+
+		now := periodic.Now(ctx)
+		payload := &defs.ReportsPayload{OrgID: state.OrgID, At: now}
+		enabled := 0
+
+		if err := workflow.ExecuteActivity(ctx, acts.SendDueReports, payload).Get(ctx, &enabled); err != nil {
+			logger.Warn("reports: unable to send", "org_id", state.OrgID, "error", err.Error())
+		} else if enabled == 0 {
+			return nil
+		}
+
+		if workflow.GetInfo(ctx).GetContinueAsNewSuggested() {
+			return workflow.NewContinueAsNewError(ctx, OrgReports, state)
+		}
+
+		timer.Restart(ctx, until_next_hour(periodic.Now(ctx)))
+	}
+}
+
+// until_next_hour returns the duration from the given time to the start of the next hour.
+func until_next_hour(now time.Time) time.Duration {
+	return now.Truncate(time.Hour).Add(time.Hour).Sub(now)
+}
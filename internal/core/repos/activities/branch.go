@@ -5,13 +5,20 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"sync"
+	"time"
 
 	git "github.com/jeffwelling/git2go/v37"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/notify"
 	"go.breu.io/quantm/internal/core/repos/defs"
 	"go.breu.io/quantm/internal/core/repos/fns"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/events"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
@@ -49,6 +56,14 @@ func (a *Branch) Clone(ctx context.Context, payload *defs.ClonePayload) (string,
 	return cloned.Workdir(), nil
 }
 
+// ForwardToRepo signals the repo workflow, starting it with the given state if it is not running.
+func (a *Branch) ForwardToRepo(ctx context.Context, payload *defs.SignalRepoPayload, event, state any) error {
+	opts := defs.ReplayRepoWorkflowOptions(payload.Repo, payload.Replay)
+	_, err := durable.OnCore().SignalWithStartWorkflow(ctx, opts, payload.Signal, event, WorkflowRepo, state)
+
+	return err
+}
+
 // RemoveDir removes a directory and handles potential errors.
 func (a *Branch) RemoveDir(ctx context.Context, path string) error {
 	slog.Debug("removing directory", "path", path)
@@ -138,6 +153,8 @@ func (a *Branch) Rebase(ctx context.Context, payload *defs.RebasePayload) (*defs
 	defer branch.Free()
 	defer upstream.Free()
 
+	result.Before = branch.Id().String()
+
 	opts, err := git.DefaultRebaseOptions()
 	if err != nil {
 		a.report_rebase_error(
@@ -188,24 +205,224 @@ func (a *Branch) Rebase(ctx context.Context, payload *defs.RebasePayload) (*defs
 	return result, nil
 }
 
-// NotifyLinesExceeded notifies on chat if lines exceed a limit.
-func (a *Branch) NotifyLinesExceeded(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error {
-	if err := kernel.Get().ChatHook(event.Context.Hook).NotifyLinesExceed(ctx, event); err != nil {
-		slog.Warn("unable to notify on chat", "error", err.Error())
+// UpdateBranch brings the branch up to date with the default branch and pushes it to origin. With
+// defs.UpdateStrategyRebase, the rebased head is force pushed. With defs.UpdateStrategyMerge, the default branch is
+// merged into the branch and pushed without force. In both cases, the update is rejected if the branch on the remote
+// no longer points at the expected head, as `git push --force-with-lease` does, see push_with_lease.
+//
+// Returns the push made on behalf of the user, or nil if the branch is already up to date.
+func (a *Branch) UpdateBranch(ctx context.Context, payload *defs.UpdateBranchPayload) (*eventsv1.Push, error) {
+	repo, err := git.OpenRepository(payload.Path)
+	if err != nil {
+		slog.Warn("update: failed to open repository", "error", err.Error(), "path", payload.Path)
+		return nil, err
+	}
+
+	defer repo.Free()
+
+	expected, err := git.NewOid(payload.Expected)
+	if err != nil {
+		return nil, err
+	}
+
+	trunk, err := git.NewOid(payload.Trunk)
+	if err != nil {
+		return nil, err
+	}
+
+	uptodate, err := repo.DescendantOf(expected, trunk)
+	if err != nil {
+		return nil, err
+	}
+
+	if uptodate || expected.Equal(trunk) {
+		slog.Info("update: already up to date", "branch", payload.Branch, "sha", payload.Expected)
+		return nil, nil
+	}
+
+	var after *git.Oid
+
+	refspec := fns.BranchNameToRef(payload.Branch) + ":" + fns.BranchNameToRef(payload.Branch)
+
+	switch payload.Strategy { // nolint:exhaustive
+	case defs.UpdateStrategyRebase:
+		after, err = git.NewOid(payload.Head)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := repo.References.Create(fns.BranchNameToRef(payload.Branch), after, true, "quantm: rebase"); err != nil {
+			return nil, err
+		}
+
+		refspec = "+" + refspec
+	case defs.UpdateStrategyMerge:
+		after, err = a.merge_trunk(ctx, repo, payload.Branch, expected, trunk)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown update strategy: %s", payload.Strategy)
+	}
+
+	if err := a.push_with_lease(ctx, repo, payload.Branch, payload.Expected, refspec); err != nil {
+		slog.Warn("update: unable to push", "error", err.Error(), "branch", payload.Branch)
+		return nil, err
+	}
+
+	push := &eventsv1.Push{
+		Ref:        fns.BranchNameToRef(payload.Branch),
+		Before:     payload.Expected,
+		After:      after.String(),
+		Repository: payload.Repository,
+		Timestamp:  timestamppb.Now(),
+	}
+
+	return push, nil
+}
+
+// Drift computes how far the branch has drifted from the default branch. The commits ahead and behind are counted
+// from the merge base, which also marks when the branch was last in sync. The churn is the set of files changed on
+// the default branch since the merge base that the branch touches as well.
+func (a *Branch) Drift(ctx context.Context, payload *defs.DriftPayload) (*eventsv1.Drift, error) {
+	repo, err := git.OpenRepository(payload.Path)
+	if err != nil {
+		slog.Warn("drift: unable to open repository", "error", err, "path", payload.Path)
+		return nil, err
+	}
+
+	defer repo.Free()
+
+	if err := a.refresh_remote(ctx, repo, payload.Trunk); err != nil {
+		slog.Warn("drift: unable to refresh remote", "path", payload.Path, "error", err.Error())
+		return nil, err
+	}
+
+	head, err := a.ref_target(ctx, repo, payload.Branch)
+	if err != nil {
+		slog.Warn("drift: unable to process head", "branch", payload.Branch, "error", err)
+		return nil, err
+	}
+
+	trunk, err := a.ref_target(ctx, repo, payload.Trunk)
+	if err != nil {
+		slog.Warn("drift: unable to process trunk", "trunk", payload.Trunk, "error", err)
+		return nil, err
+	}
+
+	base, err := repo.MergeBase(head, trunk)
+	if err != nil {
+		slog.Warn("drift: unable to find merge base", "branch", payload.Branch, "trunk", payload.Trunk, "error", err)
+		return nil, err
+	}
+
+	ahead, behind, err := repo.AheadBehind(head, trunk)
+	if err != nil {
+		return nil, err
+	}
+
+	synced, err := repo.LookupCommit(base)
+	if err != nil {
+		return nil, err
+	}
+
+	defer synced.Free()
+
+	ours, err := a.changed_files(ctx, repo, base, head)
+	if err != nil {
+		return nil, err
+	}
+
+	theirs, err := a.changed_files(ctx, repo, base, trunk)
+	if err != nil {
+		return nil, err
+	}
+
+	churn := make([]string, 0)
+
+	for _, file := range theirs {
+		if _, found := slices.BinarySearch(ours, file); found {
+			churn = append(churn, file)
+		}
+	}
+
+	drift := &eventsv1.Drift{
+		Branch:    payload.Branch,
+		Trunk:     payload.Trunk,
+		Ahead:     int32(ahead),  // nolint:gosec
+		Behind:    int32(behind), // nolint:gosec
+		SyncedAt:  timestamppb.New(synced.Committer().When),
+		Churn:     churn,
+		Timestamp: timestamppb.Now(),
+	}
+
+	return drift, nil
+}
+
+// SaveDrift records the drift of the branch, so that it can be listed without querying the branch workflows.
+func (a *Branch) SaveDrift(ctx context.Context, payload *defs.BranchDriftPayload) error {
+	params := entities.UpsertBranchDriftParams{
+		RepoID:   payload.RepoID,
+		Branch:   payload.Branch,
+		Ahead:    payload.Drift.GetAhead(),
+		Behind:   payload.Drift.GetBehind(),
+		SyncedAt: payload.Drift.GetSyncedAt().AsTime(),
+		Churn:    payload.Drift.GetChurn(),
+	}
+
+	if _, err := db.Queries().UpsertBranchDrift(ctx, params); err != nil {
+		slog.Warn("drift: unable to save", "repo", payload.RepoID, "branch", payload.Branch, "error", err.Error())
 		return err
 	}
 
 	return nil
 }
 
+// NotifyLinesExceeded notifies on chat if lines exceed a limit.
+func (a *Branch) NotifyLinesExceeded(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error {
+	return deliver(ctx, event, notify.DiffNotice(event.Payload), func(chat kernel.Chat) error {
+		return chat.NotifyLinesExceed(ctx, event)
+	})
+}
+
+// NotifyBranchDrift notifies on chat if the branch has drifted too far from the default branch.
+func (a *Branch) NotifyBranchDrift(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) error {
+	return deliver(ctx, event, notify.DriftNotice(event.Payload), func(chat kernel.Chat) error {
+		return chat.NotifyBranchDrift(ctx, event)
+	})
+}
+
 // NotifyMergeConflict notifies on chat if merge conflict message.
 func (a *Branch) NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error {
-	if err := kernel.Get().ChatHook(event.Context.Hook).NotifyMergeConflict(ctx, event); err != nil {
-		slog.Warn("unable to notify on chat", "error", err.Error())
-		return err
-	}
+	return deliver(ctx, event, notify.MergeConflictNotice(event.Payload), func(chat kernel.Chat) error {
+		return chat.NotifyMergeConflict(ctx, event)
+	})
+}
 
-	return nil
+// NotifyConflictResolved follows up on a merge conflict on chat, if the chat hook supports follow ups.
+func (a *Branch) NotifyConflictResolved(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error {
+	return deliver(ctx, event, notify.ConflictResolvedNotice(event.Payload), func(chat kernel.Chat) error {
+		followup, ok := chat.(kernel.ChatFollowUp)
+		if !ok {
+			return nil
+		}
+
+		return followup.NotifyConflictResolved(ctx, event)
+	})
+}
+
+// NotifyPullRequestMerged follows up on the notifications of the branch on chat, if the chat hook supports follow ups.
+func (a *Branch) NotifyPullRequestMerged(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest],
+) error {
+	return deliver(ctx, event, notify.PullRequestMergedNotice(event.Payload), func(chat kernel.Chat) error {
+		followup, ok := chat.(kernel.ChatFollowUp)
+		if !ok {
+			return nil
+		}
+
+		return followup.NotifyPullRequestMerged(ctx, event)
+	})
 }
 
 // - Diff Helpers -
@@ -316,6 +533,84 @@ func (a *Branch) tree_from_sha(_ context.Context, repo *git.Repository, sha stri
 	return tree, nil
 }
 
+// - Drift Helpers -
+
+// ref_target resolves the branch to the commit it points at.
+func (a *Branch) ref_target(_ context.Context, repo *git.Repository, branch string) (*git.Oid, error) {
+	ref, err := repo.References.Lookup(fns.BranchNameToRef(branch))
+	if err != nil {
+		return nil, err
+	}
+
+	defer ref.Free()
+
+	return ref.Target(), nil
+}
+
+// changed_files returns the sorted paths of the files changed between the two commits.
+func (a *Branch) changed_files(_ context.Context, repo *git.Repository, from, to *git.Oid) ([]string, error) {
+	files := make([]string, 0)
+
+	if from.Equal(to) {
+		return files, nil
+	}
+
+	older, err := repo.LookupCommit(from)
+	if err != nil {
+		return nil, err
+	}
+
+	defer older.Free()
+
+	newer, err := repo.LookupCommit(to)
+	if err != nil {
+		return nil, err
+	}
+
+	defer newer.Free()
+
+	old_tree, err := older.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	defer old_tree.Free()
+
+	new_tree, err := newer.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	defer new_tree.Free()
+
+	opts, _ := git.DefaultDiffOptions()
+
+	diff, err := repo.DiffTreeToTree(old_tree, new_tree, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = diff.Free() }()
+
+	deltas, err := diff.NumDeltas()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := 0; idx < deltas; idx++ {
+		delta, err := diff.Delta(idx)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, delta.OldFile.Path, delta.NewFile.Path)
+	}
+
+	slices.Sort(files)
+
+	return slices.Compact(files), nil
+}
+
 // - Rebase Helpers -
 
 // get_annotated_commits retrieves annotated commits for the base and head of a rebase operation.
@@ -423,7 +718,7 @@ func (a *Branch) rebase_op(
 	}
 	defer idx.Free()
 
-	conflicts, err := a.get_conflicts(ctx, idx)
+	conflicts, hunks, err := a.get_conflicts(ctx, repo, idx)
 	if err != nil {
 		result.AddOperation(op.Type, defs.RebaseStatusFailure, commit.Id().String(), commit.Message(), err)
 		result.SetStatusFailure(err)
@@ -432,6 +727,7 @@ func (a *Branch) rebase_op(
 		return err
 	} else if len(conflicts) > 0 {
 		result.Conflicts = conflicts
+		result.Hunks = hunks
 		result.SetStatusConflicts()
 		result.AddOperation(op.Type, defs.RebaseStatusFailure, commit.Id().String(), commit.Message(), nil)
 
@@ -440,7 +736,9 @@ func (a *Branch) rebase_op(
 		return nil
 	}
 
-	err = rebase.Commit(commit.Id(), commit.Author(), commit.Committer(), commit.Message())
+	rebased := &git.Oid{}
+
+	err = rebase.Commit(rebased, commit.Author(), commit.Committer(), commit.Message())
 	if err != nil {
 		result.AddOperation(op.Type, defs.RebaseStatusFailure, commit.Id().String(), commit.Message(), err)
 		result.SetStatusFailure(err)
@@ -450,8 +748,8 @@ func (a *Branch) rebase_op(
 		return err
 	}
 
-	slog.Debug("commit processed", "id", commit.Id().String())
-	result.Head = commit.Id().String()
+	slog.Debug("commit processed", "id", commit.Id().String(), "rebased", rebased.String())
+	result.Head = rebased.String()
 	result.AddOperation(op.Type, defs.RebaseStatusSuccess, commit.Id().String(), commit.Message(), nil)
 	result.SetStatusSuccess()
 
@@ -469,22 +767,24 @@ func (a *Branch) rebase_abort(_ context.Context, rebase *git.Rebase) {
 	}
 }
 
-// get_conflicts retrieves conflict information from a git index. Returns an empty slice if no conflicts are found.
-func (a *Branch) get_conflicts(_ context.Context, idx *git.Index) ([]string, error) {
+// get_conflicts retrieves conflict information from a git index, along with the conflicting lines of each file.
+// Returns an empty slice if no conflicts are found.
+func (a *Branch) get_conflicts(_ context.Context, repo *git.Repository, idx *git.Index) ([]string, []defs.ConflictHunk, error) {
 	conflicts := make([]string, 0)
+	hunks := make([]defs.ConflictHunk, 0)
 
 	if idx == nil {
-		return conflicts, nil
+		return conflicts, hunks, nil
 	}
 
 	if !idx.HasConflicts() {
-		return conflicts, nil
+		return conflicts, hunks, nil
 	}
 
 	iter, err := idx.ConflictIterator()
 	if err != nil {
 		slog.Warn("Failed to create conflict iterator", "error", err)
-		return conflicts, fmt.Errorf("failed to create conflict iterator: %w", err)
+		return conflicts, hunks, fmt.Errorf("failed to create conflict iterator: %w", err)
 	}
 
 	defer iter.Free()
@@ -498,13 +798,64 @@ func (a *Branch) get_conflicts(_ context.Context, idx *git.Index) ([]string, err
 
 			slog.Warn("Failed to get next conflict entry", "error", err)
 
-			return conflicts, fmt.Errorf("failed to get next conflict entry: %w", err)
+			return conflicts, hunks, fmt.Errorf("failed to get next conflict entry: %w", err)
+		}
+
+		path := a.conflict_path(entry)
+		conflicts = append(conflicts, path)
+
+		// the lines are only used to annotate the conflict, a file that can't be merged is still reported as conflicting.
+		found, err := a.conflict_hunks(repo, path, entry)
+		if err != nil {
+			slog.Warn("Failed to get conflicting lines", "path", path, "error", err)
+			continue
+		}
+
+		hunks = append(hunks, found...)
+	}
+
+	return conflicts, hunks, nil
+}
+
+// conflict_path returns the path of the conflicting file. The ancestor is missing when both sides added the file.
+func (a *Branch) conflict_path(entry git.IndexConflict) string {
+	for _, side := range []*git.IndexEntry{entry.Ancestor, entry.Our, entry.Their} {
+		if side != nil {
+			return side.Path
 		}
+	}
+
+	return ""
+}
+
+// conflict_hunks merges the three sides of the conflicting file, and finds the conflicting lines from the conflict
+// markers of the result.
+func (a *Branch) conflict_hunks(repo *git.Repository, path string, entry git.IndexConflict) ([]defs.ConflictHunk, error) {
+	inputs := make([]git.MergeFileInput, 3)
+
+	for i, side := range []*git.IndexEntry{entry.Ancestor, entry.Our, entry.Their} {
+		if side == nil {
+			continue
+		}
+
+		blob, err := repo.LookupBlob(side.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		inputs[i] = git.MergeFileInput{Path: side.Path, Mode: uint(side.Mode), Contents: blob.Contents()}
+
+		blob.Free()
+	}
 
-		conflicts = append(conflicts, entry.Ancestor.Path)
+	merged, err := git.MergeFile(inputs[0], inputs[1], inputs[2], &git.MergeFileOptions{Flags: git.MergeFileStyleMerge})
+	if err != nil {
+		return nil, err
 	}
 
-	return conflicts, nil
+	defer merged.Free()
+
+	return fns.ConflictHunks(path, merged.Contents), nil
 }
 
 // report_rebase_error logs a rebase error and updates the rebase result.
@@ -517,6 +868,115 @@ func (a *Branch) report_rebase_error(_ context.Context, result *defs.RebaseResul
 
 // - Git Helpers -
 
+// push_with_lease pushes the refspec to the "origin" remote, provided the branch on the remote still points at the
+// expected sha. The lease is checked against the refs advertised on the connection the push is then made on, so the
+// push carries the expected sha as the old value of the ref, and the remote rejects it if the branch moved in between,
+// whether forced or not.
+func (a *Branch) push_with_lease(_ context.Context, repo *git.Repository, branch, expected, refspec string) error {
+	remote, err := repo.Remotes.Lookup("origin")
+	if err != nil {
+		return err
+	}
+
+	defer remote.Free()
+
+	if err := remote.ConnectPush(nil, nil, nil); err != nil {
+		return err
+	}
+
+	heads, err := remote.Ls(fns.BranchNameToRef(branch))
+	if err != nil {
+		remote.Disconnect()
+		return err
+	}
+
+	head := ""
+
+	for _, h := range heads {
+		if h.Name == fns.BranchNameToRef(branch) {
+			head = h.Id.String()
+		}
+	}
+
+	if head != expected {
+		remote.Disconnect()
+		slog.Warn("update: stale lease", "branch", branch, "expected", expected, "remote", head)
+
+		return fmt.Errorf("branch %s has moved from %s to %s", branch, expected, head)
+	}
+
+	var rejected error
+
+	opts := &git.PushOptions{
+		RemoteCallbacks: git.RemoteCallbacks{
+			PushUpdateReferenceCallback: func(ref, status string) error {
+				if status != "" {
+					rejected = fmt.Errorf("branch %s has moved from %s, push rejected: %s", branch, expected, status)
+				}
+
+				return nil
+			},
+		},
+	}
+
+	if err := remote.Push([]string{refspec}, opts); err != nil {
+		return err
+	}
+
+	return rejected
+}
+
+// merge_trunk merges the default branch into the branch, creating a merge commit on the branch ref. Returns the
+// merge commit.
+func (a *Branch) merge_trunk(_ context.Context, repo *git.Repository, branch string, head, trunk *git.Oid) (*git.Oid, error) {
+	ours, err := repo.LookupCommit(head)
+	if err != nil {
+		return nil, err
+	}
+
+	defer ours.Free()
+
+	theirs, err := repo.LookupCommit(trunk)
+	if err != nil {
+		return nil, err
+	}
+
+	defer theirs.Free()
+
+	opts, err := git.DefaultMergeOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	idx, err := repo.MergeCommits(ours, theirs, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer idx.Free()
+
+	if idx.HasConflicts() {
+		return nil, fmt.Errorf("merging %s into %s results in conflicts", trunk.String(), branch)
+	}
+
+	id, err := idx.WriteTreeTo(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := repo.LookupTree(id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tree.Free()
+
+	signature := &git.Signature{Name: defs.UpdateCommitterName, Email: defs.UpdateCommitterEmail, When: time.Now()}
+	message := fmt.Sprintf("Merge %s into %s", trunk.String(), branch)
+
+	return repo.CreateCommit(fns.BranchNameToRef(branch), signature, signature, message, tree, ours, theirs)
+}
+
 // refresh_remote fetches a branch from the "origin" remote.
 func (a *Branch) refresh_remote(_ context.Context, repo *git.Repository, branch string) error {
 	remote, err := repo.Remotes.Lookup("origin")
@@ -541,3 +1001,38 @@ func (a *Branch) refresh_remote(_ context.Context, repo *git.Repository, branch
 
 	return nil
 }
+
+// deliver consults the notification preferences and the limits of the recipient of the event for the notice. A muted
+// or duplicate notification is skipped, and a notification due later, or above the rate limit, is deferred to the
+// digest of the recipient. Otherwise, the notification is sent with the chat hook of the recipient, if there is one,
+// and recorded as sent.
+func deliver[P events.Payload](
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, P], notice *notify.Notice, send func(kernel.Chat) error,
+) error {
+	notice.OrgID = event.Subject.OrgID
+	notice.RepoID = event.Subject.ID
+
+	decision, err := notify.Consult(ctx, event.Subject, notice)
+	if err != nil {
+		return err
+	}
+
+	switch decision.Action {
+	case notify.ActionSkip:
+		return nil
+	case notify.ActionDefer:
+		return notify.Defer(ctx, decision, notice)
+	}
+
+	chat, err := notify.Route(ctx, event, decision.Channel)
+	if err != nil || chat == nil {
+		return err
+	}
+
+	if err := send(chat); err != nil {
+		slog.Warn("unable to notify on chat", "hook", event.Context.Hook.String(), "kind", notice.Kind, "error", err.Error())
+		return err
+	}
+
+	return notify.Sent(ctx, decision, notice)
+}
@@ -0,0 +1,98 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Kernel groups the activities acting on the repo through its hook, so that the workflows never talk to a provider
+	// directly.
+	Kernel struct{}
+)
+
+// ReportCheckRun reports the check run against the commit. Check runs are informational, so a hook without check runs
+// is skipped instead of failing the activity.
+func (a *Kernel) ReportCheckRun(ctx context.Context, payload *defs.CheckRunPayload) error {
+	hook := kernel.Get().RepoHook(eventsv1.RepoHook(payload.Repo.Hook))
+	if hook == nil {
+		slog.Warn("check_run: hook not registered", "repo", payload.Repo.ID, "hook", payload.Repo.Hook)
+		return nil
+	}
+
+	err := hook.SetCheckRun(ctx, payload.Repo, payload.Check)
+	if errors.Is(err, kernel.ErrNotSupported) {
+		slog.Debug("check_run: not supported by hook", "repo", payload.Repo.ID, "hook", payload.Repo.Hook)
+		return nil
+	}
+
+	return err
+}
+
+// UpsertPullRequestComment keeps the sticky summary comment of the pull request up to date. The id of the comment is
+// stored per pull request, so that the comment is edited in place. A comment deleted on the provider is posted again.
+func (a *Kernel) UpsertPullRequestComment(ctx context.Context, payload *defs.PullRequestCommentPayload) error {
+	hook := kernel.Get().RepoHook(eventsv1.RepoHook(payload.Repo.Hook))
+	if hook == nil {
+		slog.Warn("pr_comment: hook not registered", "repo", payload.Repo.ID, "hook", payload.Repo.Hook)
+		return nil
+	}
+
+	params := entities.GetPullRequestCommentParams{RepoID: payload.Repo.ID, Number: payload.Number}
+
+	stored, err := db.Queries().GetPullRequestComment(ctx, params)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	if err == nil {
+		err = hook.UpdateComment(ctx, payload.Repo, stored.CommentID, payload.Body)
+		if !errors.Is(err, kernel.ErrNotFound) {
+			return a.skip_unsupported(payload, err)
+		}
+
+		slog.Info("pr_comment: comment deleted, posting again", "repo", payload.Repo.ID, "number", payload.Number)
+	}
+
+	id, err := hook.CreateComment(ctx, payload.Repo, payload.Number, payload.Body)
+	if err != nil {
+		return a.skip_unsupported(payload, err)
+	}
+
+	upsert := entities.UpsertPullRequestCommentParams{RepoID: payload.Repo.ID, Number: payload.Number, CommentID: id}
+	_, err = db.Queries().UpsertPullRequestComment(ctx, upsert)
+
+	return err
+}
+
+// CommentOnPullRequest posts a comment on the pull request, e.g. the reply to a command.
+func (a *Kernel) CommentOnPullRequest(ctx context.Context, payload *defs.PullRequestCommentPayload) error {
+	hook := kernel.Get().RepoHook(eventsv1.RepoHook(payload.Repo.Hook))
+	if hook == nil {
+		slog.Warn("pr_reply: hook not registered", "repo", payload.Repo.ID, "hook", payload.Repo.Hook)
+		return nil
+	}
+
+	_, err := hook.CreateComment(ctx, payload.Repo, payload.Number, payload.Body)
+
+	return a.skip_unsupported(payload, err)
+}
+
+// skip_unsupported swallows ErrNotSupported, since comments are informational.
+func (a *Kernel) skip_unsupported(payload *defs.PullRequestCommentPayload, err error) error {
+	if errors.Is(err, kernel.ErrNotSupported) {
+		slog.Debug("pr_comment: not supported by hook", "repo", payload.Repo.ID, "hook", payload.Repo.Hook)
+		return nil
+	}
+
+	return err
+}
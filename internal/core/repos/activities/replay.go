@@ -0,0 +1,102 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"go.temporal.io/api/workflowservice/v1"
+
+	"go.breu.io/quantm/internal/core/repos/cast"
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+type (
+	// Replay reads back the events of a repo from pulse, and signals them to the repo workflow again.
+	Replay struct{}
+)
+
+// Events reads the page of the events to replay at the offset, empty once all are read.
+func (a *Replay) Events(ctx context.Context, payload *defs.ReplayEventsPayload) ([]*pulse.Row, error) {
+	query := &pulse.EventsQuery{
+		RepoID:  payload.Repo.ID,
+		From:    payload.From,
+		To:      payload.To,
+		Scopes:  defs.ReplayScopes,
+		Derived: defs.ReplayDerived,
+		Offset:  payload.Offset,
+		Limit:   defs.ReplayPageSize,
+	}
+
+	return pulse.RepoEvents(ctx, payload.Slug, query)
+}
+
+// Resignal signals the event to the repo workflow of the replay, starting it with the given state if it is not running.
+// It reports false for an event that can not be signalled.
+func (a *Replay) Resignal(ctx context.Context, payload *defs.ResignalPayload, state any) (bool, error) {
+	signal, event, err := cast.RowToEvent(payload.Row)
+	if err != nil {
+		slog.Warn("replay: skipping event", "repo", payload.Repo.ID, "id", payload.Row.ID, "error", err.Error())
+		return false, nil
+	}
+
+	opts := defs.ReplayRepoWorkflowOptions(payload.Repo, payload.Replay)
+	if _, err := durable.OnCore().SignalWithStartWorkflow(ctx, opts, signal, event, WorkflowRepo, state); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RecordDecision reports a decision of a workflow of a dry run to its replay.
+func (a *Replay) RecordDecision(ctx context.Context, payload *defs.DecisionPayload) error {
+	opts := defs.ReplayWorkflowOptions(payload.Repo, payload.Replay.ID)
+
+	return durable.OnCore().SignalWorkflow(ctx, opts, defs.SignalReplayDecision, payload.Decision)
+}
+
+// Stop terminates the repo, branch and trunk workflows of a dry run still running, which would otherwise run their
+// timers and continue as new forever. The replay itself is left running. The workflows are looked up by the prefix of
+// their ids, which needs the advanced visibility of temporal.
+func (a *Replay) Stop(ctx context.Context, payload *defs.StopReplayPayload) error {
+	c, err := durable.Get().Client()
+	if err != nil {
+		return err
+	}
+
+	prefix := durable.OnCore().WorkflowID(defs.ReplayScopeWorkflowOptions(payload.Repo, payload.Replay))
+	self := durable.OnCore().WorkflowID(defs.ReplayWorkflowOptions(payload.Repo, payload.Replay.ID))
+	query := fmt.Sprintf(
+		"WorkflowId STARTS_WITH '%s.' AND WorkflowId != '%s' AND ExecutionStatus = 'Running'", quote(prefix), quote(self),
+	)
+
+	var token []byte
+
+	for {
+		resp, err := c.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{Query: query, NextPageToken: token})
+		if err != nil {
+			return err
+		}
+
+		for _, execution := range resp.GetExecutions() {
+			id := execution.GetExecution().GetWorkflowId()
+
+			if err := c.TerminateWorkflow(ctx, id, "", "replay: settled"); err != nil {
+				slog.Warn("replay: unable to stop workflow", "replay", payload.Replay.ID, "workflow", id, "error", err.Error())
+			}
+		}
+
+		token = resp.GetNextPageToken()
+		if len(token) == 0 {
+			return nil
+		}
+	}
+}
+
+// quote escapes the quotes of a value of a visibility query.
+func quote(value string) string {
+	return strings.ReplaceAll(value, "'", "\\'")
+}
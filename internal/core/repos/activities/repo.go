@@ -5,6 +5,8 @@ import (
 	"log/slog"
 
 	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/durable"
 )
 
@@ -13,12 +15,13 @@ type (
 )
 
 const (
+	WorkflowRepo   = "Repo"   // WorkflowRepo is string representation of workflows.Repo
 	WorkflowBranch = "Branch" // WorkflowBranch is string representation of workflows.Branch
 	WorkflowTrunk  = "Trunk"  // WorkflowTrunk is string representation of workflows.Trunk
 )
 
 func (a *Repo) ForwardToBranch(ctx context.Context, payload *defs.SignalBranchPayload, event, state any) error {
-	id := defs.BranchWorkflowOptions(payload.Repo, payload.Branch)
+	id := defs.ReplayBranchWorkflowOptions(payload.Repo, payload.Branch, payload.Replay)
 	run, err := durable.
 		OnCore().
 		SignalWithStartWorkflow(ctx, id, payload.Signal, event, WorkflowBranch, state)
@@ -33,13 +36,24 @@ func (a *Repo) ForwardToBranch(ctx context.Context, payload *defs.SignalBranchPa
 }
 
 func (a *Repo) ForwardToTrunk(ctx context.Context, payload *defs.SignalTrunkPayload, event, state any) error {
-	_, err := durable.
-		OnCore().
-		SignalWithStartWorkflow(ctx, defs.TrunkWorkflowOptions(payload.Repo), payload.Signal, event, WorkflowTrunk, state)
+	opts := defs.ReplayTrunkWorkflowOptions(payload.Repo, payload.Replay)
+	_, err := durable.OnCore().SignalWithStartWorkflow(ctx, opts, payload.Signal, event, WorkflowTrunk, state)
 
 	return err
 }
 
+// RemoveBranchDrift forgets the recorded drift of a deleted branch.
+func (a *Repo) RemoveBranchDrift(ctx context.Context, payload *defs.BranchDriftPayload) error {
+	params := entities.DeleteBranchDriftParams{RepoID: payload.RepoID, Branch: payload.Branch}
+
+	if err := db.Queries().DeleteBranchDrift(ctx, params); err != nil {
+		slog.Warn("drift: unable to remove", "repo", payload.RepoID, "branch", payload.Branch, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
 func (a *Repo) ForwardToQueue(ctx context.Context, payload *defs.SignalQueuePayload, event, state any) error {
 	return nil
 }
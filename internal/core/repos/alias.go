@@ -9,6 +9,17 @@ import (
 	"go.breu.io/quantm/internal/core/repos/workflows"
 )
 
+type (
+	// QueuePosition is the position of a pull request in the merge queue.
+	QueuePosition = defs.QueuePosition
+
+	// ReplayPayload is the input of the replay of the events of a repo.
+	ReplayPayload = defs.ReplayPayload
+
+	// ReplayResult is the outcome of a replay, with the decisions of a dry run.
+	ReplayResult = defs.ReplayResult
+)
+
 var (
 	// BranchNameFromRef extracts the branch name from a full Git reference string.
 	BranchNameFromRef = fns.BranchNameFromRef
@@ -24,6 +35,18 @@ var (
 
 	// IsQuantmBranch checks if a branch name belongs to the Quantm project (starts with "qtm/").
 	IsQuantmBranch = fns.IsQuantmBranch
+
+	// ParseCommand returns the quantm command given in the body of a pull request comment, if any.
+	ParseCommand = fns.ParseCommand
+
+	// CommandUsage renders the list of the available commands.
+	CommandUsage = fns.CommandUsage
+
+	// CommandReply renders the reply to a command handed over to the workflows.
+	CommandReply = fns.CommandReply
+
+	// FormatETA rounds the estimated time to merge for display.
+	FormatETA = fns.FormatETA
 )
 
 var (
@@ -32,11 +55,22 @@ var (
 
 	BranchWorkflow = workflows.Branch
 
+	TrunkWorkflow = workflows.Trunk
+
 	// NewRepoWorkflowState creates a new state object for the repository workflow.
 	NewRepoWorkflowState = states.NewRepo
 
 	// RepoWorkflowOptions provides options for configuring the repository workflow.
 	RepoWorkflowOptions = defs.RepoWorkflowOptions
+
+	// TrunkWorkflowOptions provides options for configuring the trunk workflow, e.g. to query the merge queue.
+	TrunkWorkflowOptions = defs.TrunkWorkflowOptions
+
+	// ReplayWorkflow signals the events of a repo persisted by pulse to fresh repo, branch and trunk workflows.
+	ReplayWorkflow = workflows.Replay
+
+	// ReplayWorkflowOptions provides options for configuring the replay workflow of a repo.
+	ReplayWorkflowOptions = defs.ReplayWorkflowOptions
 )
 
 var (
@@ -47,18 +81,33 @@ const (
 	SignalPush                     = defs.SignalPush
 	SignalRef                      = defs.SignalRef
 	SignalPullRequest              = defs.SignalPullRequest
+	SignalPullRequestLabel         = defs.SignalPullRequestLabel
 	SignalPullRequestReview        = defs.SignalPullRequestReview
 	SignalPullRequestReviewComment = defs.SignalPullRequestReviewComment
 	SignalMergeQueue               = defs.SignalMergeQueue
+	SignalCommand                  = defs.SignalCommand
+)
+
+const (
+	CommandPrefix  = defs.CommandPrefix
+	CommandMerge   = defs.CommandMerge
+	CommandDequeue = defs.CommandDequeue
+	CommandRebase  = defs.CommandRebase
+	CommandRecheck = defs.CommandRecheck
+	CommandSnooze  = defs.CommandSnooze
+	FlagPriority   = defs.FlagPriority
 )
 
 const (
 	QueryRepoForEventParent = defs.QueryRepoForEventParent
+	QueryMergeQueue         = defs.QueryMergeQueue
+	QueryReplay             = defs.QueryReplay
 )
 
 const (
-	LabelMerge    = defs.LabelMerge
-	LabelPriority = defs.LabelPriority
+	LabelMerge      = defs.LabelMerge
+	LabelPriority   = defs.LabelPriority
+	LabelAutoUpdate = defs.LabelAutoUpdate
 )
 
 // NewRepoActivities creates a new instance of the Activity struct, which handles repository-related actions.
@@ -69,3 +118,13 @@ func NewRepoActivities() *activities.Repo {
 func NewBranchActivities() *activities.Branch {
 	return &activities.Branch{}
 }
+
+// NewKernelActivities creates the activities acting on a repo through its hook.
+func NewKernelActivities() *activities.Kernel {
+	return &activities.Kernel{}
+}
+
+// NewReplayActivities creates the activities reading back the events of a repo, and signalling them again.
+func NewReplayActivities() *activities.Replay {
+	return &activities.Replay{}
+}
@@ -0,0 +1,19 @@
+package cast
+
+import (
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// DriftEventToChatEvent converts a drift event of the repo to a drift event for the chat.
+func DriftEventToChatEvent(
+	drift *events.Event[eventsv1.RepoHook, eventsv1.Drift],
+	hook int32,
+) *events.Event[eventsv1.ChatHook, eventsv1.Drift] {
+	return events.NextWithHook[eventsv1.RepoHook, eventsv1.ChatHook, eventsv1.Drift, eventsv1.Drift](
+		drift,
+		eventsv1.ChatHook(hook),
+		events.ScopeDrift,
+		events.ActionRequested,
+	).SetPayload(drift.Payload)
+}
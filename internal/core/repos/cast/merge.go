@@ -5,7 +5,7 @@ import (
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
-// MergeConflictEvent converts a Push event to a diff event.
+// RebaseEventToMergeConflictEvent converts a Rebase event with conflicts to a merge conflict event for the chat.
 func RebaseEventToMergeConflictEvent(
 	rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase],
 	hook int32,
@@ -18,3 +18,32 @@ func RebaseEventToMergeConflictEvent(
 		events.ActionFailure,
 	).SetPayload(payload)
 }
+
+// RebaseEventToConflictResolvedEvent converts a clean Rebase event, following one with conflicts, to a merge event for
+// the chat.
+func RebaseEventToConflictResolvedEvent(
+	rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase],
+	hook int32,
+	payload *eventsv1.Merge,
+) *events.Event[eventsv1.ChatHook, eventsv1.Merge] {
+	return events.NextWithHook[eventsv1.RepoHook, eventsv1.ChatHook, eventsv1.Rebase, eventsv1.Merge](
+		rebase,
+		eventsv1.ChatHook(hook),
+		events.ScopeMerge,
+		events.ActionCompleted,
+	).SetPayload(payload)
+}
+
+// PullRequestEventToMergedEvent converts the closing event of a merged pull request to a pull request event for the
+// chat.
+func PullRequestEventToMergedEvent(
+	pr *events.Event[eventsv1.RepoHook, eventsv1.PullRequest],
+	hook int32,
+) *events.Event[eventsv1.ChatHook, eventsv1.PullRequest] {
+	return events.NextWithHook[eventsv1.RepoHook, eventsv1.ChatHook, eventsv1.PullRequest, eventsv1.PullRequest](
+		pr,
+		eventsv1.ChatHook(hook),
+		events.ScopePr,
+		events.ActionClosed,
+	).SetPayload(pr.Payload)
+}
@@ -0,0 +1,110 @@
+package cast
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.breu.io/durex/queues"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+var (
+	// unmarshaler reads back the payloads persisted by pulse, ignoring the fields of later versions.
+	unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// RowToEvent converts an event persisted by pulse back to the event the hook signalled to the repo workflow, with the
+// signal it was sent on. The events persisted without their payload can not be converted.
+func RowToEvent(row *pulse.Row) (queues.Signal, any, error) {
+	if !events.IsRepoHook(row.Hook) {
+		return "", nil, fmt.Errorf("replay: %s is not a repo event", row.ID)
+	}
+
+	if len(row.Payload) == 0 {
+		return "", nil, fmt.Errorf("replay: %s was persisted without its payload", row.ID)
+	}
+
+	switch events.Scope(row.Scope) {
+	case events.ScopePush:
+		return row_to_event[eventsv1.Push](row, defs.SignalPush)
+	case events.ScopeBranch:
+		return row_to_event[eventsv1.GitRef](row, defs.SignalRef)
+	case events.ScopePrLabel:
+		return row_to_event[eventsv1.PullRequestLabel](row, defs.SignalPullRequestLabel)
+	case events.ScopeMergeQueue:
+		return row_to_event[eventsv1.MergeQueue](row, defs.SignalMergeQueue)
+	case events.ScopeCommand:
+		return row_to_event[eventsv1.Command](row, defs.SignalCommand)
+	case events.ScopePr:
+		// the reviews and the review comments are persisted with the scope of the pull request, and told apart by their
+		// payload.
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(row.Payload, &fields); err != nil {
+			return "", nil, err
+		}
+
+		switch {
+		case has(fields, "review_id", "commit_sha", "path", "in_reply_to"):
+			return row_to_event[eventsv1.PullRequestReviewComment](row, defs.SignalPullRequestReviewComment)
+		case has(fields, "pull_request_number"):
+			return row_to_event[eventsv1.PullRequestReview](row, defs.SignalPullRequestReview)
+		default:
+			return row_to_event[eventsv1.PullRequest](row, defs.SignalPullRequest)
+		}
+	}
+
+	return "", nil, errors.New("replay: " + row.Scope + " events are not signalled to the repo")
+}
+
+func row_to_event[P events.Payload](row *pulse.Row, signal queues.Signal) (queues.Signal, any, error) {
+	payload := new(P)
+	if err := unmarshaler.Unmarshal(row.Payload, any(payload).(proto.Message)); err != nil {
+		return "", nil, err
+	}
+
+	parents := make([]uuid.UUID, 0, len(row.Parents))
+	parents = append(parents, row.Parents...)
+
+	event := &events.Event[eventsv1.RepoHook, P]{
+		Version:   events.EventVersion(row.Version),
+		ID:        row.ID,
+		Timestamp: row.Timestamp,
+		Context: events.Context[eventsv1.RepoHook]{
+			Parents: parents,
+			Hook:    eventsv1.RepoHook(row.Hook),
+			Scope:   events.Scope(row.Scope),
+			Action:  events.Action(row.Action),
+			Source:  row.Source,
+
+			Replayed: true,
+		},
+		Subject: events.Subject{
+			Name:   row.SubjectName,
+			ID:     row.SubjectID,
+			OrgID:  row.OrgID,
+			TeamID: row.TeamID,
+			UserID: row.UserID,
+		},
+		Payload: payload,
+	}
+
+	return signal, event, nil
+}
+
+func has(fields map[string]json.RawMessage, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := fields[key]; ok {
+			return true
+		}
+	}
+
+	return false
+}
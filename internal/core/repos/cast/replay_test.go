@@ -0,0 +1,88 @@
+package cast_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.breu.io/durex/queues"
+
+	"go.breu.io/quantm/internal/core/repos/cast"
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+func TestRowToEvent(t *testing.T) {
+	parent := uuid.New()
+	row := &pulse.Row{
+		Version:   "0.1.0",
+		ID:        uuid.New(),
+		Parents:   []uuid.UUID{parent},
+		Hook:      int32(eventsv1.RepoHook_REPO_HOOK_GITHUB),
+		Scope:     string(events.ScopePush),
+		Action:    string(events.ActionCreated),
+		SubjectID: uuid.New(),
+		OrgID:     uuid.New(),
+		Timestamp: time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC),
+		Payload:   json.RawMessage(`{"ref":"refs/heads/main","after":"abc","added":1}`),
+	}
+
+	signal, decoded, err := cast.RowToEvent(row)
+	require.NoError(t, err)
+	assert.Equal(t, defs.SignalPush, signal)
+
+	event, ok := decoded.(*events.Event[eventsv1.RepoHook, eventsv1.Push])
+	require.True(t, ok)
+	assert.Equal(t, row.ID, event.ID)
+	assert.Equal(t, []uuid.UUID{parent}, event.Context.Parents)
+	assert.Equal(t, eventsv1.RepoHook_REPO_HOOK_GITHUB, event.Context.Hook)
+	assert.True(t, event.Context.Replayed)
+
+	derived := events.Next[eventsv1.RepoHook, eventsv1.Push, eventsv1.Rebase](event, events.ScopeRebase, events.ActionRequested)
+	assert.True(t, derived.Context.Replayed, "the events derived from a replayed event are persisted again")
+	assert.Equal(t, row.SubjectID, event.Subject.ID)
+	assert.Equal(t, "refs/heads/main", event.Payload.GetRef())
+	assert.Equal(t, "abc", event.Payload.GetAfter())
+}
+
+func TestRowToEventPullRequests(t *testing.T) {
+	tests := []struct {
+		payload string
+		signal  queues.Signal
+	}{
+		{`{"number":"7","head_branch":"feature"}`, defs.SignalPullRequest},
+		{`{"id":"1","pull_request_number":"7","state":"approved"}`, defs.SignalPullRequestReview},
+		{`{"id":"2","pull_request_number":"7","path":"main.go","commit_sha":"abc"}`, defs.SignalPullRequestReviewComment},
+	}
+
+	for _, test := range tests {
+		row := &pulse.Row{
+			ID:      uuid.New(),
+			Hook:    int32(eventsv1.RepoHook_REPO_HOOK_GITHUB),
+			Scope:   string(events.ScopePr),
+			Payload: json.RawMessage(test.payload),
+		}
+
+		signal, _, err := cast.RowToEvent(row)
+		require.NoError(t, err, test.payload)
+		assert.Equal(t, test.signal, signal, test.payload)
+	}
+}
+
+func TestRowToEventErrors(t *testing.T) {
+	rows := []*pulse.Row{
+		{ID: uuid.New(), Hook: int32(eventsv1.ChatHook_CHAT_HOOK_SLACK), Scope: "push", Payload: json.RawMessage(`{}`)},
+		{ID: uuid.New(), Hook: int32(eventsv1.RepoHook_REPO_HOOK_GITHUB), Scope: "push"},
+		{ID: uuid.New(), Hook: int32(eventsv1.RepoHook_REPO_HOOK_GITHUB), Scope: "merge", Payload: json.RawMessage(`{}`)},
+	}
+
+	for _, row := range rows {
+		_, _, err := cast.RowToEvent(row)
+		assert.Error(t, err, row.Scope)
+	}
+}
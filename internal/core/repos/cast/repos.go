@@ -1,9 +1,13 @@
 package cast
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/core/repos/fns"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/events"
@@ -11,6 +15,14 @@ import (
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
+var (
+	strategies = map[defs.UpdateStrategy]corev1.UpdateStrategy{
+		defs.UpdateStrategyNone:   corev1.UpdateStrategy_UPDATE_STRATEGY_NONE,
+		defs.UpdateStrategyRebase: corev1.UpdateStrategy_UPDATE_STRATEGY_REBASE,
+		defs.UpdateStrategyMerge:  corev1.UpdateStrategy_UPDATE_STRATEGY_MERGE,
+	}
+)
+
 // HookToProto converts an int32 representation of a RepoHook to a RepoHook proto.
 func HookToProto(hook int32) eventsv1.RepoHook {
 	v, ok := eventsv1.RepoHook_name[hook]
@@ -71,6 +83,87 @@ func RepoExtendedListToProto(repos []entities.ListReposRow) []*corev1.RepoExtend
 	return protos
 }
 
+// RepoToSettingsProto converts a Repo entity to the RepoSettings proto.
+func RepoToSettingsProto(repo *entities.Repo) *corev1.RepoSettings {
+	return &corev1.RepoSettings{
+		RepoId:        repo.ID.String(),
+		PushDebounce:  db.IntervalToProto(repo.PushDebounce),
+		AutoUpdate:    UpdateStrategyToProto(defs.UpdateStrategy(repo.AutoUpdate)),
+		DriftBehind:   repo.DriftBehind,
+		DriftDuration: db.IntervalToProto(repo.DriftDuration),
+		DriftChurn:    repo.DriftChurn,
+	}
+}
+
+// SettingsProtoToUpdateParams converts a RepoSettings proto to the params to update the settings of the repo, with the
+// update strategy already converted.
+func SettingsProtoToUpdateParams(
+	id uuid.UUID, settings *corev1.RepoSettings, strategy defs.UpdateStrategy,
+) entities.UpdateRepoSettingsParams {
+	return entities.UpdateRepoSettingsParams{
+		ID:            id,
+		PushDebounce:  db.ProtoToInterval(settings.GetPushDebounce()),
+		AutoUpdate:    string(strategy),
+		DriftBehind:   settings.GetDriftBehind(),
+		DriftDuration: db.ProtoToInterval(settings.GetDriftDuration()),
+		DriftChurn:    settings.GetDriftChurn(),
+	}
+}
+
+// UpdateStrategyToProto converts an update strategy to an UpdateStrategy proto.
+func UpdateStrategyToProto(strategy defs.UpdateStrategy) corev1.UpdateStrategy {
+	return strategies[strategy]
+}
+
+// ProtoToUpdateStrategy converts an UpdateStrategy proto to an update strategy, false if unknown.
+func ProtoToUpdateStrategy(proto corev1.UpdateStrategy) (defs.UpdateStrategy, bool) {
+	for strategy, value := range strategies {
+		if value == proto {
+			return strategy, true
+		}
+	}
+
+	return defs.UpdateStrategyNone, false
+}
+
+// BranchDriftToEvent converts a BranchDrift entity to a Drift event payload.
+func BranchDriftToEvent(drift *entities.BranchDrift, trunk string) *eventsv1.Drift {
+	return &eventsv1.Drift{
+		Branch:    drift.Branch,
+		Trunk:     trunk,
+		Ahead:     drift.Ahead,
+		Behind:    drift.Behind,
+		SyncedAt:  timestamppb.New(drift.SyncedAt),
+		Churn:     drift.Churn,
+		Timestamp: timestamppb.New(drift.UpdatedAt),
+	}
+}
+
+// BranchDriftToProto converts a Drift event payload to a BranchDrift proto, evaluated against the drift thresholds
+// of the repo.
+func BranchDriftToProto(repo *entities.Repo, drift *eventsv1.Drift, now time.Time) *corev1.BranchDrift {
+	return &corev1.BranchDrift{
+		Branch:        drift.GetBranch(),
+		Ahead:         drift.GetAhead(),
+		Behind:        drift.GetBehind(),
+		SyncedAt:      drift.GetSyncedAt(),
+		DaysSinceSync: fns.DaysSinceSync(drift, now),
+		Churn:         drift.GetChurn(),
+		Exceeded:      fns.DriftExceeded(repo, drift, now),
+		UpdatedAt:     drift.GetTimestamp(),
+	}
+}
+
+// BranchDriftListToProto converts a slice of BranchDrift entities to a slice of BranchDrift protos.
+func BranchDriftListToProto(repo *entities.Repo, drifts []entities.BranchDrift, now time.Time) []*corev1.BranchDrift {
+	protos := make([]*corev1.BranchDrift, 0)
+	for _, drift := range drifts {
+		protos = append(protos, BranchDriftToProto(repo, BranchDriftToEvent(&drift, repo.DefaultBranch), now))
+	}
+
+	return protos
+}
+
 // PushEventToRebaseEvent converts a Push event to a Rebase event.
 func PushEventToRebaseEvent(
 	push *events.Event[eventsv1.RepoHook, eventsv1.Push], parent uuid.UUID, base string,
@@ -0,0 +1,50 @@
+package cast_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go.breu.io/quantm/internal/core/repos/cast"
+	"go.breu.io/quantm/internal/db/entities"
+	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+)
+
+func TestRepoSettings(t *testing.T) {
+	id := uuid.New()
+	settings := &corev1.RepoSettings{
+		RepoId:        id.String(),
+		PushDebounce:  durationpb.New(90 * time.Second),
+		AutoUpdate:    corev1.UpdateStrategy_UPDATE_STRATEGY_REBASE,
+		DriftBehind:   20,
+		DriftDuration: durationpb.New(7 * 24 * time.Hour),
+	}
+
+	strategy, ok := cast.ProtoToUpdateStrategy(settings.GetAutoUpdate())
+	require.True(t, ok)
+
+	params := cast.SettingsProtoToUpdateParams(id, settings, strategy)
+	assert.True(t, params.PushDebounce.Valid)
+	assert.Equal(t, "rebase", params.AutoUpdate)
+
+	assert.Equal(t, int32(20), params.DriftBehind)
+	assert.Zero(t, params.DriftChurn, "a zero threshold disables the check")
+
+	repo := &entities.Repo{
+		ID:            params.ID,
+		PushDebounce:  params.PushDebounce,
+		AutoUpdate:    params.AutoUpdate,
+		DriftDuration: params.DriftDuration,
+	}
+	assert.Equal(t, 90*time.Second, cast.RepoToSettingsProto(repo).GetPushDebounce().AsDuration())
+	assert.Equal(t, id.String(), cast.RepoToSettingsProto(repo).GetRepoId())
+	assert.Equal(t, corev1.UpdateStrategy_UPDATE_STRATEGY_REBASE, cast.RepoToSettingsProto(repo).GetAutoUpdate())
+	assert.Equal(t, 7*24*time.Hour, cast.RepoToSettingsProto(repo).GetDriftDuration().AsDuration())
+
+	_, ok = cast.ProtoToUpdateStrategy(corev1.UpdateStrategy_UPDATE_STRATEGY_UNSPECIFIED)
+	assert.False(t, ok)
+}
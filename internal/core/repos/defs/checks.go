@@ -0,0 +1,29 @@
+package defs
+
+import (
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+// names of the check runs reported by quantm.
+const (
+	CheckLines    = "quantm/lines"    // size of the change against the threshold of the repo.
+	CheckConflict = "quantm/conflict" // conflicts with the default branch.
+	CheckQueue    = "quantm/queue"    // position in, and result of, the merge queue.
+)
+
+type (
+	// CheckRunPayload is the payload for the ReportCheckRun activity.
+	CheckRunPayload struct {
+		Repo  *entities.Repo   `json:"repo"`
+		Check *kernel.CheckRun `json:"check"`
+	}
+
+	// ConflictHunk is a conflicting range of lines of a file. Lines are 1-based, inclusive, and refer to the version of
+	// the file on the branch.
+	ConflictHunk struct {
+		Path      string `json:"path"`
+		StartLine int    `json:"start_line"`
+		EndLine   int    `json:"end_line"`
+	}
+)
@@ -0,0 +1,38 @@
+package defs
+
+import (
+	"time"
+)
+
+// CommandPrefix starts a quantm command in a pull request comment, e.g. "/quantm merge --priority".
+const CommandPrefix = "/quantm"
+
+// names of the commands.
+const (
+	CommandMerge   = "merge"   // adds the pull request to the merge queue.
+	CommandDequeue = "dequeue" // removes the pull request from the merge queue.
+	CommandRebase  = "rebase"  // rebases the branch onto the default branch.
+	CommandRecheck = "recheck" // analyzes the branch again, and reports the checks and the summary again.
+	CommandExplain = "explain" // replies with what is holding the pull request back.
+	CommandSnooze  = "snooze"  // silences the drift reminders of the branch for SnoozeDuration.
+)
+
+// SnoozeDuration is how long the snooze command silences the drift reminders of a branch.
+const SnoozeDuration = 24 * time.Hour
+
+// flags of the commands.
+const (
+	FlagPriority = "priority" // puts the pull request in front of the merge queue.
+)
+
+var (
+	// CommandFlags lists the flags accepted by each command.
+	CommandFlags = map[string][]string{
+		CommandMerge:   {FlagPriority},
+		CommandDequeue: {},
+		CommandRebase:  {},
+		CommandRecheck: {},
+		CommandExplain: {},
+		CommandSnooze:  {},
+	}
+)
@@ -0,0 +1,23 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// DriftPayload is the payload to compute how far a branch has drifted from the default branch.
+	DriftPayload struct {
+		Path   string `json:"path"`
+		Branch string `json:"branch"`
+		Trunk  string `json:"trunk"` // name of the default branch.
+	}
+
+	// BranchDriftPayload is the payload to record or forget the drift of a branch.
+	BranchDriftPayload struct {
+		RepoID uuid.UUID       `json:"repo_id"`
+		Branch string          `json:"branch"`
+		Drift  *eventsv1.Drift `json:"drift"`
+	}
+)
@@ -1,6 +1,8 @@
 package defs
 
 import (
+	"time"
+
 	git "github.com/jeffwelling/git2go/v37"
 
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
@@ -24,15 +26,22 @@ type (
 	}
 
 	RebaseResult struct {
+		Before       string            `json:"before"`
 		Head         string            `json:"head"`
 		Status       RebaseStatus      `json:"status"`
 		Operations   []RebaseOperation `json:"operations"`
 		TotalCommits uint              `json:"count"`
 		Conflicts    []string          `json:"conflicts"`
+		Hunks        []ConflictHunk    `json:"hunks"`
 		Error        string            `json:"error,omitempty"`
 	}
 )
 
+const (
+	RebaseConcurrency int64 = 8                // maximum number of rebase attempts in flight per repo.
+	RebaseTimeout           = time.Minute * 30 // time after which an unacknowledged rebase attempt frees its slot.
+)
+
 const (
 	RebaseStatusSuccess   RebaseStatus = "success"
 	RebaseStatusFailure   RebaseStatus = "failure"
@@ -91,6 +100,7 @@ func NewRebaseResult() *RebaseResult {
 	return &RebaseResult{
 		Status:     RebaseStatusFailure,
 		Conflicts:  []string{},
+		Hunks:      []ConflictHunk{},
 		Operations: []RebaseOperation{},
 	}
 }
@@ -0,0 +1,122 @@
+package defs
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"go.breu.io/durex/queues"
+
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+const (
+	SignalReplayDecision queues.Signal = "replay_decision" // signals a decision taken by a workflow of a dry run.
+	QueryReplay          queues.Query  = "replay"          // query the progress of a replay.
+)
+
+const (
+	// ReplayPageSize is the number of events read from pulse at once.
+	ReplayPageSize = 100
+
+	// ReplaySettle is the default time a replay waits for the decisions of its workflows, after the last one.
+	ReplaySettle = time.Minute
+)
+
+var (
+	// ReplayScopes are the scopes of the events the hooks signal to the repo workflow.
+	ReplayScopes = []string{
+		string(events.ScopePush),
+		string(events.ScopeBranch),
+		string(events.ScopePr),
+		string(events.ScopePrLabel),
+		string(events.ScopeMergeQueue),
+		string(events.ScopeCommand),
+	}
+
+	// ReplayDerived are the scopes of the events the workflows derive the events of ReplayScopes from, e.g. the push of
+	// a branch updated after a rebase. These are produced again by the replay, and never signalled.
+	ReplayDerived = []string{
+		string(events.ScopeRebase),
+	}
+
+	// ReplayReadOnly are the actions of the workflows that only read, and so are run during a dry run.
+	ReplayReadOnly = []string{"clone", "remove", "diff", "drift", "rebase"}
+)
+
+type (
+	// Replay marks the repo, branch and trunk workflows of a dry run. Their ids carry the id of the replay, so that they
+	// never receive the signals of the live workflows, and the actions acting outside the workflows are reported as
+	// decisions to the replay instead of being run. The events are not persisted again.
+	Replay struct {
+		ID string `json:"id"`
+	}
+
+	// ReplayPayload is the input of the replay of the events of a repo, from inclusive, to exclusive. Without a dry run,
+	// the events are signalled to the live workflows of the repo, e.g. to recover them after the loss of their history,
+	// and the workflows act on them as they would on the events from the hooks, without persisting the events derived
+	// from them again.
+	ReplayPayload struct {
+		ID       string             `json:"id"`
+		Repo     *entities.Repo     `json:"repo"`
+		ChatLink *entities.ChatLink `json:"chat_link"`
+		Slug     string             `json:"slug"` // slug of the org, to read the events from pulse.
+		From     time.Time          `json:"from"`
+		To       time.Time          `json:"to"`
+		DryRun   bool               `json:"dry_run"`
+		Settle   time.Duration      `json:"settle"` // time to wait for the decisions after the last one, dry run only.
+	}
+
+	// ReplayEventsPayload is the payload to read a page of the events to replay.
+	ReplayEventsPayload struct {
+		Slug   string         `json:"slug"`
+		Repo   *entities.Repo `json:"repo"`
+		From   time.Time      `json:"from"`
+		To     time.Time      `json:"to"`
+		Offset int            `json:"offset"`
+	}
+
+	// ResignalPayload is the payload to signal a persisted event to the repo workflow again.
+	ResignalPayload struct {
+		Repo     *entities.Repo     `json:"repo"`
+		ChatLink *entities.ChatLink `json:"chat_link"`
+		Replay   *Replay            `json:"replay"` // nil to signal the live workflow.
+		Row      *pulse.Row         `json:"row"`
+	}
+
+	// Decision is an action a workflow of a dry run would have run, with its input.
+	Decision struct {
+		Workflow string          `json:"workflow"` // id of the workflow.
+		Action   string          `json:"action"`
+		Input    json.RawMessage `json:"input"`
+		At       time.Time       `json:"at"`
+	}
+
+	// StopReplayPayload is the payload to stop the workflows of a dry run once it settles.
+	StopReplayPayload struct {
+		Repo   *entities.Repo `json:"repo"`
+		Replay *Replay        `json:"replay"`
+	}
+
+	// DecisionPayload is the payload to report a decision to the replay.
+	DecisionPayload struct {
+		Repo     *entities.Repo `json:"repo"`
+		Replay   *Replay        `json:"replay"`
+		Decision *Decision      `json:"decision"`
+	}
+
+	// ReplayResult is the outcome of a replay. The events that can not be signalled, e.g. from an older version of the
+	// payload, are skipped.
+	ReplayResult struct {
+		Events    int         `json:"events"`
+		Skipped   int         `json:"skipped"`
+		Decisions []*Decision `json:"decisions"`
+	}
+)
+
+// ReadOnly reports whether the action is run during a dry run.
+func (r *Replay) ReadOnly(action string) bool {
+	return slices.Contains(ReplayReadOnly, action)
+}
@@ -0,0 +1,60 @@
+package defs
+
+import (
+	"time"
+
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+// states of a pull request review, as reported by the hooks.
+const (
+	ReviewApproved         = "approved"
+	ReviewChangesRequested = "changes_requested"
+	ReviewDismissed        = "dismissed"
+)
+
+type (
+	// SummaryConflict is the outcome of the latest rebase of the branch onto the default branch.
+	SummaryConflict struct {
+		Status RebaseStatus `json:"status"`
+		Trunk  string       `json:"trunk"` // sha of the default branch the branch was rebased onto.
+		Files  []string     `json:"files"`
+	}
+
+	// QueuePosition is the position of a pull request in the merge queue. A zero position means the pull request is not
+	// waiting in the queue, either because it is being merged or because it is not queued at all. ETA is zero when there
+	// is no merge history to estimate it from.
+	QueuePosition struct {
+		Number   int64         `json:"number"`
+		Sha      string        `json:"sha"` // head of the pull request as queued.
+		Position int           `json:"position"`
+		Total    int           `json:"total"`
+		ETA      time.Duration `json:"eta"`
+		Merging  bool          `json:"merging"`
+	}
+
+	// BranchOverlaps lists the other branches touching the same files as the branch.
+	BranchOverlaps struct {
+		Branches []string `json:"branches"`
+	}
+
+	// PullRequestSummary is everything quantm knows about an open pull request, rendered as the sticky comment on it.
+	// Sections that are not known yet are nil or empty, and rendered as pending.
+	PullRequestSummary struct {
+		Lines     *DiffLines        `json:"lines"`
+		Threshold int32             `json:"threshold"`
+		Trunk     string            `json:"trunk"` // name of the default branch.
+		Projects  []string          `json:"projects"`
+		Conflict  *SummaryConflict  `json:"conflict"`
+		Overlaps  []string          `json:"overlaps"`
+		Reviews   map[string]string `json:"reviews"` // latest review state by login of the reviewer.
+		Queue     *QueuePosition    `json:"queue"`
+	}
+
+	// PullRequestCommentPayload is the payload for the UpsertPullRequestComment activity.
+	PullRequestCommentPayload struct {
+		Repo   *entities.Repo `json:"repo"`
+		Number int64          `json:"number"`
+		Body   string         `json:"body"`
+	}
+)
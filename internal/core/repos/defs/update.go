@@ -0,0 +1,28 @@
+package defs
+
+type (
+	// UpdateStrategy is the strategy used to bring a branch up to date with the default branch.
+	UpdateStrategy string
+
+	// UpdateBranchPayload is the payload to bring a branch up to date with the default branch.
+	UpdateBranchPayload struct {
+		Path       string         `json:"path"`
+		Branch     string         `json:"branch"`
+		Strategy   UpdateStrategy `json:"strategy"`
+		Trunk      string         `json:"trunk"`      // head of the default branch.
+		Expected   string         `json:"expected"`   // head of the branch on the remote before the update.
+		Head       string         `json:"head"`       // rebased head of the branch, only used by UpdateStrategyRebase.
+		Repository string         `json:"repository"` // name of the repository.
+	}
+)
+
+const (
+	UpdateStrategyNone   UpdateStrategy = "none"   // branches are never updated.
+	UpdateStrategyRebase UpdateStrategy = "rebase" // branches are rebased on the default branch and force pushed.
+	UpdateStrategyMerge  UpdateStrategy = "merge"  // the default branch is merged into the branches.
+)
+
+const (
+	UpdateCommitterName  = "quantm"
+	UpdateCommitterEmail = "quantm@breu.io"
+)
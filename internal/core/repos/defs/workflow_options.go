@@ -12,10 +12,19 @@ import (
 //
 //	"ai.ctrlplane.core.org.{org}.repos.{id}.name.{name}"
 func RepoWorkflowOptions(repo *entities.Repo) workflows.Options {
+	return ReplayRepoWorkflowOptions(repo, nil)
+}
+
+// ReplayRepoWorkflowOptions returns the workflow options for RepoCtrl of a dry run, the ones of the live workflow
+// without a replay. The workflow ID is formatted as:
+//
+//	"ai.ctrlplane.core.org.{org}.repos.{id}.replay.{replay}.name.{name}"
+func ReplayRepoWorkflowOptions(repo *entities.Repo, replay *Replay) workflows.Options {
 	opts := durable.NewWorkflowOptions(
 		durable.WithOrg(repo.OrgID.String()),
 		durable.WithSubject("repos"),
 		durable.WithSubjectID(repo.ID.String()),
+		replay_scope(replay),
 		durable.WithMeta("name", repo.Name),
 	)
 
@@ -27,10 +36,17 @@ func RepoWorkflowOptions(repo *entities.Repo) workflows.Options {
 //
 //	"ai.ctrlplane.core.org.{org}.repos.{id}.name.{name}.branch.{branch}"
 func BranchWorkflowOptions(repo *entities.Repo, branch string) workflows.Options {
+	return ReplayBranchWorkflowOptions(repo, branch, nil)
+}
+
+// ReplayBranchWorkflowOptions returns the workflow options for BranchCtrl of a dry run, the ones of the live workflow
+// without a replay.
+func ReplayBranchWorkflowOptions(repo *entities.Repo, branch string, replay *Replay) workflows.Options {
 	opts := durable.NewWorkflowOptions(
 		durable.WithOrg(repo.OrgID.String()),
 		durable.WithSubject("repos"),
 		durable.WithSubjectID(repo.ID.String()),
+		replay_scope(replay),
 		durable.WithMeta("name", repo.Name),
 		durable.WithMeta("branch", branch),
 	)
@@ -43,13 +59,62 @@ func BranchWorkflowOptions(repo *entities.Repo, branch string) workflows.Options
 //
 //	"ai.ctrlplane.core.org.{org}.repos.{id}.name.{name}.branch.trunk"
 func TrunkWorkflowOptions(repo *entities.Repo) workflows.Options {
+	return ReplayTrunkWorkflowOptions(repo, nil)
+}
+
+// ReplayTrunkWorkflowOptions returns the workflow options for TrunkCtrl of a dry run, the ones of the live workflow
+// without a replay.
+func ReplayTrunkWorkflowOptions(repo *entities.Repo, replay *Replay) workflows.Options {
 	opts := durable.NewWorkflowOptions(
 		durable.WithOrg(repo.OrgID.String()),
 		durable.WithSubject("repos"),
 		durable.WithSubjectID(repo.ID.String()),
+		replay_scope(replay),
 		durable.WithMeta("name", repo.Name),
 		durable.WithMeta("branch", "trunk"),
 	)
 
 	return opts
 }
+
+// ReplayWorkflowOptions returns workflow options for the replay of the events of a repo. The workflow ID is formatted
+// as:
+//
+//	"ai.ctrlplane.core.org.{org}.repos.{id}.replay.{replay}.events"
+func ReplayWorkflowOptions(repo *entities.Repo, id string) workflows.Options {
+	opts := durable.NewWorkflowOptions(
+		durable.WithOrg(repo.OrgID.String()),
+		durable.WithSubject("repos"),
+		durable.WithSubjectID(repo.ID.String()),
+		durable.WithScope("replay"),
+		durable.WithScopeID(id),
+		durable.WithAction("events"),
+	)
+
+	return opts
+}
+
+// ReplayScopeWorkflowOptions returns the workflow options whose id prefixes the ids of the repo, branch and trunk
+// workflows of a dry run, as well as of the replay itself. The workflow ID is formatted as:
+//
+//	"ai.ctrlplane.core.org.{org}.repos.{id}.replay.{replay}"
+func ReplayScopeWorkflowOptions(repo *entities.Repo, replay *Replay) workflows.Options {
+	opts := durable.NewWorkflowOptions(
+		durable.WithOrg(repo.OrgID.String()),
+		durable.WithSubject("repos"),
+		durable.WithSubjectID(repo.ID.String()),
+		replay_scope(replay),
+	)
+
+	return opts
+}
+
+// replay_scope scopes the ids of the workflows of a dry run to the replay, and leaves the live ones as they are.
+func replay_scope(replay *Replay) durable.WorkflowOptionBuilder {
+	return func(o *durable.WorkflowOptions) {
+		if replay != nil {
+			durable.WithScope("replay")(o)
+			durable.WithScopeID(replay.ID)(o)
+		}
+	}
+}
@@ -8,8 +8,9 @@ import (
 )
 
 const (
-	LabelMerge    = "quantm-merge"
-	LabelPriority = "quantm-priority"
+	LabelMerge      = "quantm-merge"
+	LabelPriority   = "quantm-priority"
+	LabelAutoUpdate = "quantm-auto-update"
 )
 
 // signals.
@@ -22,10 +23,15 @@ const (
 	SignalPullRequestReview        queues.Signal = "pr_review"         // signals a pull request review event.
 	SignalPullRequestReviewComment queues.Signal = "pr_review_comment" // signals a pull request review comment event.
 	SignalMergeQueue               queues.Signal = "merge_queue"       // signals a pull request queue event.
+	SignalRebaseCompleted          queues.Signal = "rebase_completed"  // signals the completion of a rebase attempt.
+	SignalOverlaps                 queues.Signal = "overlaps"          // signals the branches touching the same files.
+	SignalQueuePosition            queues.Signal = "queue_position"    // signals the position in the merge queue.
+	SignalCommand                  queues.Signal = "command"           // signals a command given on a pull request.
 )
 
 const (
 	QueryRepoForEventParent queues.Query = "event_parent" // query to find the parent event for the given event
+	QueryMergeQueue         queues.Query = "merge_queue"  // query the positions of the pull requests in the merge queue.
 )
 
 type (
@@ -70,11 +76,19 @@ type (
 		Signal queues.Signal  `json:"signal"`
 		Repo   *entities.Repo `json:"repo"`
 		Branch string         `json:"branch"`
+		Replay *Replay        `json:"replay,omitempty"` // set by the workflows of a dry run.
 	}
 
 	SignalTrunkPayload struct {
 		Signal queues.Signal  `json:"signal"`
 		Repo   *entities.Repo `json:"repo"`
+		Replay *Replay        `json:"replay,omitempty"` // set by the workflows of a dry run.
+	}
+
+	SignalRepoPayload struct {
+		Signal queues.Signal  `json:"signal"`
+		Repo   *entities.Repo `json:"repo"`
+		Replay *Replay        `json:"replay,omitempty"` // set by the workflows of a dry run.
 	}
 
 	SignalQueuePayload struct{}
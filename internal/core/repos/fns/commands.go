@@ -0,0 +1,111 @@
+package fns
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+var (
+	ErrUnknownCommand = errors.New("unknown command")
+	ErrUnknownFlag    = errors.New("unknown flag")
+)
+
+// ParseCommand returns the command given in the body of a comment. Only the first line starting with
+// defs.CommandPrefix is read. A comment without any such line holds no command, and nil is returned without an error.
+func ParseCommand(body string) (*eventsv1.Command, error) {
+	for _, line := range strings.Split(body, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || fields[0] != defs.CommandPrefix {
+			continue
+		}
+
+		if len(fields) == 1 {
+			return nil, ErrUnknownCommand
+		}
+
+		name := strings.ToLower(fields[1])
+
+		allowed, ok := defs.CommandFlags[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownCommand, fields[1])
+		}
+
+		command := &eventsv1.Command{Name: name, Flags: make([]string, 0)}
+
+		for _, field := range fields[2:] {
+			flag, found := strings.CutPrefix(field, "--")
+			if !found || !slices.Contains(allowed, flag) {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownFlag, field)
+			}
+
+			if !slices.Contains(command.Flags, flag) {
+				command.Flags = append(command.Flags, flag)
+			}
+		}
+
+		return command, nil
+	}
+
+	return nil, nil
+}
+
+// HasFlag reports whether the flag was given to the command.
+func HasFlag(command *eventsv1.Command, flag string) bool {
+	return slices.Contains(command.GetFlags(), flag)
+}
+
+// CommandUsage renders the list of the available commands as markdown.
+func CommandUsage() string {
+	return strings.Join([]string{
+		"Available commands:",
+		"",
+		fmt.Sprintf("- `%s merge [--priority]` adds the pull request to the merge queue.", defs.CommandPrefix),
+		fmt.Sprintf("- `%s dequeue` removes the pull request from the merge queue.", defs.CommandPrefix),
+		fmt.Sprintf("- `%s rebase` rebases the branch onto the default branch.", defs.CommandPrefix),
+		fmt.Sprintf("- `%s recheck` analyzes the branch again.", defs.CommandPrefix),
+		fmt.Sprintf("- `%s explain` explains what is holding the pull request back.", defs.CommandPrefix),
+		fmt.Sprintf("- `%s snooze` silences the drift reminders of the branch for a day.", defs.CommandPrefix),
+	}, "\n")
+}
+
+// CommandReply renders the reply to a command that has been handed over to the workflows. An empty reply means the
+// command replies on its own.
+func CommandReply(command *eventsv1.Command) string {
+	switch command.GetName() {
+	case defs.CommandMerge:
+		if HasFlag(command, defs.FlagPriority) {
+			return fmt.Sprintf("%s is added to the front of the merge queue.", pull_request(command))
+		}
+
+		return fmt.Sprintf("%s is added to the merge queue.", pull_request(command))
+	case defs.CommandDequeue:
+		return fmt.Sprintf("%s is removed from the merge queue.", pull_request(command))
+	case defs.CommandRebase:
+		if command.GetTrunk() == "" {
+			return fmt.Sprintf("A rebase of `%s` onto the default branch is queued.", command.GetBranch())
+		}
+
+		return fmt.Sprintf("A rebase of `%s` onto `%.7s` is queued.", command.GetBranch(), command.GetTrunk())
+	case defs.CommandRecheck:
+		return fmt.Sprintf("`%s` is being analyzed again, the checks and the summary will be updated.", command.GetBranch())
+	case defs.CommandSnooze:
+		return fmt.Sprintf("The drift reminders of `%s` are snoozed for a day.", command.GetBranch())
+	default:
+		return ""
+	}
+}
+
+// pull_request names the pull request of the command. Commands given on the chat may only name the branch, the number
+// is then resolved by the repo.
+func pull_request(command *eventsv1.Command) string {
+	if command.GetNumber() == 0 {
+		return fmt.Sprintf("The pull request of `%s`", command.GetBranch())
+	}
+
+	return fmt.Sprintf("#%d", command.GetNumber())
+}
@@ -0,0 +1,56 @@
+package fns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/core/repos/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func TestParseCommand(t *testing.T) {
+	t.Parallel()
+
+	command, err := fns.ParseCommand("LGTM, ship it.\n\n/quantm merge --priority\n/quantm dequeue")
+	if assert.NoError(t, err) && assert.NotNil(t, command) {
+		assert.Equal(t, defs.CommandMerge, command.GetName())
+		assert.True(t, fns.HasFlag(command, defs.FlagPriority))
+	}
+
+	command, err = fns.ParseCommand("  /quantm   Rebase  ")
+	if assert.NoError(t, err) && assert.NotNil(t, command) {
+		assert.Equal(t, defs.CommandRebase, command.GetName())
+		assert.Empty(t, command.GetFlags())
+	}
+
+	command, err = fns.ParseCommand("no command here, just /quantm mentioned")
+	assert.NoError(t, err)
+	assert.Nil(t, command)
+
+	_, err = fns.ParseCommand("/quantm deploy")
+	assert.ErrorIs(t, err, fns.ErrUnknownCommand)
+
+	_, err = fns.ParseCommand("/quantm")
+	assert.ErrorIs(t, err, fns.ErrUnknownCommand)
+
+	_, err = fns.ParseCommand("/quantm rebase --priority")
+	assert.ErrorIs(t, err, fns.ErrUnknownFlag)
+}
+
+func TestCommandReply(t *testing.T) {
+	t.Parallel()
+
+	command := &eventsv1.Command{Name: defs.CommandMerge, Number: 42, Flags: []string{defs.FlagPriority}}
+	assert.Equal(t, "#42 is added to the front of the merge queue.", fns.CommandReply(command))
+
+	command = &eventsv1.Command{Name: defs.CommandMerge, Branch: "feature"}
+	assert.Equal(t, "The pull request of `feature` is added to the merge queue.", fns.CommandReply(command))
+
+	command = &eventsv1.Command{Name: defs.CommandRebase, Branch: "feature"}
+	assert.Equal(t, "A rebase of `feature` onto the default branch is queued.", fns.CommandReply(command))
+
+	command = &eventsv1.Command{Name: defs.CommandExplain}
+	assert.Empty(t, fns.CommandReply(command))
+}
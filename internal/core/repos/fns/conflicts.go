@@ -0,0 +1,54 @@
+package fns
+
+import (
+	"strings"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+)
+
+type (
+	conflict_section int
+)
+
+const (
+	section_none conflict_section = iota
+	section_ours
+	section_base
+	section_theirs
+)
+
+// ConflictHunks finds the conflicting ranges of lines in the contents of a file with conflict markers, as written by a
+// three-way merge. The lines refer to the "theirs" side of the merge, i.e. the commit being rebased, so that the
+// ranges can be annotated on the branch. A conflict where "theirs" deleted the lines is reported as the single line
+// the deletion happened at.
+func ConflictHunks(path string, contents []byte) []defs.ConflictHunk {
+	hunks := make([]defs.ConflictHunk, 0)
+	section := section_none
+	line := 0  // last line of "theirs" seen.
+	start := 0 // first line of "theirs" in the current conflict.
+
+	for _, text := range strings.Split(string(contents), "\n") {
+		switch {
+		case strings.HasPrefix(text, "<<<<<<<") && section == section_none:
+			section = section_ours
+			start = line + 1
+		case strings.HasPrefix(text, "|||||||") && section == section_ours:
+			section = section_base
+		case strings.HasPrefix(text, "=======") && (section == section_ours || section == section_base):
+			section = section_theirs
+		case strings.HasPrefix(text, ">>>>>>>") && section == section_theirs:
+			end := line
+			if end < start {
+				start = max(line, 1)
+				end = start
+			}
+
+			hunks = append(hunks, defs.ConflictHunk{Path: path, StartLine: start, EndLine: end})
+			section = section_none
+		case section == section_none || section == section_theirs:
+			line++
+		}
+	}
+
+	return hunks
+}
@@ -0,0 +1,41 @@
+package fns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/core/repos/fns"
+)
+
+func TestConflictHunks(t *testing.T) {
+	t.Parallel()
+
+	contents := []byte(`package main
+
+<<<<<<< ours
+const name = "trunk"
+=======
+const name = "branch"
+const also = "branch"
+>>>>>>> theirs
+
+func main() {
+<<<<<<< ours
+	println(name)
+||||||| base
+	print(name)
+=======
+>>>>>>> theirs
+}
+`)
+
+	expected := []defs.ConflictHunk{
+		{Path: "main.go", StartLine: 3, EndLine: 4},
+		{Path: "main.go", StartLine: 6, EndLine: 6},
+	}
+
+	assert.Equal(t, expected, fns.ConflictHunks("main.go", contents))
+	assert.Empty(t, fns.ConflictHunks("main.go", []byte("package main\n")))
+}
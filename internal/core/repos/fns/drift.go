@@ -0,0 +1,43 @@
+package fns
+
+import (
+	"time"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// DaysSinceSync returns the number of whole days since the branch was last in sync with the default branch.
+func DaysSinceSync(drift *eventsv1.Drift, now time.Time) int32 {
+	if drift.GetSyncedAt() == nil {
+		return 0
+	}
+
+	elapsed := now.Sub(drift.GetSyncedAt().AsTime())
+	if elapsed < 0 {
+		return 0
+	}
+
+	return int32(elapsed / (time.Hour * 24)) // nolint:gosec
+}
+
+// DriftExceeded reports whether the drift of the branch exceeds any of the drift thresholds configured on the repo.
+// A zero threshold disables the corresponding check.
+func DriftExceeded(repo *entities.Repo, drift *eventsv1.Drift, now time.Time) bool {
+	if drift == nil {
+		return false
+	}
+
+	if repo.DriftBehind > 0 && drift.GetBehind() >= repo.DriftBehind {
+		return true
+	}
+
+	if duration := db.IntervalToDuration(repo.DriftDuration); duration > 0 && drift.GetSyncedAt() != nil {
+		if now.Sub(drift.GetSyncedAt().AsTime()) >= duration {
+			return true
+		}
+	}
+
+	return repo.DriftChurn > 0 && len(drift.GetChurn()) >= int(repo.DriftChurn)
+}
@@ -1,6 +1,8 @@
 package fns
 
 import (
+	"slices"
+
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
@@ -22,3 +24,22 @@ func GetLatestCommit(push *eventsv1.Push) *eventsv1.Commit {
 
 	return latest
 }
+
+// ChangedFiles returns the sorted list of files added, removed or modified by the commits of the push.
+func ChangedFiles(push *eventsv1.Push) []string {
+	seen := make(map[string]bool)
+	files := make([]string, 0)
+
+	for _, commit := range push.GetCommits() {
+		for _, file := range slices.Concat(commit.GetAdded(), commit.GetRemoved(), commit.GetModified()) {
+			if !seen[file] {
+				seen[file] = true
+				files = append(files, file)
+			}
+		}
+	}
+
+	slices.Sort(files)
+
+	return files
+}
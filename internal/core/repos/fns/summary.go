@@ -0,0 +1,243 @@
+package fns
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+const (
+	// SummaryMarker identifies the sticky summary comment among the comments of a pull request.
+	SummaryMarker = "<!-- quantm:summary -->"
+
+	root_project = "(root)"
+)
+
+// Projects returns the sorted list of top level directories touched by the diff. Files at the root of the repo are
+// grouped under a single root project.
+func Projects(diff *eventsv1.Diff) []string {
+	files := slices.Concat(diff.GetFiles().GetAdded(), diff.GetFiles().GetDeleted(), diff.GetFiles().GetModified())
+	for _, renamed := range diff.GetFiles().GetRenamed() {
+		files = append(files, renamed.GetNew())
+	}
+
+	seen := make(map[string]bool)
+	projects := make([]string, 0)
+
+	for _, file := range files {
+		project := root_project
+		if dir, _, found := strings.Cut(path.Clean(file), "/"); found {
+			project = dir
+		}
+
+		if !seen[project] {
+			seen[project] = true
+			projects = append(projects, project)
+		}
+	}
+
+	slices.Sort(projects)
+
+	return projects
+}
+
+// RenderSummary renders the summary of a pull request as the markdown body of the sticky comment. The output only
+// depends on the summary, so that an unchanged summary never results in an edit.
+func RenderSummary(summary *defs.PullRequestSummary) string {
+	rows := [][2]string{
+		{"Size", summary_size(summary)},
+		{"Conflicts", summary_conflict(summary)},
+		{"Overlapping branches", summary_overlaps(summary)},
+		{"Reviews", summary_reviews(summary)},
+		{"Merge queue", summary_queue(summary)},
+	}
+
+	if len(summary.Projects) > 0 {
+		rows = slices.Insert(rows, 1, [2]string{"Projects", code(summary.Projects)})
+	}
+
+	var body strings.Builder
+
+	body.WriteString(SummaryMarker + "\n")
+	body.WriteString("### quantm\n\n")
+	body.WriteString("| | |\n|---|---|\n")
+
+	for _, row := range rows {
+		fmt.Fprintf(&body, "| **%s** | %s |\n", row[0], row[1])
+	}
+
+	return body.String()
+}
+
+// FormatETA rounds the duration for display, e.g. "~45m" or "~2h10m". A zero duration is unknown.
+func FormatETA(eta time.Duration) string {
+	if eta <= 0 {
+		return "unknown"
+	}
+
+	if eta < time.Minute {
+		return "< 1m"
+	}
+
+	eta = eta.Round(time.Minute)
+	if eta < time.Hour {
+		return fmt.Sprintf("~%dm", int(eta.Minutes()))
+	}
+
+	return fmt.Sprintf("~%dh%02dm", int(eta.Hours()), int(eta.Minutes())%60)
+}
+
+func summary_size(summary *defs.PullRequestSummary) string {
+	if summary.Lines == nil {
+		return ":hourglass: not analyzed yet"
+	}
+
+	total := summary.Lines.Sum()
+	icon := ":white_check_mark:"
+
+	if total > int(summary.Threshold) {
+		icon = ":warning:"
+	}
+
+	return fmt.Sprintf(
+		"%s %d lines changed (+%d -%d), threshold %d",
+		icon, total, summary.Lines.Added, summary.Lines.Removed, summary.Threshold,
+	)
+}
+
+func summary_conflict(summary *defs.PullRequestSummary) string {
+	if summary.Conflict == nil {
+		return ":hourglass: not checked yet"
+	}
+
+	trunk := fmt.Sprintf("`%s` at `%.7s`", summary.Trunk, summary.Conflict.Trunk)
+
+	switch summary.Conflict.Status { // nolint:exhaustive
+	case defs.RebaseStatusSuccess, defs.RebaseStatusUpToDate:
+		return fmt.Sprintf(":white_check_mark: rebases cleanly onto %s", trunk)
+	case defs.RebaseStatusConflicts:
+		return fmt.Sprintf(":x: %d files conflict with %s: %s", len(summary.Conflict.Files), trunk, code(summary.Conflict.Files))
+	default:
+		return fmt.Sprintf(":grey_question: unable to rebase onto %s", trunk)
+	}
+}
+
+func summary_overlaps(summary *defs.PullRequestSummary) string {
+	if len(summary.Overlaps) == 0 {
+		return "none"
+	}
+
+	return fmt.Sprintf(":eyes: %s touch the same files", code(summary.Overlaps))
+}
+
+func summary_reviews(summary *defs.PullRequestSummary) string {
+	approved, changes := reviewers(summary)
+
+	switch {
+	case len(changes) > 0:
+		return fmt.Sprintf(":x: changes requested by %s", strings.Join(changes, ", "))
+	case len(approved) > 0:
+		return fmt.Sprintf(":white_check_mark: approved by %s", strings.Join(approved, ", "))
+	default:
+		return ":hourglass: waiting for review"
+	}
+}
+
+// reviewers splits the reviewers into the ones approving and the ones requesting changes, sorted by name.
+func reviewers(summary *defs.PullRequestSummary) ([]string, []string) {
+	approved := make([]string, 0)
+	changes := make([]string, 0)
+
+	for _, reviewer := range slices.Sorted(maps.Keys(summary.Reviews)) {
+		switch summary.Reviews[reviewer] {
+		case defs.ReviewApproved:
+			approved = append(approved, reviewer)
+		case defs.ReviewChangesRequested:
+			changes = append(changes, reviewer)
+		}
+	}
+
+	return approved, changes
+}
+
+func summary_queue(summary *defs.PullRequestSummary) string {
+	if summary.Queue == nil {
+		return "not queued"
+	}
+
+	if summary.Queue.Merging {
+		return ":rocket: being merged"
+	}
+
+	if summary.Queue.Position == 0 {
+		return "not queued"
+	}
+
+	return fmt.Sprintf(
+		"position %d of %d, ETA %s", summary.Queue.Position, summary.Queue.Total, FormatETA(summary.Queue.ETA),
+	)
+}
+
+func code(items []string) string {
+	quoted := make([]string, len(items))
+	for i, item := range items {
+		quoted[i] = fmt.Sprintf("`%s`", item)
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// RenderExplain renders what is holding the pull request back, as the reply to the explain command.
+func RenderExplain(summary *defs.PullRequestSummary) string {
+	reasons := make([]string, 0)
+
+	if summary.Lines != nil && summary.Lines.Sum() > int(summary.Threshold) {
+		reasons = append(reasons, fmt.Sprintf(
+			"The change is %d lines, above the threshold of %d. Large changes are hard to review, consider splitting it.",
+			summary.Lines.Sum(), summary.Threshold,
+		))
+	}
+
+	if summary.Conflict != nil && summary.Conflict.Status == defs.RebaseStatusConflicts {
+		reasons = append(reasons, fmt.Sprintf(
+			"The branch conflicts with `%s` in %s. Rebase the branch and resolve the conflicts.",
+			summary.Trunk, code(summary.Conflict.Files),
+		))
+	}
+
+	switch approved, changes := reviewers(summary); {
+	case len(changes) > 0:
+		reasons = append(reasons, fmt.Sprintf("Changes are requested by %s.", strings.Join(changes, ", ")))
+	case len(approved) == 0:
+		reasons = append(reasons, "The pull request is not approved yet.")
+	}
+
+	if len(summary.Overlaps) > 0 {
+		reasons = append(reasons, fmt.Sprintf(
+			"%s touch the same files, merging either first may cause conflicts for the other.", code(summary.Overlaps),
+		))
+	}
+
+	if summary.Queue != nil && summary.Queue.Position > 0 {
+		reasons = append(reasons, fmt.Sprintf(
+			"The pull request is waiting in the merge queue, at position %d of %d.", summary.Queue.Position, summary.Queue.Total,
+		))
+	}
+
+	if len(reasons) == 0 {
+		return fmt.Sprintf("Nothing is holding the pull request back, add it to the merge queue with `%s merge`.", defs.CommandPrefix)
+	}
+
+	items := make([]string, len(reasons))
+	for i, reason := range reasons {
+		items[i] = "- " + reason
+	}
+
+	return "The pull request is held back because:\n\n" + strings.Join(items, "\n")
+}
@@ -0,0 +1,59 @@
+package fns_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/core/repos/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func TestProjects(t *testing.T) {
+	t.Parallel()
+
+	diff := &eventsv1.Diff{
+		Files: &eventsv1.DiffFiles{
+			Added:    []string{"api/main.go", "README.md"},
+			Modified: []string{"web/src/app.ts", "api/go.mod"},
+			Renamed:  []*eventsv1.RenamedFile{{Old: "lib/a.go", New: "pkg/a.go"}},
+		},
+	}
+
+	assert.Equal(t, []string{"(root)", "api", "pkg", "web"}, fns.Projects(diff))
+	assert.Empty(t, fns.Projects(&eventsv1.Diff{}))
+}
+
+func TestRenderSummary(t *testing.T) {
+	t.Parallel()
+
+	summary := &defs.PullRequestSummary{
+		Lines:     &defs.DiffLines{Added: 400, Removed: 200},
+		Threshold: 500,
+		Trunk:     "main",
+		Projects:  []string{"api"},
+		Conflict:  &defs.SummaryConflict{Status: defs.RebaseStatusConflicts, Trunk: "0123456789", Files: []string{"api/main.go"}},
+		Overlaps:  []string{"feat/other"},
+		Reviews:   map[string]string{"b@example.com": defs.ReviewApproved, "a@example.com": defs.ReviewChangesRequested},
+		Queue:     &defs.QueuePosition{Position: 2, Total: 3, ETA: time.Minute * 90},
+	}
+
+	body := fns.RenderSummary(summary)
+
+	assert.Contains(t, body, fns.SummaryMarker)
+	assert.Contains(t, body, "| **Size** | :warning: 600 lines changed (+400 -200), threshold 500 |")
+	assert.Contains(t, body, "| **Projects** | `api` |")
+	assert.Contains(t, body, ":x: 1 files conflict with `main` at `0123456`: `api/main.go`")
+	assert.Contains(t, body, "`feat/other` touch the same files")
+	assert.Contains(t, body, ":x: changes requested by a@example.com")
+	assert.Contains(t, body, "position 2 of 3, ETA ~1h30m")
+	assert.Equal(t, body, fns.RenderSummary(summary))
+
+	empty := fns.RenderSummary(&defs.PullRequestSummary{})
+
+	assert.NotContains(t, empty, "Projects")
+	assert.Contains(t, empty, ":hourglass: not analyzed yet")
+	assert.Contains(t, empty, "not queued")
+}
@@ -3,13 +3,18 @@ package nomad
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/core/repos/cast"
 	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/erratic"
 	corev1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
 	"go.breu.io/quantm/internal/proto/ctrlplane/core/v1/corev1connect"
@@ -36,6 +41,98 @@ func (s *RepoService) ListRepos(
 	return connect.NewResponse(&corev1.ListReposResponse{Repos: protos}), nil
 }
 
+func (s *RepoService) ListBranchDrift(
+	ctx context.Context, req *connect.Request[corev1.ListBranchDriftRequest],
+) (*connect.Response[corev1.ListBranchDriftResponse], error) {
+	repo, err := s.repo(ctx, req.Msg.GetRepoId())
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Queries().ListBranchDrifts(ctx, entities.ListBranchDriftsParams{RepoID: repo.ID, OrgID: repo.OrgID})
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("repo_id", req.Msg.GetRepoId()).Wrap(err)
+	}
+
+	protos := cast.BranchDriftListToProto(repo, rows, time.Now())
+
+	return connect.NewResponse(&corev1.ListBranchDriftResponse{Branches: protos}), nil
+}
+
+// GetRepoSettings returns the settings of the repo.
+func (s *RepoService) GetRepoSettings(
+	ctx context.Context, req *connect.Request[corev1.GetRepoSettingsRequest],
+) (*connect.Response[corev1.GetRepoSettingsResponse], error) {
+	repo, err := s.repo(ctx, req.Msg.GetRepoId())
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&corev1.GetRepoSettingsResponse{Settings: cast.RepoToSettingsProto(repo)}), nil
+}
+
+// SetRepoSettings saves the settings of the repo. The push debounce must be positive and the update strategy known. The
+// drift thresholds can not be negative, zero disables the check.
+func (s *RepoService) SetRepoSettings(
+	ctx context.Context, req *connect.Request[corev1.SetRepoSettingsRequest],
+) (*connect.Response[corev1.SetRepoSettingsResponse], error) {
+	msg := req.Msg.GetSettings()
+
+	if msg.GetPushDebounce().AsDuration() <= 0 {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).
+			AddHint("push_debounce", msg.GetPushDebounce().AsDuration().String())
+	}
+
+	strategy, ok := cast.ProtoToUpdateStrategy(msg.GetAutoUpdate())
+	if !ok {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("auto_update", msg.GetAutoUpdate().String())
+	}
+
+	if msg.GetDriftBehind() < 0 || msg.GetDriftDuration().AsDuration() < 0 || msg.GetDriftChurn() < 0 {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).
+			AddHint("drift_behind", strconv.Itoa(int(msg.GetDriftBehind()))).
+			AddHint("drift_duration", msg.GetDriftDuration().AsDuration().String()).
+			AddHint("drift_churn", strconv.Itoa(int(msg.GetDriftChurn())))
+	}
+
+	repo, err := s.repo(ctx, msg.GetRepoId())
+	if err != nil {
+		return nil, err
+	}
+
+	entity, err := db.Queries().UpdateRepoSettings(ctx, cast.SettingsProtoToUpdateParams(repo.ID, msg, strategy))
+	if err != nil {
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("repo_id", msg.GetRepoId()).Wrap(err)
+	}
+
+	return connect.NewResponse(&corev1.SetRepoSettingsResponse{Settings: cast.RepoToSettingsProto(&entity)}), nil
+}
+
+// repo returns the repo if it belongs to the org of the authenticated user.
+func (s *RepoService) repo(ctx context.Context, raw string) (*entities.Repo, error) {
+	_, org_id := auth.NomadAuthContext(ctx)
+
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.CoreModule).AddHint("repo_id", raw).Wrap(err)
+	}
+
+	repo, err := db.Queries().GetRepo(ctx, id)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, erratic.NewNotFoundError(erratic.CoreModule, "repo").AddHint("repo_id", raw)
+		}
+
+		return nil, erratic.NewDatabaseError(erratic.CoreModule).AddHint("repo_id", raw).Wrap(err)
+	}
+
+	if repo.OrgID != org_id {
+		return nil, erratic.NewNotFoundError(erratic.CoreModule, "repo").AddHint("repo_id", raw)
+	}
+
+	return &repo, nil
+}
+
 func NewRepoServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
 	return corev1connect.NewRepoServiceHandler(&RepoService{}, opts...)
 }
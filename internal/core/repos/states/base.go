@@ -1,21 +1,30 @@
 package states
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"go.breu.io/durex/dispatch"
+	"go.breu.io/durex/queues"
 	"go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/workflow"
 
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/repos/activities"
+	"go.breu.io/quantm/internal/core/repos/defs"
 	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/events"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
 )
 
 type (
 	// Base represents the base state for repository workflows.  It encapsulates
 	// core data and provides logging utilities.
 	Base struct {
-		Repo     *entities.Repo     `json:"repo"`      // Repository entity.
-		ChatLink *entities.ChatLink `json:"chat_link"` // ChatLink entity.
+		Repo     *entities.Repo     `json:"repo"`             // Repository entity.
+		ChatLink *entities.ChatLink `json:"chat_link"`        // ChatLink entity.
+		Replay   *defs.Replay       `json:"replay,omitempty"` // Replay of a dry run, nil for the live workflows.
 
 		logger log.Logger // Workflow logger.
 	}
@@ -32,8 +41,14 @@ func (state *Base) rx(ctx workflow.Context, ch workflow.ReceiveChannel, target a
 }
 
 // run wraps workflow.ExecuteActivity with logging with the default activity context. If you need to
-// provide a custom context, use run_ex.
+// provide a custom context, use run_ex. During a dry run, the actions that do not only read are reported to the replay
+// as decisions instead, leaving the result as is.
 func (state *Base) run(ctx workflow.Context, action string, activity, event, result any, keyvals ...any) error {
+	if state.Replay != nil && !state.Replay.ReadOnly(action) {
+		state.decide(ctx, action, event)
+		return nil
+	}
+
 	state.logger.Info(fmt.Sprintf("dispatch(%s): init ...", action), keyvals...)
 
 	ctx = dispatch.WithDefaultActivityContext(ctx)
@@ -48,6 +63,79 @@ func (state *Base) run(ctx workflow.Context, action string, activity, event, res
 	return nil
 }
 
+// decide reports the action the workflow would have run, with its input, to the replay of the dry run. Failing to
+// report is logged, but never interrupts the workflow.
+func (state *Base) decide(ctx workflow.Context, action string, input any) {
+	state.logger.Info(fmt.Sprintf("dispatch(%s): dry run", action), "replay", state.Replay.ID)
+
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		state.logger.Warn("decide: unable to encode input", "action", action, "error", err.Error())
+	}
+
+	acts := &activities.Replay{}
+	decision := &defs.Decision{
+		Workflow: workflow.GetInfo(ctx).WorkflowExecution.ID,
+		Action:   action,
+		Input:    encoded,
+		At:       workflow.Now(ctx),
+	}
+	payload := &defs.DecisionPayload{Repo: state.Repo, Replay: state.Replay, Decision: decision}
+
+	if err := workflow.ExecuteActivity(ctx, acts.RecordDecision, payload).Get(ctx, nil); err != nil {
+		state.logger.Warn("decide: unable to report", "replay", state.Replay.ID, "action", action, "error", err.Error())
+	}
+}
+
+// check reports the check run against its commit through the hook of the repo. Failing to report is logged, but never
+// interrupts the workflow.
+func (state *Base) check(ctx workflow.Context, check *kernel.CheckRun) {
+	if check.Sha == "" {
+		return
+	}
+
+	acts := &activities.Kernel{}
+	payload := &defs.CheckRunPayload{Repo: state.Repo, Check: check}
+
+	if err := state.run(ctx, "check_run", acts.ReportCheckRun, payload, nil, "check", check.Name, "sha", check.Sha); err != nil {
+		state.logger.Warn("check_run: unable to report", "repo", state.Repo.ID, "check", check.Name, "error", err.Error())
+	}
+}
+
+// forward_to_branch routes the signal to the branch, starting its workflow if needed.
+func (state *Base) forward_to_branch(ctx workflow.Context, signal queues.Signal, branch string, event any) error {
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	acts := &activities.Repo{}
+	next := NewBranch(state.Repo, state.ChatLink, branch)
+	next.Replay = state.Replay
+	payload := &defs.SignalBranchPayload{Signal: signal, Repo: state.Repo, Branch: branch, Replay: state.Replay}
+
+	return workflow.ExecuteActivity(ctx, acts.ForwardToBranch, payload, event, next).Get(ctx, nil)
+}
+
+// chat_hook returns the chat hook the repo is linked to, if any. It is only recorded on the chat events, the hook that
+// delivers a notification is resolved from the chat link of its target when it is sent.
+func (state *Base) chat_hook() int32 {
+	if state.ChatLink != nil {
+		return state.ChatLink.Hook
+	}
+
+	return int32(eventsv1.ChatHook_CHAT_HOOK_UNSPECIFIED)
+}
+
+// persist persists the event, unless the workflow is a dry run, or the event is derived from a replayed one. The events
+// derived during the replay were persisted when the events were first signalled.
+func persist[H events.Hook, P events.Payload](ctx workflow.Context, state *Base, event *events.Event[H, P]) error {
+	if state.Replay != nil || event.Context.Replayed {
+		return nil
+	}
+
+	return pulse.Persist(ctx, event)
+}
+
 // - public
 
 // RestartRecommended checks if the workflow should be continued as new.
@@ -2,38 +2,52 @@ package states
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"go.breu.io/durex/dispatch"
 	"go.temporal.io/sdk/workflow"
 
+	"go.breu.io/quantm/internal/core/kernel"
 	"go.breu.io/quantm/internal/core/repos/activities"
 	"go.breu.io/quantm/internal/core/repos/cast"
 	"go.breu.io/quantm/internal/core/repos/defs"
 	"go.breu.io/quantm/internal/core/repos/fns"
+	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/durable/periodic"
 	"go.breu.io/quantm/internal/events"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
-	"go.breu.io/quantm/internal/pulse"
 )
 
 type (
 	BranchIntervals struct {
-		pr    periodic.Interval // used to send a notifcation if a pr is not opened within a certain time.
-		stale periodic.Interval // used to send a notification if a branch is stale.
+		pr       periodic.Interval // used to send a notifcation if a pr is not opened within a certain time.
+		stale    periodic.Interval // used to send a notification if a branch is stale.
+		debounce periodic.Interval // used to coalesce a burst of pushes into a single analysis.
 	}
 
 	Branch struct {
 		*Base `json:"base"` // Base workflow state.
 
-		Branch       string           `json:"branch"`
-		LatestCommit *eventsv1.Commit `json:"latest_commit"`
+		Branch       string                                           `json:"branch"`
+		LatestCommit *eventsv1.Commit                                 `json:"latest_commit"`
+		PendingPush  *events.Event[eventsv1.RepoHook, eventsv1.Push]  `json:"pending_push"`  // latest push awaiting analysis.
+		LastPush     *events.Event[eventsv1.RepoHook, eventsv1.Push]  `json:"last_push"`     // latest push analyzed.
+		AutoUpdate   bool                                             `json:"auto_update"`   // branch has opted in to updates.
+		Drift        *events.Event[eventsv1.RepoHook, eventsv1.Drift] `json:"drift"`         // latest drift from the default branch.
+		Drifting     bool                                             `json:"drifting"`      // drift thresholds are exceeded.
+		PR           int64                                            `json:"pr"`            // open pull request, 0 if none.
+		Summary      *defs.PullRequestSummary                         `json:"summary"`       // rendered as the sticky comment.
+		SnoozedUntil time.Time                                        `json:"snoozed_until"` // drift reminders are silenced until.
 
 		intervals BranchIntervals
 		acts      *activities.Branch
 		done      bool
+		posted    string // body of the summary comment as last posted.
 	}
 )
 
@@ -51,7 +65,8 @@ func (state *Branch) PullRequestMonitor(ctx workflow.Context) {
 }
 
 // StaleMonitor is a goroutine that monitors the branch for staleness. If the branch is stale, a notification is
-// sent to the hook associated with the branch.
+// sent to the hook associated with the branch. Since the days since the last sync grow without any activity on the
+// branch, the drift thresholds are checked again as well.
 //
 // TODO: implement the logic for sending a notification if the branch is stale.
 func (state *Branch) StaleMonitor(ctx workflow.Context) {
@@ -59,13 +74,37 @@ func (state *Branch) StaleMonitor(ctx workflow.Context) {
 		for {
 			state.intervals.stale.Tick(ctx_)
 			_ = state.notify_user(ctx)
+
+			state.check_drift(ctx_)
 		}
 	})
 }
 
-// OnPush resets the stale timer and processes the push event. The repo is cloned, the diff calculated, and
-// notifications sent if change complexity warrants. Author notification is prioritized, falling back to
-// the repo's chat hook.
+// PushMonitor is a goroutine that analyzes the pushes to the branch. Pushes arriving within the debounce window of
+// each other are coalesced, and only the latest push is analyzed once the window elapses without a new push.
+func (state *Branch) PushMonitor(ctx workflow.Context) {
+	workflow.Go(ctx, func(ctx_ workflow.Context) {
+		for {
+			_ = workflow.Await(ctx_, func() bool { return state.PendingPush != nil })
+
+			if state.intervals.debounce != nil {
+				state.intervals.debounce.Tick(ctx_)
+			}
+
+			push := state.PendingPush
+			state.PendingPush = nil
+			state.LastPush = push
+
+			state.analyze(ctx_, push)
+		}
+	})
+}
+
+// OnPush resets the stale timer and queues the push for analysis. If a push is already waiting for analysis, it is
+// replaced by the incoming push and the debounce window starts over.
+//
+// NOTE: every push is persisted to pulse by the hook before the branch is signaled, so coalescing only affects
+// the analysis.
 func (state *Branch) OnPush(ctx workflow.Context) durable.ChannelHandler {
 	return func(ch workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.Push]{}
@@ -73,35 +112,34 @@ func (state *Branch) OnPush(ctx workflow.Context) durable.ChannelHandler {
 
 		state.intervals.stale.Reset(ctx)
 
-		opts := &workflow.SessionOptions{ExecutionTimeout: time.Minute * 30, CreationTimeout: time.Second * 30}
+		state.LatestCommit = fns.GetLatestCommit(event.Payload)
 
-		session, err := workflow.CreateSession(ctx, opts)
-		if err != nil {
-			state.logger.Error("clone: unable to create session", "push", event.Payload.After, "error", err.Error())
-			return
+		if state.PendingPush != nil {
+			state.logger.Info("push: coalescing", "skipped", state.PendingPush.Payload.After, "latest", event.Payload.After)
 		}
 
-		defer workflow.CompleteSession(session)
-
-		state.LatestCommit = fns.GetLatestCommit(event.Payload)
-
-		clone := &defs.ClonePayload{Repo: state.Repo, Hook: event.Context.Hook, Branch: state.Branch, SHA: event.Payload.After}
-		path := state.clone(session, clone)
-		diff := state.diff(session, path, state.Repo.DefaultBranch, event.Payload.After)
-		state.remove_dir(ctx, path)
+		state.PendingPush = event
+		state.reset_debounce(ctx)
+	}
+}
 
-		// compare the diff
-		state.compare_diff(session, event, diff)
+// reset_debounce starts the debounce window over, if the pushes are debounced.
+func (state *Branch) reset_debounce(ctx workflow.Context) {
+	if state.intervals.debounce != nil {
+		state.intervals.debounce.Reset(ctx)
 	}
 }
 
 // OnRebase handles the rebase event for the branch. It creates a session, clones the repository at given branch,
-// attempts to rebase the branch with given sha, and removes the cloned repository.
+// attempts to rebase the branch with given sha, and removes the cloned repository. The attempt is always acknowledged
+// to the repo, so that the repo can dispatch the next one.
 func (state *Branch) OnRebase(ctx workflow.Context) durable.ChannelHandler {
 	return func(ch workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.Rebase]{}
 		state.rx(ctx, ch, event)
 
+		defer state.rebase_completed(ctx, event)
+
 		opts := &workflow.SessionOptions{ExecutionTimeout: time.Minute * 30, CreationTimeout: time.Second * 30}
 
 		session, err := workflow.CreateSession(ctx, opts)
@@ -115,10 +153,21 @@ func (state *Branch) OnRebase(ctx workflow.Context) durable.ChannelHandler {
 		clone := &defs.ClonePayload{Repo: state.Repo, Hook: event.Context.Hook, Branch: state.Branch, SHA: event.Payload.Head}
 		path := state.clone(session, clone)
 
+		if drift := state.drift(session, path); drift != nil {
+			state.on_drift(session, events.Next[eventsv1.RepoHook, eventsv1.Rebase, eventsv1.Drift](
+				event, events.ScopeDrift, events.ActionUpdated,
+			).SetPayload(drift))
+		}
+
 		rebase := &defs.RebaseResult{}
-		_ = state.run(ctx, "rebase", state.acts.Rebase, &defs.RebasePayload{Rebase: event.Payload, Path: path}, rebase)
+		_ = state.run(session, "rebase", state.acts.Rebase, &defs.RebasePayload{Rebase: event.Payload, Path: path}, rebase)
 
 		state.check_merge_conflict(session, event, rebase)
+		state.report_conflict(session, event, rebase)
+
+		state.Summary.Conflict = &defs.SummaryConflict{Status: rebase.Status, Trunk: event.Payload.Head, Files: rebase.Conflicts}
+		state.summarize(session)
+		state.update_branch(session, event, rebase, path)
 
 		state.remove_dir(ctx, path)
 	}
@@ -131,6 +180,8 @@ func (state *Branch) OnLabel(ctx workflow.Context) durable.ChannelHandler {
 		state.rx(ctx, rx, event)
 
 		switch event.Payload.Name {
+		case defs.LabelAutoUpdate:
+			state.AutoUpdate = event.Context.Action == events.EventActionAdded
 		case "qmerge":
 			fmt.Println("push to the queue based in level of priority")
 		case "priority-qmerge":
@@ -141,11 +192,62 @@ func (state *Branch) OnLabel(ctx workflow.Context) durable.ChannelHandler {
 	}
 }
 
-// OnPrReview handles pull request review events.
+// OnPR tracks the open pull request of the branch. The summary comment is posted as soon as the pull request is
+// opened, and the queue position forgotten once it is closed. A merged pull request is followed up on chat.
+func (state *Branch) OnPR(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		event := &events.Event[eventsv1.RepoHook, eventsv1.PullRequest]{}
+		state.rx(ctx, rx, event)
+
+		if event.Context.Action == events.ActionClosed {
+			state.report_queue_result(ctx, event.Payload)
+
+			state.PR = 0
+			state.Summary.Queue = nil
+
+			if event.Payload.Merged {
+				state.notify_merged(ctx, event)
+			}
+
+			return
+		}
+
+		if state.PR != event.Payload.Number {
+			state.posted = ""
+		}
+
+		state.PR = event.Payload.Number
+		state.summarize(ctx)
+	}
+}
+
+// OnPrReview records the latest review of each reviewer for the review gate of the summary. Reviewers are keyed by
+// their login on the provider, since the email of a user is private unless they make it public.
 func (state *Branch) OnPrReview(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		event := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestReview]{}
 		state.rx(ctx, rx, event)
+
+		reviewer := event.Payload.GetAuthorLogin()
+		if reviewer == "" {
+			reviewer = event.Payload.GetAuthorEmail()
+		}
+
+		if reviewer == "" {
+			state.logger.Warn("review: reviewer unknown", "repo", state.Repo.ID, "branch", state.Branch, "review", event.Payload.GetId())
+			return
+		}
+
+		switch review := strings.ToLower(event.Payload.State); review {
+		case defs.ReviewApproved, defs.ReviewChangesRequested:
+			state.Summary.Reviews[reviewer] = review
+		case defs.ReviewDismissed:
+			delete(state.Summary.Reviews, reviewer)
+		default: // comments do not change the gate.
+			return
+		}
+
+		state.summarize(ctx)
 	}
 }
 
@@ -157,6 +259,61 @@ func (state *Branch) OnPRReviewComment(ctx workflow.Context) durable.ChannelHand
 	}
 }
 
+// OnOverlaps records the other branches touching the same files as the branch.
+func (state *Branch) OnOverlaps(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		overlaps := &defs.BranchOverlaps{}
+		state.rx(ctx, rx, overlaps)
+
+		state.Summary.Overlaps = overlaps.Branches
+		state.summarize(ctx)
+	}
+}
+
+// OnQueuePosition records the position of the pull request of the branch in the merge queue.
+func (state *Branch) OnQueuePosition(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		position := &defs.QueuePosition{}
+		state.rx(ctx, rx, position)
+
+		state.Summary.Queue = position
+		state.summarize(ctx)
+	}
+}
+
+// OnCommand handles the commands given on the pull request of the branch. A recheck analyzes the latest push again
+// and posts the summary even if it has not changed, explain replies with what holds the pull request back, and snooze
+// silences the drift reminders for defs.SnoozeDuration.
+func (state *Branch) OnCommand(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		command := &events.Event[eventsv1.RepoHook, eventsv1.Command]{}
+		state.rx(ctx, rx, command)
+
+		switch command.Payload.Name {
+		case defs.CommandRecheck:
+			state.posted = ""
+
+			if state.LastPush == nil {
+				state.summarize(ctx)
+				return
+			}
+
+			if state.PendingPush == nil {
+				state.PendingPush = state.LastPush
+			}
+
+			state.reset_debounce(ctx)
+		case defs.CommandExplain:
+			state.Summary.Threshold = state.Repo.Threshold
+			state.Summary.Trunk = state.Repo.DefaultBranch
+			state.comment(ctx, command.Payload.Number, fns.RenderExplain(state.Summary))
+		case defs.CommandSnooze:
+			state.SnoozedUntil = workflow.Now(ctx).Add(defs.SnoozeDuration)
+			state.Drifting = false
+		}
+	}
+}
+
 // ExitLoop returns true if the branch should exit the event loop.
 func (state *Branch) ExitLoop(ctx workflow.Context) bool {
 	return state.done || workflow.GetInfo(ctx).GetContinueAsNewSuggested()
@@ -168,8 +325,50 @@ func (state *Branch) Init(ctx workflow.Context) {
 
 	pr := periodic.New(ctx, time.Minute*60*24)
 	stale := periodic.New(ctx, time.Minute*60*24)
-
 	state.intervals = BranchIntervals{pr: pr, stale: stale}
+
+	// a debounce that is not positive, e.g. set in the database, analyzes each push immediately.
+	if debounce := db.IntervalToDuration(state.Repo.PushDebounce); debounce > 0 {
+		state.intervals.debounce = periodic.New(ctx, debounce)
+	}
+
+	if state.Summary == nil {
+		state.Summary = &defs.PullRequestSummary{}
+	}
+
+	if state.Summary.Reviews == nil {
+		state.Summary.Reviews = make(map[string]string)
+	}
+}
+
+// analyze processes the push event. The repo is cloned, the diff and the drift from the default branch calculated, and
+// notifications sent if change complexity or drift warrants. Author notification is prioritized, falling back to the
+// repo's chat hook.
+func (state *Branch) analyze(ctx workflow.Context, event *events.Event[eventsv1.RepoHook, eventsv1.Push]) {
+	opts := &workflow.SessionOptions{ExecutionTimeout: time.Minute * 30, CreationTimeout: time.Second * 30}
+
+	session, err := workflow.CreateSession(ctx, opts)
+	if err != nil {
+		state.logger.Error("clone: unable to create session", "push", event.Payload.After, "error", err.Error())
+		return
+	}
+
+	defer workflow.CompleteSession(session)
+
+	clone := &defs.ClonePayload{Repo: state.Repo, Hook: event.Context.Hook, Branch: state.Branch, SHA: event.Payload.After}
+	path := state.clone(session, clone)
+	diff := state.diff(session, path, state.Repo.DefaultBranch, event.Payload.After)
+	drift := state.drift(session, path)
+	state.remove_dir(ctx, path)
+
+	if drift != nil {
+		state.on_drift(session, events.Next[eventsv1.RepoHook, eventsv1.Push, eventsv1.Drift](
+			event, events.ScopeDrift, events.ActionUpdated,
+		).SetPayload(drift))
+	}
+
+	// compare the diff
+	state.compare_diff(session, event, diff)
 }
 
 // clone clones the repository at the given SHA using a Temporal activity.  A UUID is generated for the clone path via SideEffect
@@ -202,19 +401,94 @@ func (state *Branch) diff(ctx workflow.Context, path, base, sha string) *eventsv
 	return result
 }
 
+// drift calculates how far the branch has drifted from the default branch using a Temporal activity. Returns nil if
+// the drift could not be calculated.
+func (state *Branch) drift(ctx workflow.Context, path string) *eventsv1.Drift {
+	payload := &defs.DriftPayload{Path: path, Branch: state.Branch, Trunk: state.Repo.DefaultBranch}
+	result := &eventsv1.Drift{}
+
+	if err := state.run(ctx, "drift", state.acts.Drift, payload, result); err != nil {
+		state.logger.Warn("drift: unable to calculate drift", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+		return nil
+	}
+
+	return result
+}
+
+// on_drift records the latest drift of the branch and checks it against the drift thresholds of the repo.
+func (state *Branch) on_drift(ctx workflow.Context, event *events.Event[eventsv1.RepoHook, eventsv1.Drift]) {
+	state.Drift = event
+
+	if err := persist(ctx, state.Base, event); err != nil {
+		state.logger.Warn("drift: unable to persist drift event", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+	}
+
+	payload := &defs.BranchDriftPayload{RepoID: state.Repo.ID, Branch: state.Branch, Drift: event.Payload}
+	if err := state.run(ctx, "save_drift", state.acts.SaveDrift, payload, nil); err != nil {
+		state.logger.Warn("drift: unable to save drift", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+	}
+
+	state.check_drift(ctx)
+}
+
+// check_drift sends a notification when the branch crosses any of the drift thresholds of the repo. The notification
+// is sent once per crossing, i.e. the branch has to get back within the thresholds before it is notified again. Once a
+// snooze elapses, the branch is notified again if it is still drifting.
+func (state *Branch) check_drift(ctx workflow.Context) {
+	if state.Drift == nil || workflow.Now(ctx).Before(state.SnoozedUntil) {
+		return
+	}
+
+	exceeded := fns.DriftExceeded(state.Repo, state.Drift.Payload, workflow.Now(ctx))
+	notify := exceeded && !state.Drifting
+	state.Drifting = exceeded
+
+	if !notify {
+		return
+	}
+
+	// check the repo's connected chat or user's connected chat.
+	hook := state.chat_hook()
+	event := cast.DriftEventToChatEvent(state.Drift, hook)
+
+	// persist chat event
+	if err := persist(ctx, state.Base, event); err != nil {
+		state.logger.Warn("drift: unable to persist chat event", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+	}
+
+	if err := state.run(ctx, "branch_drift", state.acts.NotifyBranchDrift, event, nil); err != nil {
+		state.logger.Error("branch_drift: unable to to send", "error", err.Error())
+	}
+}
+
 // check the change diff and if it exceed from the threshold sends message to user other wise message to repo connected group.
 func (state *Branch) compare_diff(
 	ctx workflow.Context, push *events.Event[eventsv1.RepoHook, eventsv1.Push], diff *eventsv1.Diff,
 ) {
 	dlt := diff.GetLines().GetAdded() + diff.GetLines().GetRemoved()
 
+	state.report_lines(ctx, push.Payload.After, diff)
+
+	if diff.GetLines() != nil {
+		state.Summary.Lines = &defs.DiffLines{Added: int(diff.GetLines().GetAdded()), Removed: int(diff.GetLines().GetRemoved())}
+
+		if state.Repo.IsMonorepo {
+			state.Summary.Projects = fns.Projects(diff)
+		}
+
+		state.summarize(ctx)
+	}
+
 	if dlt > state.Repo.Threshold {
+		diff.Branch = state.Branch
+		diff.Threshold = state.Repo.Threshold
+
 		// check the repo's connected chat or user's connected chat.
-		hook := int32(eventsv1.ChatHook_CHAT_HOOK_SLACK)
+		hook := state.chat_hook()
 		event := cast.PushEventToDiffEvent(push, hook, diff)
 
 		// persist chat event
-		if err := pulse.Persist(ctx, event); err != nil {
+		if err := persist(ctx, state.Base, event); err != nil {
 			state.logger.Warn(
 				"attempt_diff: unable to persist diff event",
 				"repo", state.Repo.ID, "branch", fns.BranchNameFromRef(push.Payload.Ref), "error", err.Error(),
@@ -227,34 +501,264 @@ func (state *Branch) compare_diff(
 	}
 }
 
-// check_merge_conflict check the merge conflict and send chat message otherwise nothing.
+// check_merge_conflict notifies the chat of the conflicts of the rebase as the state of the branch changes, i.e. when
+// a conflict appears or its files change, and not on every rebase finding the same conflict. Once a branch that was
+// conflicting rebases cleanly again, the chat is followed up.
 func (state *Branch) check_merge_conflict(
 	ctx workflow.Context, rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase], res *defs.RebaseResult,
 ) {
-	if len(res.Conflicts) > 0 {
-		// check the repo's connected chat or user's connected chat.
-		hook := int32(eventsv1.ChatHook_CHAT_HOOK_SLACK)
+	payload := &eventsv1.Merge{
+		HeadBranch: state.Branch,
+		HeadCommit: &eventsv1.Commit{Sha: res.Before},
+		BaseBranch: state.Repo.DefaultBranch,
+		BaseCommit: &eventsv1.Commit{Sha: rebase.Payload.Head},
+		Files:      res.Conflicts,
+	}
 
-		// TODO - head and base commits
-		payload := &eventsv1.Merge{
-			HeadBranch: rebase.Payload.Head,
-			BaseBranch: rebase.Payload.Base,
-			Files:      res.Conflicts,
-		}
+	// check the repo's connected chat or user's connected chat.
+	hook := state.chat_hook()
 
+	switch {
+	case len(res.Conflicts) > 0 && state.conflicting() && slices.Equal(state.Summary.Conflict.Files, res.Conflicts):
+		state.logger.Debug("merge_conflict: unchanged, skipping", "repo", state.Repo.ID, "branch", state.Branch)
+	case len(res.Conflicts) > 0:
 		event := cast.RebaseEventToMergeConflictEvent(rebase, hook, payload)
 
 		// persist chat event
-		if err := pulse.Persist(ctx, event); err != nil {
-			state.logger.Warn(
-				"attempt_merge: unable to persist merge event",
-				"repo", state.Repo.ID, "branch", payload.HeadBranch, "error", err.Error(),
-			)
+		if err := persist(ctx, state.Base, event); err != nil {
+			state.logger.Warn("merge_conflict: unable to persist merge event", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
 		}
 
 		if err := state.run(ctx, "merge_conflict", state.acts.NotifyMergeConflict, event, nil); err != nil {
 			state.logger.Error("merge_conflict: unable to to send", "error", err.Error())
 		}
+	case state.conflicting() && (res.Status == defs.RebaseStatusSuccess || res.Status == defs.RebaseStatusUpToDate):
+		event := cast.RebaseEventToConflictResolvedEvent(rebase, hook, payload)
+
+		if err := persist(ctx, state.Base, event); err != nil {
+			state.logger.Warn(
+				"conflict_resolved: unable to persist merge event", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error(),
+			)
+		}
+
+		if err := state.run(ctx, "conflict_resolved", state.acts.NotifyConflictResolved, event, nil); err != nil {
+			state.logger.Error("conflict_resolved: unable to to send", "error", err.Error())
+		}
+	}
+}
+
+// conflicting reports whether the latest rebase of the branch onto the default branch had conflicts.
+func (state *Branch) conflicting() bool {
+	return state.Summary.Conflict != nil && state.Summary.Conflict.Status == defs.RebaseStatusConflicts
+}
+
+// notify_merged follows up on the notifications of the branch on chat once its pull request is merged.
+func (state *Branch) notify_merged(ctx workflow.Context, pr *events.Event[eventsv1.RepoHook, eventsv1.PullRequest]) {
+	event := cast.PullRequestEventToMergedEvent(pr, state.chat_hook())
+
+	if err := persist(ctx, state.Base, event); err != nil {
+		state.logger.Warn("pr_merged: unable to persist pull request event", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+	}
+
+	if err := state.run(ctx, "pr_merged", state.acts.NotifyPullRequestMerged, event, nil); err != nil {
+		state.logger.Error("pr_merged: unable to to send", "error", err.Error())
+	}
+}
+
+// report_queue_result completes the queue check run of a pull request closed while in the merge queue with its result.
+// The pull requests taken off the queue before are reported by the trunk.
+func (state *Branch) report_queue_result(ctx workflow.Context, pr *eventsv1.PullRequest) {
+	queue := state.Summary.Queue
+	if queue == nil || queue.Sha == "" || queue.Number != pr.GetNumber() {
+		return
+	}
+
+	check := &kernel.CheckRun{
+		Sha:        queue.Sha,
+		Name:       defs.CheckQueue,
+		Status:     kernel.CheckStatusCompleted,
+		Conclusion: kernel.CheckConclusionSuccess,
+		Title:      "Merged",
+		Summary:    fmt.Sprintf("#%d was merged into `%s`.", pr.GetNumber(), pr.GetBaseBranch()),
+	}
+
+	if !pr.GetMerged() {
+		check.Conclusion = kernel.CheckConclusionNeutral
+		check.Title = "Closed without merging"
+		check.Summary = fmt.Sprintf("#%d was closed in the merge queue of `%s` without being merged.", pr.GetNumber(), pr.GetBaseBranch())
+	}
+
+	state.check(ctx, check)
+}
+
+// report_lines reports the size of the change against the threshold of the repo as a check run on the pushed commit.
+func (state *Branch) report_lines(ctx workflow.Context, sha string, diff *eventsv1.Diff) {
+	if diff.GetLines() == nil { // the diff could not be calculated.
+		return
+	}
+
+	added, removed := diff.GetLines().GetAdded(), diff.GetLines().GetRemoved()
+
+	check := &kernel.CheckRun{
+		Sha:        sha,
+		Name:       defs.CheckLines,
+		Status:     kernel.CheckStatusCompleted,
+		Conclusion: kernel.CheckConclusionSuccess,
+		Title:      fmt.Sprintf("%d lines changed, within the threshold of %d", added+removed, state.Repo.Threshold),
+		Summary:    fmt.Sprintf("+%d -%d lines against `%s`.", added, removed, state.Repo.DefaultBranch),
+	}
+
+	if added+removed > state.Repo.Threshold {
+		check.Conclusion = kernel.CheckConclusionFailure
+		check.Title = fmt.Sprintf("%d lines changed, above the threshold of %d", added+removed, state.Repo.Threshold)
+		check.Summary += " Large changes are hard to review, consider splitting the branch into smaller pull requests."
+	}
+
+	state.check(ctx, check)
+}
+
+// report_conflict reports the outcome of the rebase onto the default branch as a check run on the head of the branch.
+// Conflicts are annotated on the conflicting lines. Rebases failing for any other reason are not reported.
+func (state *Branch) report_conflict(
+	ctx workflow.Context, rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase], res *defs.RebaseResult,
+) {
+	trunk := fmt.Sprintf("`%s` at `%.7s`", state.Repo.DefaultBranch, rebase.Payload.Head)
+
+	check := &kernel.CheckRun{
+		Sha:        res.Before,
+		Name:       defs.CheckConflict,
+		Status:     kernel.CheckStatusCompleted,
+		Conclusion: kernel.CheckConclusionSuccess,
+		Title:      fmt.Sprintf("No conflicts with %s", state.Repo.DefaultBranch),
+		Summary:    fmt.Sprintf("The branch rebases cleanly onto %s.", trunk),
+	}
+
+	switch res.Status { // nolint:exhaustive
+	case defs.RebaseStatusSuccess, defs.RebaseStatusUpToDate:
+	case defs.RebaseStatusConflicts:
+		files := make([]string, len(res.Conflicts))
+		for i, file := range res.Conflicts {
+			files[i] = fmt.Sprintf("- `%s`", file)
+		}
+
+		check.Conclusion = kernel.CheckConclusionFailure
+		check.Title = fmt.Sprintf("%d files conflict with %s", len(res.Conflicts), state.Repo.DefaultBranch)
+		check.Summary = fmt.Sprintf("Rebasing onto %s conflicts in:\n\n%s", trunk, strings.Join(files, "\n"))
+		check.Annotations = make([]*kernel.CheckAnnotation, len(res.Hunks))
+
+		for i, hunk := range res.Hunks {
+			check.Annotations[i] = &kernel.CheckAnnotation{
+				Path:      hunk.Path,
+				StartLine: hunk.StartLine,
+				EndLine:   hunk.EndLine,
+				Level:     kernel.AnnotationLevelFailure,
+				Title:     fmt.Sprintf("Conflict with %s", state.Repo.DefaultBranch),
+				Message:   fmt.Sprintf("These lines conflict with %s, rebase the branch and resolve the conflict.", trunk),
+			}
+		}
+	default:
+		return
+	}
+
+	state.check(ctx, check)
+}
+
+// update_branch brings the branch up to date with the default branch after a clean rebase, provided both the repo and
+// the branch have opted in. The update is recorded in pulse as a push made by quantm.
+func (state *Branch) update_branch(
+	ctx workflow.Context, rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase], res *defs.RebaseResult, path string,
+) {
+	strategy := defs.UpdateStrategy(state.Repo.AutoUpdate)
+
+	if !state.AutoUpdate || strategy == defs.UpdateStrategyNone || res.Status != defs.RebaseStatusSuccess {
+		return
+	}
+
+	payload := &defs.UpdateBranchPayload{
+		Path:       path,
+		Branch:     state.Branch,
+		Strategy:   strategy,
+		Trunk:      rebase.Payload.Head,
+		Expected:   res.Before,
+		Head:       res.Head,
+		Repository: rebase.Payload.Repository,
+	}
+
+	result := &eventsv1.Push{}
+	if err := state.run(ctx, "update_branch", state.acts.UpdateBranch, payload, &result); err != nil {
+		state.logger.Warn("update_branch: unable to update", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+		return
+	}
+
+	if result == nil {
+		return
+	}
+
+	action := events.ActionUpdated
+	if strategy == defs.UpdateStrategyRebase {
+		action = events.ActionForced
+	}
+
+	event := events.Next[eventsv1.RepoHook, eventsv1.Rebase, eventsv1.Push](rebase, events.ScopePush, action).SetPayload(result)
+
+	if err := persist(ctx, state.Base, event); err != nil {
+		state.logger.Warn(
+			"update_branch: unable to persist push event",
+			"repo", state.Repo.ID, "branch", state.Branch, "error", err.Error(),
+		)
+	}
+}
+
+// rebase_completed acknowledges the rebase attempt to the repo.
+func (state *Branch) rebase_completed(ctx workflow.Context, rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase]) {
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	event := events.
+		Next[eventsv1.RepoHook, eventsv1.Rebase, eventsv1.Rebase](rebase, events.ScopeRebase, events.ActionCompleted).
+		SetPayload(rebase.Payload)
+
+	next := NewRepo(state.Repo, state.ChatLink)
+	next.Replay = state.Replay
+	payload := &defs.SignalRepoPayload{Signal: defs.SignalRebaseCompleted, Repo: state.Repo, Replay: state.Replay}
+
+	if err := workflow.ExecuteActivity(ctx, state.acts.ForwardToRepo, payload, event, next).Get(ctx, nil); err != nil {
+		state.logger.Warn("rebase: unable to acknowledge", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+	}
+}
+
+// summarize posts the summary as the sticky comment on the open pull request of the branch. Nothing is posted while
+// the branch has no open pull request, or when the summary renders the same as last posted.
+func (state *Branch) summarize(ctx workflow.Context) {
+	if state.PR == 0 {
+		return
+	}
+
+	state.Summary.Threshold = state.Repo.Threshold
+	state.Summary.Trunk = state.Repo.DefaultBranch
+
+	body := fns.RenderSummary(state.Summary)
+	if body == state.posted {
+		return
+	}
+
+	acts := &activities.Kernel{}
+	payload := &defs.PullRequestCommentPayload{Repo: state.Repo, Number: state.PR, Body: body}
+
+	if err := state.run(ctx, "pr_comment", acts.UpsertPullRequestComment, payload, nil, "number", state.PR); err != nil {
+		state.logger.Warn("pr_comment: unable to post summary", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
+		return
+	}
+
+	state.posted = body
+}
+
+// comment posts a reply on the pull request.
+func (state *Branch) comment(ctx workflow.Context, number int64, body string) {
+	acts := &activities.Kernel{}
+	payload := &defs.PullRequestCommentPayload{Repo: state.Repo, Number: number, Body: body}
+
+	if err := state.run(ctx, "pr_reply", acts.CommentOnPullRequest, payload, nil, "number", number); err != nil {
+		state.logger.Warn("pr_reply: unable to comment", "repo", state.Repo.ID, "branch", state.Branch, "error", err.Error())
 	}
 }
 
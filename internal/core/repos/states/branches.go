@@ -0,0 +1,151 @@
+package states
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"go.breu.io/quantm/internal/core/repos/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// BranchMeta holds what the repo knows about a branch. It is used to prioritise and pre-filter the rebase attempts
+	// on pushes to the default branch.
+	BranchMeta struct {
+		PR        int64     `json:"pr"`         // number of the open pull request, 0 if there is none.
+		Head      string    `json:"head"`       // sha of the latest push to the branch.
+		Files     []string  `json:"files"`      // files touched by the pushes to the branch.
+		UpdatedAt time.Time `json:"updated_at"` // time of the latest push to the branch.
+	}
+
+	BranchMetas map[string]*BranchMeta
+)
+
+// get returns the meta for the branch, creating it if it does not exist.
+func (b BranchMetas) get(branch string) *BranchMeta {
+	meta, ok := b[branch]
+	if !ok {
+		meta = &BranchMeta{Files: make([]string, 0)}
+		b[branch] = meta
+	}
+
+	return meta
+}
+
+// on_push records the files touched by the push and the time of the push.
+func (b BranchMetas) on_push(branch string, push *eventsv1.Push) {
+	meta := b.get(branch)
+
+	for _, file := range fns.ChangedFiles(push) {
+		if idx, found := slices.BinarySearch(meta.Files, file); !found {
+			meta.Files = slices.Insert(meta.Files, idx, file)
+		}
+	}
+
+	if push.GetTimestamp() != nil {
+		meta.UpdatedAt = push.GetTimestamp().AsTime()
+	}
+
+	meta.Head = push.GetAfter()
+}
+
+// by_pr returns the branch of the open pull request, or an empty string if it is not known.
+func (b BranchMetas) by_pr(number int64) string {
+	for branch, meta := range b {
+		if meta.PR == number {
+			return branch
+		}
+	}
+
+	return ""
+}
+
+// set_pr sets the open pull request for the branch. A zero number marks the branch as having no open pull request.
+func (b BranchMetas) set_pr(branch string, number int64) {
+	b.get(branch).PR = number
+}
+
+// remove forgets the branch.
+func (b BranchMetas) remove(branch string) {
+	delete(b, branch)
+}
+
+// overlaps reports whether the files touched by the branch intersect with the given files. If either side is unknown,
+// the branch is assumed to overlap, so that the pre-filter never skips a rebase that might conflict.
+func (b BranchMetas) overlaps(branch string, files []string) bool {
+	meta, ok := b[branch]
+	if !ok || len(meta.Files) == 0 || len(files) == 0 {
+		return true
+	}
+
+	for _, file := range files {
+		if _, found := slices.BinarySearch(meta.Files, file); found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// overlapping returns the sorted list of the other branches touching any of the files touched by the branch. Unlike
+// overlaps, unknown files never count as an overlap, since the result is shown to users.
+func (b BranchMetas) overlapping(branch string) []string {
+	result := make([]string, 0)
+
+	meta, ok := b[branch]
+	if !ok {
+		return result
+	}
+
+	for name, other := range b {
+		if name == branch {
+			continue
+		}
+
+		for _, file := range meta.Files {
+			if _, found := slices.BinarySearch(other.Files, file); found {
+				result = append(result, name)
+				break
+			}
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// prioritise sorts the branches in the order in which they should be rebased. Branches with an open pull request
+// come first, then the most recently active ones. Ties are broken by name to keep the order deterministic.
+func (b BranchMetas) prioritise(branches []string) []string {
+	sorted := slices.Clone(branches)
+
+	lookup := func(branch string) *BranchMeta {
+		if meta, ok := b[branch]; ok {
+			return meta
+		}
+
+		return &BranchMeta{}
+	}
+
+	slices.SortFunc(sorted, func(x, y string) int {
+		mx, my := lookup(x), lookup(y)
+
+		if (mx.PR != 0) != (my.PR != 0) {
+			if mx.PR != 0 {
+				return -1
+			}
+
+			return 1
+		}
+
+		if c := my.UpdatedAt.Compare(mx.UpdatedAt); c != 0 {
+			return c
+		}
+
+		return strings.Compare(x, y)
+	})
+
+	return sorted
+}
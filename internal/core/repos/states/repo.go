@@ -15,7 +15,6 @@ import (
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/events"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
-	"go.breu.io/quantm/internal/pulse"
 )
 
 type (
@@ -24,9 +23,16 @@ type (
 	Repo struct {
 		*Base    `json:"base"`  // Base workflow state.
 		Triggers BranchTriggers `json:"triggers"` // Branch triggers.
+		Branches BranchMetas    `json:"branches"` // Branch activity, used to prioritise rebase attempts.
+		Rebases  RebaseRequests `json:"rebases"`  // Pending rebase attempts, keyed by branch.
+		Trunk    string         `json:"trunk"`    // sha of the latest push to the default branch.
 
-		acts *activities.Repo
+		acts     *activities.Repo
+		inflight map[string]bool    // branches with a rebase attempt in flight.
+		slots    workflow.Semaphore // bounds the number of rebase attempts in flight.
 	}
+
+	RebaseRequests map[string]*events.Event[eventsv1.RepoHook, eventsv1.Rebase]
 )
 
 // - signal handlers -
@@ -43,16 +49,23 @@ func (state *Repo) OnPush(ctx workflow.Context) durable.ChannelHandler {
 		branch := fns.BranchNameFromRef(push.Payload.Ref)
 
 		if branch == state.Repo.DefaultBranch {
+			state.Trunk = push.Payload.After
 			state.attempt_rebase(ctx, push)
 
 			return
 		}
 
 		state.Triggers.add(branch, push.ID)
+		state.Branches.on_push(branch, push.Payload)
 
 		if err := state.forward_to_branch(ctx, defs.SignalPush, branch, push); err != nil {
 			state.logger.Warn("push: unable to signal branch", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
 		}
+
+		overlaps := &defs.BranchOverlaps{Branches: state.Branches.overlapping(branch)}
+		if err := state.forward_to_branch(ctx, defs.SignalOverlaps, branch, overlaps); err != nil {
+			state.logger.Warn("push: unable to signal overlaps", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
+		}
 	}
 }
 
@@ -74,24 +87,62 @@ func (state *Repo) OnRef(ctx workflow.Context) durable.ChannelHandler {
 
 			if ref.Context.Action == events.ActionDeleted {
 				state.Triggers.remove(branch)
+				state.Branches.remove(branch)
+				delete(state.Rebases, branch)
+
+				payload := &defs.BranchDriftPayload{RepoID: state.Repo.ID, Branch: branch}
+				if err := state.run(ctx, "remove_drift", state.acts.RemoveBranchDrift, payload, nil); err != nil {
+					state.logger.Warn("ref: unable to remove drift", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
+				}
 			}
 		}
 	}
 }
 
-// OnPR handles the pull request event on the repository.
+// OnPR handles the pull request event on the repository. It keeps track of the open pull request of the head branch,
+// and forwards the event to the head branch.
 func (state *Repo) OnPR(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		pr := &events.Event[eventsv1.RepoHook, eventsv1.PullRequest]{}
 		state.rx(ctx, rx, pr)
+
+		branch := pr.Payload.HeadBranch
+
+		if pr.Context.Action == events.ActionClosed {
+			state.Branches.set_pr(branch, 0)
+		} else {
+			state.Branches.set_pr(branch, pr.Payload.Number)
+		}
+
+		if err := state.forward_to_branch(ctx, defs.SignalPullRequest, branch, pr); err != nil {
+			state.logger.Warn("pr: unable to signal branch", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
+		}
 	}
 }
 
-// OnPRReview handles the pull request review event with on the repository.
-func (state *Repo) OnPRReview(ctx workflow.Context) durable.ChannelHandler {
+// OnPRLabel handles the pull request label event on the repository, forwarding it to the head branch.
+func (state *Repo) OnPRLabel(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
-		label := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestReview]{}
+		label := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestLabel]{}
 		state.rx(ctx, rx, label)
+
+		if err := state.forward_to_branch(ctx, defs.SignalPullRequestLabel, label.Payload.Branch, label); err != nil {
+			state.logger.Warn("label: unable to signal branch", "repo", state.Repo.ID, "branch", label.Payload.Branch, "error", err.Error())
+		}
+	}
+}
+
+// OnPRReview handles the pull request review event on the repository, forwarding it to the head branch.
+func (state *Repo) OnPRReview(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		review := &events.Event[eventsv1.RepoHook, eventsv1.PullRequestReview]{}
+		state.rx(ctx, rx, review)
+
+		if err := state.forward_to_branch(ctx, defs.SignalPullRequestReview, review.Payload.Branch, review); err != nil {
+			state.logger.Warn(
+				"review: unable to signal branch", "repo", state.Repo.ID, "branch", review.Payload.Branch, "error", err.Error(),
+			)
+		}
 	}
 }
 
@@ -112,6 +163,85 @@ func (state *Repo) OnMergeQueue(ctx workflow.Context) durable.ChannelHandler {
 	}
 }
 
+// OnCommand handles the commands given on pull requests or from the chat. Queue commands are forwarded to the trunk as
+// merge queue events, rebases are queued like the ones caused by a push to the default branch, and the rest is
+// forwarded to the branch.
+func (state *Repo) OnCommand(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		command := &events.Event[eventsv1.RepoHook, eventsv1.Command]{}
+		state.rx(ctx, rx, command)
+
+		state.resolve(command.Payload)
+
+		branch := command.Payload.Branch
+		if branch == "" {
+			state.logger.Warn("command: unable to resolve branch", "repo", state.Repo.ID, "number", command.Payload.Number)
+			return
+		}
+
+		switch command.Payload.Name {
+		case defs.CommandMerge, defs.CommandDequeue:
+			state.on_queue_command(ctx, command)
+		case defs.CommandRebase:
+			if command.Payload.Trunk == "" {
+				state.logger.Warn("command: default branch not known yet", "repo", state.Repo.ID, "branch", branch)
+				return
+			}
+
+			state.Rebases[branch] = events.
+				Next[eventsv1.RepoHook, eventsv1.Command, eventsv1.Rebase](command, events.ScopeRebase, events.ActionRequested).
+				SetPayload(&eventsv1.Rebase{Base: branch, Head: command.Payload.Trunk, Repository: state.Repo.Name})
+		default:
+			if err := state.forward_to_branch(ctx, defs.SignalCommand, branch, command); err != nil {
+				state.logger.Warn("command: unable to signal branch", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
+			}
+		}
+	}
+}
+
+// OnRebaseCompleted handles the acknowledgement of a rebase attempt from the branch, freeing up its slot.
+func (state *Repo) OnRebaseCompleted(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		rebase := &events.Event[eventsv1.RepoHook, eventsv1.Rebase]{}
+		state.rx(ctx, rx, rebase)
+
+		delete(state.inflight, rebase.Payload.Base)
+	}
+}
+
+// - monitors -
+
+// RebaseDispatcher is a goroutine that dispatches the pending rebase attempts to the branches, highest priority first.
+// At most defs.RebaseConcurrency attempts are in flight at any time. A slot is freed when the branch acknowledges the
+// attempt, or after defs.RebaseTimeout.
+func (state *Repo) RebaseDispatcher(ctx workflow.Context) {
+	workflow.Go(ctx, func(ctx_ workflow.Context) {
+		for {
+			if err := state.slots.Acquire(ctx_, 1); err != nil {
+				return
+			}
+
+			branch := ""
+
+			_ = workflow.Await(ctx_, func() bool {
+				branch = state.next_rebase()
+				return branch != ""
+			})
+
+			rebase := state.Rebases[branch]
+			delete(state.Rebases, branch)
+
+			state.inflight[branch] = true
+
+			workflow.Go(ctx_, func(ctx workflow.Context) {
+				defer state.slots.Release(1)
+
+				state.dispatch_rebase(ctx, branch, rebase)
+			})
+		}
+	})
+}
+
 // - query handlers -
 
 // QueryBranchTrigger queries the parent branch for the specified branch.
@@ -126,45 +256,130 @@ func (state *Repo) QueryBranchTrigger(branch string) (uuid.UUID, error) {
 
 // - local -
 
-// forward_to_branch routes the signal to the appropriate branch.
-func (state *Repo) forward_to_branch(ctx workflow.Context, signal queues.Signal, branch string, event any) error {
-	ctx = dispatch.WithDefaultActivityContext(ctx)
-
-	next := NewBranch(state.Repo, state.ChatLink, branch)
-	payload := &defs.SignalBranchPayload{Signal: signal, Repo: state.Repo, Branch: branch}
-
-	return workflow.ExecuteActivity(ctx, state.acts.ForwardToBranch, payload, event, next).Get(ctx, nil)
-}
-
 // forward_to_trunk routes the signal to the trunk.
 func (state *Repo) forward_to_trunk(ctx workflow.Context, signal queues.Signal, event any) error {
 	ctx = dispatch.WithDefaultActivityContext(ctx)
 
 	next := NewTrunk(state.Repo, state.ChatLink)
-	payload := &defs.SignalTrunkPayload{Signal: signal, Repo: state.Repo}
+	next.Replay = state.Replay
+	payload := &defs.SignalTrunkPayload{Signal: signal, Repo: state.Repo, Replay: state.Replay}
 
 	return workflow.ExecuteActivity(ctx, state.acts.ForwardToTrunk, payload, event, next).Get(ctx, nil)
 }
 
-// attempt_rebase rebases all branches with a trigger on the default branch.
-func (state *Repo) attempt_rebase(ctx workflow.Context, push *events.Event[eventsv1.RepoHook, eventsv1.Push]) {
-	for branch := range state.Triggers {
-		workflow.Go(ctx, func(ctx workflow.Context) {
-			rebase := events.
-				Next[eventsv1.RepoHook, eventsv1.Push, eventsv1.Rebase](push, events.ScopeRebase, events.ActionRequested).
-				SetPayload(&eventsv1.Rebase{Base: branch, Head: push.Payload.After, Repository: push.Payload.Repository})
-
-			if err := pulse.Persist(ctx, rebase); err != nil {
-				state.logger.Warn(
-					"attempt_rebase: unable to persist rebase event",
-					"repo", state.Repo.ID, "branch", branch, "error", err.Error(),
-				)
-			}
+// resolve fills in what the command leaves out. Commands from the chat only carry either the branch or the number of
+// the pull request, and never the shas, so these are looked up from the activity of the branches.
+func (state *Repo) resolve(command *eventsv1.Command) {
+	if command.Branch == "" && command.Number != 0 {
+		command.Branch = state.Branches.by_pr(command.Number)
+	}
 
-			if err := state.forward_to_branch(ctx, defs.SignalRebase, branch, rebase); err != nil {
-				state.logger.Warn("atemmpt_rebase: unable to signal branch", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
-			}
+	meta, ok := state.Branches[command.Branch]
+	if !ok {
+		return
+	}
+
+	if command.Number == 0 {
+		command.Number = meta.PR
+	}
+
+	if command.Sha == "" {
+		command.Sha = meta.Head
+	}
+
+	if command.Trunk == "" {
+		command.Trunk = state.Trunk
+	}
+}
+
+// on_queue_command translates the merge and dequeue commands into merge queue events for the trunk.
+func (state *Repo) on_queue_command(ctx workflow.Context, command *events.Event[eventsv1.RepoHook, eventsv1.Command]) {
+	if command.Payload.Number == 0 {
+		state.logger.Warn("command: no open pull request", "repo", state.Repo.ID, "branch", command.Payload.Branch)
+		return
+	}
+
+	action := events.EventActionAdded
+	if command.Payload.Name == defs.CommandDequeue {
+		action = events.EventActionRemoved
+	}
+
+	mq := events.
+		Next[eventsv1.RepoHook, eventsv1.Command, eventsv1.MergeQueue](command, events.ScopeMergeQueue, action).
+		SetPayload(&eventsv1.MergeQueue{
+			Number:     command.Payload.Number,
+			Branch:     command.Payload.Branch,
+			IsPriority: fns.HasFlag(command.Payload, defs.FlagPriority),
+			Timestamp:  command.Payload.Timestamp,
+			Sha:        command.Payload.Sha,
 		})
+
+	if err := persist(ctx, state.Base, mq); err != nil {
+		state.logger.Warn("command: unable to persist merge queue event", "repo", state.Repo.ID, "error", err.Error())
+	}
+
+	if err := state.forward_to_trunk(ctx, defs.SignalMergeQueue, mq); err != nil {
+		state.logger.Warn("command: unable to signal trunk", "repo", state.Repo.ID, "error", err.Error())
+	}
+}
+
+// attempt_rebase queues a rebase attempt for all branches with a trigger on the default branch. Branches that have
+// not touched any of the files changed on the default branch are skipped, since the rebase can not conflict. A queued
+// attempt that has not been dispatched yet is replaced, so that each branch is only rebased on the latest change.
+func (state *Repo) attempt_rebase(_ workflow.Context, push *events.Event[eventsv1.RepoHook, eventsv1.Push]) {
+	files := fns.ChangedFiles(push.Payload)
+
+	for branch := range state.Triggers {
+		if !state.Branches.overlaps(branch, files) {
+			state.logger.Info("attempt_rebase: skipping, no overlapping files", "repo", state.Repo.ID, "branch", branch)
+			continue
+		}
+
+		state.Rebases[branch] = events.
+			Next[eventsv1.RepoHook, eventsv1.Push, eventsv1.Rebase](push, events.ScopeRebase, events.ActionRequested).
+			SetPayload(&eventsv1.Rebase{Base: branch, Head: push.Payload.After, Repository: push.Payload.Repository})
+	}
+}
+
+// next_rebase returns the pending rebase attempt with the highest priority, skipping branches that already have an
+// attempt in flight. Returns an empty string if there is none.
+func (state *Repo) next_rebase() string {
+	pending := make([]string, 0, len(state.Rebases))
+
+	for branch := range state.Rebases {
+		if !state.inflight[branch] {
+			pending = append(pending, branch)
+		}
+	}
+
+	if len(pending) == 0 {
+		return ""
+	}
+
+	return state.Branches.prioritise(pending)[0]
+}
+
+// dispatch_rebase persists the rebase event, signals the branch and waits for the branch to acknowledge the attempt.
+func (state *Repo) dispatch_rebase(
+	ctx workflow.Context, branch string, rebase *events.Event[eventsv1.RepoHook, eventsv1.Rebase],
+) {
+	defer delete(state.inflight, branch)
+
+	if err := persist(ctx, state.Base, rebase); err != nil {
+		state.logger.Warn(
+			"attempt_rebase: unable to persist rebase event",
+			"repo", state.Repo.ID, "branch", branch, "error", err.Error(),
+		)
+	}
+
+	if err := state.forward_to_branch(ctx, defs.SignalRebase, branch, rebase); err != nil {
+		state.logger.Warn("attempt_rebase: unable to signal branch", "repo", state.Repo.ID, "branch", branch, "error", err.Error())
+		return
+	}
+
+	ok, _ := workflow.AwaitWithTimeout(ctx, defs.RebaseTimeout, func() bool { return !state.inflight[branch] })
+	if !ok {
+		state.logger.Warn("attempt_rebase: timed out waiting for branch", "repo", state.Repo.ID, "branch", branch)
 	}
 }
 
@@ -176,6 +391,17 @@ func (state *Repo) Init(ctx workflow.Context) {
 	if state.acts == nil {
 		state.acts = &activities.Repo{}
 	}
+
+	if state.Branches == nil {
+		state.Branches = make(BranchMetas)
+	}
+
+	if state.Rebases == nil {
+		state.Rebases = make(RebaseRequests)
+	}
+
+	state.inflight = make(map[string]bool)
+	state.slots = workflow.NewSemaphore(ctx, defs.RebaseConcurrency)
 }
 
 // NewRepo creates a new RepoState instance. It initializes BaseState using the provided context and
@@ -183,6 +409,8 @@ func (state *Repo) Init(ctx workflow.Context) {
 func NewRepo(repo *entities.Repo, chat *entities.ChatLink) *Repo {
 	base := &Base{Repo: repo, ChatLink: chat}
 	triggers := make(BranchTriggers)
+	branches := make(BranchMetas)
+	rebases := make(RebaseRequests)
 
-	return &Repo{base, triggers, &activities.Repo{}}
+	return &Repo{Base: base, Triggers: triggers, Branches: branches, Rebases: rebases, acts: &activities.Repo{}}
 }
@@ -173,6 +173,10 @@ func (q *Sequencer[K, E]) Demote(ctx workflow.Context, key K) {
 
 // Peek returns the item at the front of the queue without removing it.
 func (q *Sequencer[K, E]) Peek(ctx workflow.Context) *E {
+	if q.Head == nil {
+		return nil
+	}
+
 	return q.Head.Item
 }
 
@@ -221,6 +225,17 @@ func (q *Sequencer[K, E]) All(ctx workflow.Context) []*E {
 	return items
 }
 
+// Items returns all items in the queue without taking the lock. Query handlers must not block, so they use Items
+// instead of All.
+func (q *Sequencer[K, E]) Items() []*E {
+	items := make([]*E, 0)
+	for current := q.Head; current != nil; current = current.Next {
+		items = append(items, current.Item)
+	}
+
+	return items
+}
+
 // - Initialization and Creation -
 
 // Init restores the lock mutex.
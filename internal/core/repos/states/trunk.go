@@ -1,8 +1,13 @@
 package states
 
 import (
+	"fmt"
+	"time"
+
 	"go.temporal.io/sdk/workflow"
 
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/repos/defs"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/events"
@@ -13,6 +18,10 @@ type (
 	Trunk struct {
 		*Base      `json:"base"`
 		MergeQueue *Sequencer[int64, eventsv1.MergeQueue] `json:"merge_queue"`
+		MergeTime  time.Duration                          `json:"merge_time"` // average time between merges of a busy queue.
+		LastPop    time.Time                              `json:"last_pop"`   // time the last item left the queue.
+		Backlog    bool                                   `json:"backlog"`    // items were waiting when the last item left.
+		Reported   map[int64]defs.QueuePosition           `json:"reported"`   // positions last reported, by pull request.
 
 		done     bool                   // done flag
 		channel  workflow.Channel       // for cross loop communication
@@ -23,13 +32,17 @@ type (
 // - queue process -
 
 // OnMergeQueue is the signal handler for the merge queue.
-func (state Trunk) OnMergeQueue(ctx workflow.Context) durable.ChannelHandler {
+func (state *Trunk) OnMergeQueue(ctx workflow.Context) durable.ChannelHandler {
 	return func(rx workflow.ReceiveChannel, more bool) {
 		mq := &events.Event[eventsv1.RepoHook, eventsv1.MergeQueue]{}
 		state.rx(ctx, rx, mq)
 
+		defer state.report_queue(ctx)
+
 		if mq.Context.Action == events.EventActionRemoved {
 			state.MergeQueue.Remove(ctx, mq.Payload.GetNumber())
+			state.report_removed(ctx, mq.Payload)
+			state.position(ctx, mq.Payload, &defs.QueuePosition{Number: mq.Payload.GetNumber()})
 
 			return
 		}
@@ -44,12 +57,23 @@ func (state Trunk) OnMergeQueue(ctx workflow.Context) durable.ChannelHandler {
 	}
 }
 
-// StartQueue is the main queue processing loop.
+// StartQueue is the main queue processing loop. It waits for the next item of the queue, and stops once the workflow
+// is recommended to continue as new, waking the event loop of the workflow.
 func (state *Trunk) StartQueue(ctx workflow.Context) {
 	log := workflow.GetLogger(ctx)
 
-	for state.Continue() && state.MergeQueue.Peek(ctx) != nil {
+	for state.Continue() {
+		_ = workflow.Await(ctx, func() bool { return state.MergeQueue.Peek(ctx) != nil || state.RestartRecommended(ctx) })
+
+		if state.MergeQueue.Peek(ctx) == nil {
+			state.done = true
+			state.channel.Send(ctx, struct{}{})
+
+			return
+		}
+
 		next := state.MergeQueue.Pop(ctx) // next item
+		state.on_pop(ctx)
 
 		// ahead of line testing
 		// we rebase the changes from the branches that are being tested, this way, we can run tests on each.
@@ -60,7 +84,128 @@ func (state *Trunk) StartQueue(ctx workflow.Context) {
 		//
 		// we also will create a shadow branch that will be used to merge the changes into the main branch.
 		log.Info("merge_queue: attempting ahead of line merge ...", "next", next, "in_prgress", state.inflight)
+
+		state.check(ctx, &kernel.CheckRun{
+			Sha:     next.GetSha(),
+			Name:    defs.CheckQueue,
+			Status:  kernel.CheckStatusInProgress,
+			Title:   "Merging",
+			Summary: fmt.Sprintf("#%d is at the front of the merge queue of `%s`, and is being merged.", next.GetNumber(), state.Repo.DefaultBranch),
+		})
+
+		state.position(ctx, next, &defs.QueuePosition{Number: next.GetNumber(), Sha: next.GetSha(), Merging: true})
+		state.report_queue(ctx)
+	}
+}
+
+// OnDone is the handler waking the event loop once the queue processing loop stops.
+func (state *Trunk) OnDone(ctx workflow.Context) durable.ChannelHandler {
+	return func(rx workflow.ReceiveChannel, more bool) {
+		rx.Receive(ctx, nil)
+	}
+}
+
+// on_pop updates the average time between merges. Only the time between items leaving a queue that had items waiting
+// counts, so that an idle queue does not inflate the estimates.
+func (state *Trunk) on_pop(ctx workflow.Context) {
+	now := workflow.Now(ctx)
+
+	if state.Backlog && !state.LastPop.IsZero() {
+		elapsed := now.Sub(state.LastPop)
+
+		if state.MergeTime == 0 {
+			state.MergeTime = elapsed
+		} else {
+			state.MergeTime = (state.MergeTime + elapsed) / 2
+		}
 	}
+
+	state.LastPop = now
+	state.Backlog = state.MergeQueue.Peek(ctx) != nil
+}
+
+// report_queue reports the position of the pull requests waiting in the merge queue as a check run on their head, and
+// to their branch for the summary comment. Only the pull requests whose position changed since the last report are
+// reported, the check run is reported when the position itself changed.
+func (state *Trunk) report_queue(ctx workflow.Context) {
+	queued := state.MergeQueue.All(ctx)
+	reported := make(map[int64]defs.QueuePosition, len(queued))
+
+	for idx, item := range queued {
+		position := defs.QueuePosition{
+			Number:   item.GetNumber(),
+			Sha:      item.GetSha(),
+			Position: idx + 1,
+			Total:    len(queued),
+			ETA:      time.Duration(idx+1) * state.MergeTime,
+		}
+
+		reported[item.GetNumber()] = position
+		last, ok := state.Reported[item.GetNumber()]
+
+		if !ok || last.Position != position.Position || last.Sha != position.Sha {
+			state.check(ctx, &kernel.CheckRun{
+				Sha:     item.GetSha(),
+				Name:    defs.CheckQueue,
+				Status:  kernel.CheckStatusQueued,
+				Title:   fmt.Sprintf("Position %d in the merge queue", position.Position),
+				Summary: fmt.Sprintf("#%d is waiting to be merged into `%s`.", item.GetNumber(), state.Repo.DefaultBranch),
+			})
+		}
+
+		if !ok || last.Position != position.Position || last.Total != position.Total || last.Sha != position.Sha {
+			state.position(ctx, item, &position)
+		}
+	}
+
+	state.Reported = reported
+}
+
+// position signals the position of the pull request in the merge queue to its branch.
+func (state *Trunk) position(ctx workflow.Context, item *eventsv1.MergeQueue, position *defs.QueuePosition) {
+	if item.GetBranch() == "" {
+		return
+	}
+
+	if err := state.forward_to_branch(ctx, defs.SignalQueuePosition, item.GetBranch(), position); err != nil {
+		state.logger.Warn("queue: unable to signal position", "repo", state.Repo.ID, "branch", item.GetBranch(), "error", err.Error())
+	}
+}
+
+// report_removed completes the queue check run of a pull request taken off the merge queue before being merged.
+func (state *Trunk) report_removed(ctx workflow.Context, item *eventsv1.MergeQueue) {
+	state.check(ctx, &kernel.CheckRun{
+		Sha:        item.GetSha(),
+		Name:       defs.CheckQueue,
+		Status:     kernel.CheckStatusCompleted,
+		Conclusion: kernel.CheckConclusionNeutral,
+		Title:      "Removed from the merge queue",
+		Summary:    fmt.Sprintf("#%d was removed from the merge queue of `%s` before being merged.", item.GetNumber(), state.Repo.DefaultBranch),
+	})
+}
+
+// - query handlers -
+
+// QueryMergeQueue returns the position of every pull request waiting in the merge queue.
+func (state *Trunk) QueryMergeQueue() ([]defs.QueuePosition, error) {
+	queued := state.MergeQueue.Items()
+	positions := make([]defs.QueuePosition, len(queued))
+
+	for idx, item := range queued {
+		positions[idx] = defs.QueuePosition{
+			Number:   item.GetNumber(),
+			Position: idx + 1,
+			Total:    len(queued),
+			ETA:      time.Duration(idx+1) * state.MergeTime,
+		}
+	}
+
+	return positions, nil
+}
+
+// Done returns the channel the queue processing loop wakes the event loop on, once it stops.
+func (state *Trunk) Done() workflow.ReceiveChannel {
+	return state.channel
 }
 
 func (state *Trunk) Continue() bool {
@@ -71,14 +216,17 @@ func (state *Trunk) Init(ctx workflow.Context) {
 	state.Base.Init(ctx)
 	state.MergeQueue.Init(ctx)
 	state.channel = workflow.NewChannel(ctx)
+
+	if state.Reported == nil {
+		state.Reported = make(map[int64]defs.QueuePosition)
+	}
 }
 
 func NewTrunk(repo *entities.Repo, chat *entities.ChatLink) *Trunk {
 	return &Trunk{
-		&Base{Repo: repo, ChatLink: chat},
-		NewSequencer[int64, eventsv1.MergeQueue](),
-		false,
-		nil,
-		make([]*eventsv1.MergeQueue, 0),
+		Base:       &Base{Repo: repo, ChatLink: chat},
+		MergeQueue: NewSequencer[int64, eventsv1.MergeQueue](),
+		Reported:   make(map[int64]defs.QueuePosition),
+		inflight:   make([]*eventsv1.MergeQueue, 0),
 	}
 }
@@ -16,6 +16,7 @@ func Branch(ctx workflow.Context, state *states.Branch) error {
 
 	state.PullRequestMonitor(ctx)
 	state.StaleMonitor(ctx)
+	state.PushMonitor(ctx)
 
 	// - signal handlers -
 
@@ -25,6 +26,9 @@ func Branch(ctx workflow.Context, state *states.Branch) error {
 	rebase := workflow.GetSignalChannel(ctx, defs.SignalRebase.String())
 	selector.AddReceive(rebase, state.OnRebase(ctx))
 
+	pr := workflow.GetSignalChannel(ctx, defs.SignalPullRequest.String())
+	selector.AddReceive(pr, state.OnPR(ctx))
+
 	label := workflow.GetSignalChannel(ctx, defs.SignalPullRequestLabel.String())
 	selector.AddReceive(label, state.OnLabel(ctx))
 
@@ -34,6 +38,15 @@ func Branch(ctx workflow.Context, state *states.Branch) error {
 	prrc := workflow.GetSignalChannel(ctx, defs.SignalPullRequestReviewComment.String())
 	selector.AddReceive(prrc, state.OnPRReviewComment(ctx))
 
+	overlaps := workflow.GetSignalChannel(ctx, defs.SignalOverlaps.String())
+	selector.AddReceive(overlaps, state.OnOverlaps(ctx))
+
+	queue := workflow.GetSignalChannel(ctx, defs.SignalQueuePosition.String())
+	selector.AddReceive(queue, state.OnQueuePosition(ctx))
+
+	cmd := workflow.GetSignalChannel(ctx, defs.SignalCommand.String())
+	selector.AddReceive(cmd, state.OnCommand(ctx))
+
 	// - event loop -
 
 	for !state.ExitLoop(ctx) {
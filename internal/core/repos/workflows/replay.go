@@ -0,0 +1,119 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos/activities"
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/core/repos/states"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// Replay signals the events of a repo persisted by pulse, in order, to fresh repo workflows, which forward them to
+// fresh branch and trunk workflows as they would the events from the hooks.
+//
+// On a dry run, the workflows are isolated from the live ones, and report the actions acting outside of them as
+// decisions instead of running them. The replay then waits for the decisions until none is reported for the settle
+// time. The timers of the workflows run on the clock of the replay, not of the events, so that e.g. the stale branches
+// of the events are not reported unless the settle time is long enough. The workflows of the dry run are then stopped.
+func Replay(ctx workflow.Context, payload *defs.ReplayPayload) (*defs.ReplayResult, error) {
+	logger := workflow.GetLogger(ctx)
+	result := &defs.ReplayResult{Decisions: make([]*defs.Decision, 0)}
+
+	if err := workflow.SetQueryHandler(ctx, defs.QueryReplay.String(), func() (*defs.ReplayResult, error) {
+		return result, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var replay *defs.Replay
+	if payload.DryRun {
+		replay = &defs.Replay{ID: payload.ID}
+	}
+
+	decisions := workflow.GetSignalChannel(ctx, defs.SignalReplayDecision.String())
+
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		for {
+			decision := &defs.Decision{}
+			decisions.Receive(ctx, decision)
+
+			result.Decisions = append(result.Decisions, decision)
+		}
+	})
+
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+	acts := &activities.Replay{}
+
+	// - stop the workflows of the dry run, however the replay ends -
+	if replay != nil {
+		defer func() {
+			ctx, cancel := workflow.NewDisconnectedContext(ctx)
+			defer cancel()
+
+			stop := &defs.StopReplayPayload{Repo: payload.Repo, Replay: replay}
+			if err := workflow.ExecuteActivity(ctx, acts.Stop, stop).Get(ctx, nil); err != nil {
+				logger.Warn("replay: unable to stop the workflows", "replay", replay.ID, "error", err.Error())
+			}
+		}()
+	}
+
+	for offset := 0; ; offset += defs.ReplayPageSize {
+		rows := make([]*pulse.Row, 0)
+		page := &defs.ReplayEventsPayload{Slug: payload.Slug, Repo: payload.Repo, From: payload.From, To: payload.To, Offset: offset}
+
+		if err := workflow.ExecuteActivity(ctx, acts.Events, page).Get(ctx, &rows); err != nil {
+			return result, err
+		}
+
+		for _, row := range rows {
+			next := states.NewRepo(payload.Repo, payload.ChatLink)
+			next.Replay = replay
+
+			signalled := false
+			resignal := &defs.ResignalPayload{Repo: payload.Repo, ChatLink: payload.ChatLink, Replay: replay, Row: row}
+
+			if err := workflow.ExecuteActivity(ctx, acts.Resignal, resignal, next).Get(ctx, &signalled); err != nil {
+				return result, err
+			}
+
+			if signalled {
+				result.Events++
+			} else {
+				result.Skipped++
+			}
+		}
+
+		if len(rows) < defs.ReplayPageSize {
+			break
+		}
+	}
+
+	logger.Info("replay: events signalled", "events", result.Events, "skipped", result.Skipped)
+
+	if replay == nil {
+		return result, nil
+	}
+
+	settle := payload.Settle
+	if settle <= 0 {
+		settle = defs.ReplaySettle
+	}
+
+	// - wait for the decisions to settle -
+	for {
+		count := len(result.Decisions)
+
+		ok, err := workflow.AwaitWithTimeout(ctx, settle, func() bool { return len(result.Decisions) > count })
+		if err != nil {
+			return result, err
+		}
+
+		if !ok {
+			break
+		}
+	}
+
+	return result, nil
+}
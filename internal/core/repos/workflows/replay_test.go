@@ -0,0 +1,68 @@
+package workflows_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+
+	"go.breu.io/quantm/internal/core/repos/activities"
+	"go.breu.io/quantm/internal/core/repos/defs"
+	"go.breu.io/quantm/internal/core/repos/workflows"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+func TestReplayStopsItsWorkflows(t *testing.T) {
+	ts := &testsuite.WorkflowTestSuite{}
+	env := ts.NewTestWorkflowEnvironment()
+	acts := &activities.Replay{}
+	repo := &entities.Repo{ID: uuid.New(), OrgID: uuid.New(), Name: "quantm"}
+	stopped := make([]string, 0)
+
+	env.RegisterActivity(acts)
+	env.OnActivity(acts.Events, mock.Anything, mock.Anything).Return([]*pulse.Row{{ID: uuid.New()}}, nil).Once()
+	env.OnActivity(acts.Resignal, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+	env.OnActivity(acts.Stop, mock.Anything, mock.Anything).Return(func(_ context.Context, payload *defs.StopReplayPayload) error {
+		stopped = append(stopped, payload.Replay.ID)
+		return nil
+	}).Once()
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(defs.SignalReplayDecision.String(), &defs.Decision{Action: "merge"})
+	}, time.Second)
+
+	env.ExecuteWorkflow(workflows.Replay, &defs.ReplayPayload{ID: "dry", Repo: repo, DryRun: true, Settle: time.Minute})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	result := &defs.ReplayResult{}
+	require.NoError(t, env.GetWorkflowResult(result))
+
+	assert.Equal(t, 1, result.Events)
+	assert.Len(t, result.Decisions, 1)
+	assert.Equal(t, []string{"dry"}, stopped, "the workflows of the dry run are still running")
+	env.AssertExpectations(t)
+}
+
+func TestReplayLiveLeavesWorkflows(t *testing.T) {
+	ts := &testsuite.WorkflowTestSuite{}
+	env := ts.NewTestWorkflowEnvironment()
+	acts := &activities.Replay{}
+	repo := &entities.Repo{ID: uuid.New(), OrgID: uuid.New(), Name: "quantm"}
+
+	env.RegisterActivity(acts)
+	env.OnActivity(acts.Events, mock.Anything, mock.Anything).Return([]*pulse.Row{}, nil).Once()
+
+	env.ExecuteWorkflow(workflows.Replay, &defs.ReplayPayload{ID: "live", Repo: repo})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertNotCalled(t, "Stop", mock.Anything, mock.Anything)
+}
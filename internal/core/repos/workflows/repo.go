@@ -20,6 +20,10 @@ func Repo(ctx workflow.Context, state *states.Repo) error {
 		return err
 	}
 
+	// - monitors -
+
+	state.RebaseDispatcher(ctx)
+
 	// - signal handlers -
 
 	ref := workflow.GetSignalChannel(ctx, defs.SignalRef.String())
@@ -31,6 +35,9 @@ func Repo(ctx workflow.Context, state *states.Repo) error {
 	pr := workflow.GetSignalChannel(ctx, defs.SignalPullRequest.String())
 	selector.AddReceive(pr, state.OnPR(ctx))
 
+	label := workflow.GetSignalChannel(ctx, defs.SignalPullRequestLabel.String())
+	selector.AddReceive(label, state.OnPRLabel(ctx))
+
 	prr := workflow.GetSignalChannel(ctx, defs.SignalPullRequestReview.String())
 	selector.AddReceive(prr, state.OnPRReview(ctx))
 
@@ -40,6 +47,12 @@ func Repo(ctx workflow.Context, state *states.Repo) error {
 	prrc := workflow.GetSignalChannel(ctx, defs.SignalPullRequestReviewComment.String())
 	selector.AddReceive(prrc, state.OnPRReviewComment(ctx))
 
+	cmd := workflow.GetSignalChannel(ctx, defs.SignalCommand.String())
+	selector.AddReceive(cmd, state.OnCommand(ctx))
+
+	rc := workflow.GetSignalChannel(ctx, defs.SignalRebaseCompleted.String())
+	selector.AddReceive(rc, state.OnRebaseCompleted(ctx))
+
 	// - event loop -
 
 	for !state.RestartRecommended(ctx) {
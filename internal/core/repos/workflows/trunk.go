@@ -10,11 +10,18 @@ import (
 func Trunk(ctx workflow.Context, state *states.Trunk) error {
 	state.Init(ctx)
 
+	if err := workflow.SetQueryHandler(ctx, defs.QueryMergeQueue.String(), state.QueryMergeQueue); err != nil {
+		return err
+	}
+
 	selector := workflow.NewSelector(ctx)
 
 	mq := workflow.GetSignalChannel(ctx, defs.SignalMergeQueue.String())
 	selector.AddReceive(mq, state.OnMergeQueue(ctx))
 
+	done := state.Done()
+	selector.AddReceive(done, state.OnDone(ctx))
+
 	// - queue control -
 	workflow.Go(ctx, state.StartQueue)
 
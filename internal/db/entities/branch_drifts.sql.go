@@ -0,0 +1,117 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: branch_drifts.sql
+
+package entities
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteBranchDrift = `-- name: DeleteBranchDrift :exec
+DELETE FROM branch_drifts
+WHERE repo_id = $1 AND branch = $2
+`
+
+type DeleteBranchDriftParams struct {
+	RepoID uuid.UUID `json:"repo_id"`
+	Branch string    `json:"branch"`
+}
+
+func (q *Queries) DeleteBranchDrift(ctx context.Context, arg DeleteBranchDriftParams) error {
+	_, err := q.db.Exec(ctx, deleteBranchDrift, arg.RepoID, arg.Branch)
+	return err
+}
+
+const listBranchDrifts = `-- name: ListBranchDrifts :many
+SELECT drift.id, drift.created_at, drift.updated_at, drift.repo_id, drift.branch, drift.ahead, drift.behind, drift.synced_at, drift.churn
+FROM branch_drifts AS drift
+JOIN repos AS repo ON repo.id = drift.repo_id
+WHERE drift.repo_id = $1 AND repo.org_id = $2
+ORDER BY drift.behind DESC, drift.branch
+`
+
+type ListBranchDriftsParams struct {
+	RepoID uuid.UUID `json:"repo_id"`
+	OrgID  uuid.UUID `json:"org_id"`
+}
+
+func (q *Queries) ListBranchDrifts(ctx context.Context, arg ListBranchDriftsParams) ([]BranchDrift, error) {
+	rows, err := q.db.Query(ctx, listBranchDrifts, arg.RepoID, arg.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BranchDrift
+	for rows.Next() {
+		var i BranchDrift
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.RepoID,
+			&i.Branch,
+			&i.Ahead,
+			&i.Behind,
+			&i.SyncedAt,
+			&i.Churn,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertBranchDrift = `-- name: UpsertBranchDrift :one
+INSERT INTO branch_drifts (repo_id, branch, ahead, behind, synced_at, churn)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (repo_id, branch) DO UPDATE
+SET
+    ahead = EXCLUDED.ahead,
+    behind = EXCLUDED.behind,
+    synced_at = EXCLUDED.synced_at,
+    churn = EXCLUDED.churn,
+    updated_at = now()
+RETURNING id, created_at, updated_at, repo_id, branch, ahead, behind, synced_at, churn
+`
+
+type UpsertBranchDriftParams struct {
+	RepoID   uuid.UUID `json:"repo_id"`
+	Branch   string    `json:"branch"`
+	Ahead    int32     `json:"ahead"`
+	Behind   int32     `json:"behind"`
+	SyncedAt time.Time `json:"synced_at"`
+	Churn    []string  `json:"churn"`
+}
+
+func (q *Queries) UpsertBranchDrift(ctx context.Context, arg UpsertBranchDriftParams) (BranchDrift, error) {
+	row := q.db.QueryRow(ctx, upsertBranchDrift,
+		arg.RepoID,
+		arg.Branch,
+		arg.Ahead,
+		arg.Behind,
+		arg.SyncedAt,
+		arg.Churn,
+	)
+	var i BranchDrift
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepoID,
+		&i.Branch,
+		&i.Ahead,
+		&i.Behind,
+		&i.SyncedAt,
+		&i.Churn,
+	)
+	return i, err
+}
@@ -64,3 +64,84 @@ func (q *Queries) GetChatLink(ctx context.Context, linkTo uuid.UUID) (ChatLink,
 	)
 	return i, err
 }
+
+const getChatLinkByProviderUser = `-- name: GetChatLinkByProviderUser :one
+SELECT id, created_at, updated_at, hook, kind, link_to, data
+FROM chat_links
+WHERE
+  hook = $1
+  AND kind = 'user'
+  AND data ->> 'provider_team_id' = $2::text
+  AND data ->> 'provider_user_id' = $3::text
+`
+
+type GetChatLinkByProviderUserParams struct {
+	Hook   int32  `json:"hook"`
+	TeamID string `json:"team_id"`
+	UserID string `json:"user_id"`
+}
+
+func (q *Queries) GetChatLinkByProviderUser(ctx context.Context, arg GetChatLinkByProviderUserParams) (ChatLink, error) {
+	row := q.db.QueryRow(ctx, getChatLinkByProviderUser, arg.Hook, arg.TeamID, arg.UserID)
+	var i ChatLink
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Hook,
+		&i.Kind,
+		&i.LinkTo,
+		&i.Data,
+	)
+	return i, err
+}
+
+const listChatLinksByHook = `-- name: ListChatLinksByHook :many
+SELECT id, created_at, updated_at, hook, kind, link_to, data
+FROM chat_links
+WHERE hook = $1
+`
+
+func (q *Queries) ListChatLinksByHook(ctx context.Context, hook int32) ([]ChatLink, error) {
+	rows, err := q.db.Query(ctx, listChatLinksByHook, hook)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChatLink
+	for rows.Next() {
+		var i ChatLink
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Hook,
+			&i.Kind,
+			&i.LinkTo,
+			&i.Data,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateChatLinkData = `-- name: UpdateChatLinkData :exec
+UPDATE chat_links
+SET data = $2
+WHERE id = $1
+`
+
+type UpdateChatLinkDataParams struct {
+	ID   uuid.UUID `json:"id"`
+	Data []byte    `json:"data"`
+}
+
+func (q *Queries) UpdateChatLinkData(ctx context.Context, arg UpdateChatLinkDataParams) error {
+	_, err := q.db.Exec(ctx, updateChatLinkData, arg.ID, arg.Data)
+	return err
+}
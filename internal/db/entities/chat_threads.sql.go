@@ -0,0 +1,101 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: chat_threads.sql
+
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteChatThread = `-- name: DeleteChatThread :exec
+DELETE FROM chat_threads
+WHERE id = $1
+`
+
+func (q *Queries) DeleteChatThread(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteChatThread, id)
+	return err
+}
+
+const getChatThread = `-- name: GetChatThread :one
+SELECT id, created_at, updated_at, repo_id, branch, hook, target, channel, ts
+FROM chat_threads
+WHERE repo_id = $1 AND branch = $2 AND hook = $3 AND target = $4
+`
+
+type GetChatThreadParams struct {
+	RepoID uuid.UUID `json:"repo_id"`
+	Branch string    `json:"branch"`
+	Hook   int32     `json:"hook"`
+	Target string    `json:"target"`
+}
+
+func (q *Queries) GetChatThread(ctx context.Context, arg GetChatThreadParams) (ChatThread, error) {
+	row := q.db.QueryRow(ctx, getChatThread,
+		arg.RepoID,
+		arg.Branch,
+		arg.Hook,
+		arg.Target,
+	)
+	var i ChatThread
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepoID,
+		&i.Branch,
+		&i.Hook,
+		&i.Target,
+		&i.Channel,
+		&i.Ts,
+	)
+	return i, err
+}
+
+const upsertChatThread = `-- name: UpsertChatThread :one
+INSERT INTO chat_threads (repo_id, branch, hook, target, channel, ts)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (repo_id, branch, hook, target) DO UPDATE
+SET
+    channel = EXCLUDED.channel,
+    ts = EXCLUDED.ts,
+    updated_at = now()
+RETURNING id, created_at, updated_at, repo_id, branch, hook, target, channel, ts
+`
+
+type UpsertChatThreadParams struct {
+	RepoID  uuid.UUID `json:"repo_id"`
+	Branch  string    `json:"branch"`
+	Hook    int32     `json:"hook"`
+	Target  string    `json:"target"`
+	Channel string    `json:"channel"`
+	Ts      string    `json:"ts"`
+}
+
+func (q *Queries) UpsertChatThread(ctx context.Context, arg UpsertChatThreadParams) (ChatThread, error) {
+	row := q.db.QueryRow(ctx, upsertChatThread,
+		arg.RepoID,
+		arg.Branch,
+		arg.Hook,
+		arg.Target,
+		arg.Channel,
+		arg.Ts,
+	)
+	var i ChatThread
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepoID,
+		&i.Branch,
+		&i.Hook,
+		&i.Target,
+		&i.Channel,
+		&i.Ts,
+	)
+	return i, err
+}
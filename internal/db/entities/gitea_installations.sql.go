@@ -0,0 +1,131 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: gitea_installations.sql
+
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createGiteaInstallation = `-- name: CreateGiteaInstallation :one
+INSERT INTO gitea_installations (org_id, owner_id, owner_login)
+VALUES ($1, $2, $3)
+RETURNING id, created_at, updated_at, org_id, owner_id, owner_login, webhook_id, is_active
+`
+
+type CreateGiteaInstallationParams struct {
+	OrgID      uuid.UUID `json:"org_id"`
+	OwnerID    int64     `json:"owner_id"`
+	OwnerLogin string    `json:"owner_login"`
+}
+
+func (q *Queries) CreateGiteaInstallation(ctx context.Context, arg CreateGiteaInstallationParams) (GiteaInstallation, error) {
+	row := q.db.QueryRow(ctx, createGiteaInstallation, arg.OrgID, arg.OwnerID, arg.OwnerLogin)
+	var i GiteaInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.OwnerID,
+		&i.OwnerLogin,
+		&i.WebhookID,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getGiteaInstallation = `-- name: GetGiteaInstallation :one
+SELECT id, created_at, updated_at, org_id, owner_id, owner_login, webhook_id, is_active
+FROM gitea_installations
+WHERE id = $1
+`
+
+func (q *Queries) GetGiteaInstallation(ctx context.Context, id uuid.UUID) (GiteaInstallation, error) {
+	row := q.db.QueryRow(ctx, getGiteaInstallation, id)
+	var i GiteaInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.OwnerID,
+		&i.OwnerLogin,
+		&i.WebhookID,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getGiteaInstallationByOwnerID = `-- name: GetGiteaInstallationByOwnerID :one
+SELECT id, created_at, updated_at, org_id, owner_id, owner_login, webhook_id, is_active
+FROM gitea_installations
+WHERE owner_id = $1
+`
+
+func (q *Queries) GetGiteaInstallationByOwnerID(ctx context.Context, ownerID int64) (GiteaInstallation, error) {
+	row := q.db.QueryRow(ctx, getGiteaInstallationByOwnerID, ownerID)
+	var i GiteaInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.OwnerID,
+		&i.OwnerLogin,
+		&i.WebhookID,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const updateGiteaInstallation = `-- name: UpdateGiteaInstallation :one
+UPDATE gitea_installations
+SET
+    owner_login = $2,
+    is_active = $3
+WHERE id = $1
+RETURNING id, created_at, updated_at, org_id, owner_id, owner_login, webhook_id, is_active
+`
+
+type UpdateGiteaInstallationParams struct {
+	ID         uuid.UUID `json:"id"`
+	OwnerLogin string    `json:"owner_login"`
+	IsActive   bool      `json:"is_active"`
+}
+
+func (q *Queries) UpdateGiteaInstallation(ctx context.Context, arg UpdateGiteaInstallationParams) (GiteaInstallation, error) {
+	row := q.db.QueryRow(ctx, updateGiteaInstallation, arg.ID, arg.OwnerLogin, arg.IsActive)
+	var i GiteaInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.OwnerID,
+		&i.OwnerLogin,
+		&i.WebhookID,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const updateGiteaInstallationWebhookID = `-- name: UpdateGiteaInstallationWebhookID :exec
+UPDATE gitea_installations
+SET webhook_id = $2
+WHERE id = $1
+`
+
+type UpdateGiteaInstallationWebhookIDParams struct {
+	ID        uuid.UUID `json:"id"`
+	WebhookID int64     `json:"webhook_id"`
+}
+
+func (q *Queries) UpdateGiteaInstallationWebhookID(ctx context.Context, arg UpdateGiteaInstallationWebhookIDParams) error {
+	_, err := q.db.Exec(ctx, updateGiteaInstallationWebhookID, arg.ID, arg.WebhookID)
+	return err
+}
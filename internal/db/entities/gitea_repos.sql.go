@@ -0,0 +1,122 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: gitea_repos.sql
+
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const activateGiteaRepo = `-- name: ActivateGiteaRepo :exec
+UPDATE gitea_repos
+SET is_active = true
+WHERE id = $1
+`
+
+func (q *Queries) ActivateGiteaRepo(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, activateGiteaRepo, id)
+	return err
+}
+
+const createGiteaRepo = `-- name: CreateGiteaRepo :one
+INSERT INTO gitea_repos (installation_id, gitea_id, name, full_name, url)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, updated_at, installation_id, gitea_id, name, full_name, url, is_active
+`
+
+type CreateGiteaRepoParams struct {
+	InstallationID uuid.UUID `json:"installation_id"`
+	GiteaID        int64     `json:"gitea_id"`
+	Name           string    `json:"name"`
+	FullName       string    `json:"full_name"`
+	Url            string    `json:"url"`
+}
+
+func (q *Queries) CreateGiteaRepo(ctx context.Context, arg CreateGiteaRepoParams) (GiteaRepo, error) {
+	row := q.db.QueryRow(ctx, createGiteaRepo,
+		arg.InstallationID,
+		arg.GiteaID,
+		arg.Name,
+		arg.FullName,
+		arg.Url,
+	)
+	var i GiteaRepo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InstallationID,
+		&i.GiteaID,
+		&i.Name,
+		&i.FullName,
+		&i.Url,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getGiteaRepoByID = `-- name: GetGiteaRepoByID :one
+SELECT id, created_at, updated_at, installation_id, gitea_id, name, full_name, url, is_active
+FROM gitea_repos
+WHERE id = $1
+`
+
+func (q *Queries) GetGiteaRepoByID(ctx context.Context, id uuid.UUID) (GiteaRepo, error) {
+	row := q.db.QueryRow(ctx, getGiteaRepoByID, id)
+	var i GiteaRepo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InstallationID,
+		&i.GiteaID,
+		&i.Name,
+		&i.FullName,
+		&i.Url,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getGiteaRepoByInstallationIDAndGiteaID = `-- name: GetGiteaRepoByInstallationIDAndGiteaID :one
+SELECT id, created_at, updated_at, installation_id, gitea_id, name, full_name, url, is_active
+FROM gitea_repos
+WHERE installation_id = $1 AND gitea_id = $2
+`
+
+type GetGiteaRepoByInstallationIDAndGiteaIDParams struct {
+	InstallationID uuid.UUID `json:"installation_id"`
+	GiteaID        int64     `json:"gitea_id"`
+}
+
+func (q *Queries) GetGiteaRepoByInstallationIDAndGiteaID(ctx context.Context, arg GetGiteaRepoByInstallationIDAndGiteaIDParams) (GiteaRepo, error) {
+	row := q.db.QueryRow(ctx, getGiteaRepoByInstallationIDAndGiteaID, arg.InstallationID, arg.GiteaID)
+	var i GiteaRepo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InstallationID,
+		&i.GiteaID,
+		&i.Name,
+		&i.FullName,
+		&i.Url,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const suspendedGiteaRepo = `-- name: SuspendedGiteaRepo :exec
+UPDATE gitea_repos
+SET is_active = false
+WHERE id = $1
+`
+
+func (q *Queries) SuspendedGiteaRepo(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, suspendedGiteaRepo, id)
+	return err
+}
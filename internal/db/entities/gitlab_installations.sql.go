@@ -0,0 +1,148 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: gitlab_installations.sql
+
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const createGitlabInstallation = `-- name: CreateGitlabInstallation :one
+INSERT INTO gitlab_installations (org_id, url, group_id, group_path, access_token, webhook_secret)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, created_at, updated_at, org_id, url, group_id, group_path, access_token, is_active, webhook_secret
+`
+
+type CreateGitlabInstallationParams struct {
+	OrgID         uuid.UUID `json:"org_id"`
+	Url           string    `json:"url"`
+	GroupID       int64     `json:"group_id"`
+	GroupPath     string    `json:"group_path"`
+	AccessToken   string    `json:"access_token"`
+	WebhookSecret string    `json:"webhook_secret"`
+}
+
+func (q *Queries) CreateGitlabInstallation(ctx context.Context, arg CreateGitlabInstallationParams) (GitlabInstallation, error) {
+	row := q.db.QueryRow(ctx, createGitlabInstallation,
+		arg.OrgID,
+		arg.Url,
+		arg.GroupID,
+		arg.GroupPath,
+		arg.AccessToken,
+		arg.WebhookSecret,
+	)
+	var i GitlabInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Url,
+		&i.GroupID,
+		&i.GroupPath,
+		&i.AccessToken,
+		&i.IsActive,
+		&i.WebhookSecret,
+	)
+	return i, err
+}
+
+const getGitlabInstallation = `-- name: GetGitlabInstallation :one
+SELECT id, created_at, updated_at, org_id, url, group_id, group_path, access_token, is_active, webhook_secret
+FROM gitlab_installations
+WHERE id = $1
+`
+
+func (q *Queries) GetGitlabInstallation(ctx context.Context, id uuid.UUID) (GitlabInstallation, error) {
+	row := q.db.QueryRow(ctx, getGitlabInstallation, id)
+	var i GitlabInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Url,
+		&i.GroupID,
+		&i.GroupPath,
+		&i.AccessToken,
+		&i.IsActive,
+		&i.WebhookSecret,
+	)
+	return i, err
+}
+
+const getGitlabInstallationByURLAndGroupID = `-- name: GetGitlabInstallationByURLAndGroupID :one
+SELECT id, created_at, updated_at, org_id, url, group_id, group_path, access_token, is_active, webhook_secret
+FROM gitlab_installations
+WHERE url = $1 AND group_id = $2
+`
+
+type GetGitlabInstallationByURLAndGroupIDParams struct {
+	Url     string `json:"url"`
+	GroupID int64  `json:"group_id"`
+}
+
+func (q *Queries) GetGitlabInstallationByURLAndGroupID(ctx context.Context, arg GetGitlabInstallationByURLAndGroupIDParams) (GitlabInstallation, error) {
+	row := q.db.QueryRow(ctx, getGitlabInstallationByURLAndGroupID, arg.Url, arg.GroupID)
+	var i GitlabInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Url,
+		&i.GroupID,
+		&i.GroupPath,
+		&i.AccessToken,
+		&i.IsActive,
+		&i.WebhookSecret,
+	)
+	return i, err
+}
+
+const updateGitlabInstallation = `-- name: UpdateGitlabInstallation :one
+UPDATE gitlab_installations
+SET
+    group_path = $2,
+    access_token = $3,
+    webhook_secret = $4,
+    is_active = $5
+WHERE id = $1
+RETURNING id, created_at, updated_at, org_id, url, group_id, group_path, access_token, is_active, webhook_secret
+`
+
+type UpdateGitlabInstallationParams struct {
+	ID            uuid.UUID `json:"id"`
+	GroupPath     string    `json:"group_path"`
+	AccessToken   string    `json:"access_token"`
+	WebhookSecret string    `json:"webhook_secret"`
+	IsActive      bool      `json:"is_active"`
+}
+
+func (q *Queries) UpdateGitlabInstallation(ctx context.Context, arg UpdateGitlabInstallationParams) (GitlabInstallation, error) {
+	row := q.db.QueryRow(ctx, updateGitlabInstallation,
+		arg.ID,
+		arg.GroupPath,
+		arg.AccessToken,
+		arg.WebhookSecret,
+		arg.IsActive,
+	)
+	var i GitlabInstallation
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Url,
+		&i.GroupID,
+		&i.GroupPath,
+		&i.AccessToken,
+		&i.IsActive,
+		&i.WebhookSecret,
+	)
+	return i, err
+}
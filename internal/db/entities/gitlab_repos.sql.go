@@ -0,0 +1,141 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: gitlab_repos.sql
+
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const activateGitlabRepo = `-- name: ActivateGitlabRepo :exec
+UPDATE gitlab_repos
+SET is_active = true
+WHERE id = $1
+`
+
+func (q *Queries) ActivateGitlabRepo(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, activateGitlabRepo, id)
+	return err
+}
+
+const createGitlabRepo = `-- name: CreateGitlabRepo :one
+INSERT INTO gitlab_repos (installation_id, gitlab_id, name, full_name, url)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, created_at, updated_at, installation_id, gitlab_id, webhook_id, name, full_name, url, is_active
+`
+
+type CreateGitlabRepoParams struct {
+	InstallationID uuid.UUID `json:"installation_id"`
+	GitlabID       int64     `json:"gitlab_id"`
+	Name           string    `json:"name"`
+	FullName       string    `json:"full_name"`
+	Url            string    `json:"url"`
+}
+
+func (q *Queries) CreateGitlabRepo(ctx context.Context, arg CreateGitlabRepoParams) (GitlabRepo, error) {
+	row := q.db.QueryRow(ctx, createGitlabRepo,
+		arg.InstallationID,
+		arg.GitlabID,
+		arg.Name,
+		arg.FullName,
+		arg.Url,
+	)
+	var i GitlabRepo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InstallationID,
+		&i.GitlabID,
+		&i.WebhookID,
+		&i.Name,
+		&i.FullName,
+		&i.Url,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getGitlabRepoByID = `-- name: GetGitlabRepoByID :one
+SELECT id, created_at, updated_at, installation_id, gitlab_id, webhook_id, name, full_name, url, is_active
+FROM gitlab_repos
+WHERE id = $1
+`
+
+func (q *Queries) GetGitlabRepoByID(ctx context.Context, id uuid.UUID) (GitlabRepo, error) {
+	row := q.db.QueryRow(ctx, getGitlabRepoByID, id)
+	var i GitlabRepo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InstallationID,
+		&i.GitlabID,
+		&i.WebhookID,
+		&i.Name,
+		&i.FullName,
+		&i.Url,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const getGitlabRepoByInstallationIDAndGitlabID = `-- name: GetGitlabRepoByInstallationIDAndGitlabID :one
+SELECT id, created_at, updated_at, installation_id, gitlab_id, webhook_id, name, full_name, url, is_active
+FROM gitlab_repos
+WHERE installation_id = $1 AND gitlab_id = $2
+`
+
+type GetGitlabRepoByInstallationIDAndGitlabIDParams struct {
+	InstallationID uuid.UUID `json:"installation_id"`
+	GitlabID       int64     `json:"gitlab_id"`
+}
+
+func (q *Queries) GetGitlabRepoByInstallationIDAndGitlabID(ctx context.Context, arg GetGitlabRepoByInstallationIDAndGitlabIDParams) (GitlabRepo, error) {
+	row := q.db.QueryRow(ctx, getGitlabRepoByInstallationIDAndGitlabID, arg.InstallationID, arg.GitlabID)
+	var i GitlabRepo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.InstallationID,
+		&i.GitlabID,
+		&i.WebhookID,
+		&i.Name,
+		&i.FullName,
+		&i.Url,
+		&i.IsActive,
+	)
+	return i, err
+}
+
+const suspendedGitlabRepo = `-- name: SuspendedGitlabRepo :exec
+UPDATE gitlab_repos
+SET is_active = false
+WHERE id = $1
+`
+
+func (q *Queries) SuspendedGitlabRepo(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, suspendedGitlabRepo, id)
+	return err
+}
+
+const updateGitlabRepoWebhookID = `-- name: UpdateGitlabRepoWebhookID :exec
+UPDATE gitlab_repos
+SET webhook_id = $2
+WHERE id = $1
+`
+
+type UpdateGitlabRepoWebhookIDParams struct {
+	ID        uuid.UUID `json:"id"`
+	WebhookID int64     `json:"webhook_id"`
+}
+
+func (q *Queries) UpdateGitlabRepoWebhookID(ctx context.Context, arg UpdateGitlabRepoWebhookIDParams) error {
+	_, err := q.db.Exec(ctx, updateGitlabRepoWebhookID, arg.ID, arg.WebhookID)
+	return err
+}
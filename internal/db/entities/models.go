@@ -55,6 +55,18 @@ func (ns NullTeamRole) Value() (driver.Value, error) {
 	return string(ns.TeamRole), nil
 }
 
+type BranchDrift struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	RepoID    uuid.UUID `json:"repo_id"`
+	Branch    string    `json:"branch"`
+	Ahead     int32     `json:"ahead"`
+	Behind    int32     `json:"behind"`
+	SyncedAt  time.Time `json:"synced_at"`
+	Churn     []string  `json:"churn"`
+}
+
 type ChatLink struct {
 	ID        uuid.UUID `json:"id"`
 	CreatedAt time.Time `json:"created_at"`
@@ -65,6 +77,41 @@ type ChatLink struct {
 	Data      []byte    `json:"data"`
 }
 
+type ChatThread struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	RepoID    uuid.UUID `json:"repo_id"`
+	Branch    string    `json:"branch"`
+	Hook      int32     `json:"hook"`
+	Target    string    `json:"target"`
+	Channel   string    `json:"channel"`
+	Ts        string    `json:"ts"`
+}
+
+type GiteaInstallation struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	OrgID      uuid.UUID `json:"org_id"`
+	OwnerID    int64     `json:"owner_id"`
+	OwnerLogin string    `json:"owner_login"`
+	WebhookID  int64     `json:"webhook_id"`
+	IsActive   bool      `json:"is_active"`
+}
+
+type GiteaRepo struct {
+	ID             uuid.UUID `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	InstallationID uuid.UUID `json:"installation_id"`
+	GiteaID        int64     `json:"gitea_id"`
+	Name           string    `json:"name"`
+	FullName       string    `json:"full_name"`
+	Url            string    `json:"url"`
+	IsActive       bool      `json:"is_active"`
+}
+
 type GithubInstallation struct {
 	ID                  uuid.UUID `json:"id"`
 	CreatedAt           time.Time `json:"created_at"`
@@ -111,6 +158,81 @@ type GithubUser struct {
 	Login       string    `json:"login"`
 }
 
+type GitlabInstallation struct {
+	ID            uuid.UUID `json:"id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	OrgID         uuid.UUID `json:"org_id"`
+	Url           string    `json:"url"`
+	GroupID       int64     `json:"group_id"`
+	GroupPath     string    `json:"group_path"`
+	AccessToken   string    `json:"access_token"`
+	IsActive      bool      `json:"is_active"`
+	WebhookSecret string    `json:"webhook_secret"`
+}
+
+type GitlabRepo struct {
+	ID             uuid.UUID `json:"id"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	InstallationID uuid.UUID `json:"installation_id"`
+	GitlabID       int64     `json:"gitlab_id"`
+	WebhookID      int64     `json:"webhook_id"`
+	Name           string    `json:"name"`
+	FullName       string    `json:"full_name"`
+	Url            string    `json:"url"`
+	IsActive       bool      `json:"is_active"`
+}
+
+type NotificationDedup struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	RepoID      uuid.UUID `json:"repo_id"`
+	Branch      string    `json:"branch"`
+	Kind        string    `json:"kind"`
+	Fingerprint string    `json:"fingerprint"`
+	SentAt      time.Time `json:"sent_at"`
+}
+
+type NotificationDigest struct {
+	ID           uuid.UUID `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Recipient    uuid.UUID `json:"recipient"`
+	Channel      string    `json:"channel"`
+	RepoID       uuid.UUID `json:"repo_id"`
+	Kind         string    `json:"kind"`
+	Branch       string    `json:"branch"`
+	Summary      string    `json:"summary"`
+	DeliverAfter time.Time `json:"deliver_after"`
+}
+
+type NotificationPreference struct {
+	ID         uuid.UUID `json:"id"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	Kind       string    `json:"kind"`
+	LinkTo     uuid.UUID `json:"link_to"`
+	Muted      []string  `json:"muted"`
+	Channel    string    `json:"channel"`
+	Timezone   string    `json:"timezone"`
+	QuietStart int32     `json:"quiet_start"`
+	QuietEnd   int32     `json:"quiet_end"`
+	Digest     bool      `json:"digest"`
+	DigestHour int32     `json:"digest_hour"`
+}
+
+type NotificationRate struct {
+	ID          uuid.UUID `json:"id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	Recipient   uuid.UUID `json:"recipient"`
+	Channel     string    `json:"channel"`
+	WindowStart time.Time `json:"window_start"`
+	Count       int32     `json:"count"`
+}
+
 type OauthAccount struct {
 	ID                uuid.UUID `json:"id"`
 	CreatedAt         time.Time `json:"created_at"`
@@ -132,6 +254,32 @@ type Org struct {
 	Hooks     []byte    `json:"hooks"`
 }
 
+type PullRequestComment struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	RepoID    uuid.UUID `json:"repo_id"`
+	Number    int64     `json:"number"`
+	CommentID int64     `json:"comment_id"`
+}
+
+type PulseEvent struct {
+	ID          uuid.UUID   `json:"id"`
+	Version     string      `json:"version"`
+	Parents     []uuid.UUID `json:"parents"`
+	Hook        int32       `json:"hook"`
+	Scope       string      `json:"scope"`
+	Action      string      `json:"action"`
+	Source      string      `json:"source"`
+	SubjectID   uuid.UUID   `json:"subject_id"`
+	SubjectName string      `json:"subject_name"`
+	UserID      uuid.UUID   `json:"user_id"`
+	TeamID      uuid.UUID   `json:"team_id"`
+	OrgID       uuid.UUID   `json:"org_id"`
+	Timestamp   time.Time   `json:"timestamp"`
+	Payload     []byte      `json:"payload"`
+}
+
 type Repo struct {
 	ID            uuid.UUID       `json:"id"`
 	CreatedAt     time.Time       `json:"created_at"`
@@ -146,6 +294,26 @@ type Repo struct {
 	StaleDuration pgtype.Interval `json:"stale_duration"`
 	Url           string          `json:"url"`
 	IsActive      bool            `json:"is_active"`
+	PushDebounce  pgtype.Interval `json:"push_debounce"`
+	AutoUpdate    string          `json:"auto_update"`
+	DriftBehind   int32           `json:"drift_behind"`
+	DriftDuration pgtype.Interval `json:"drift_duration"`
+	DriftChurn    int32           `json:"drift_churn"`
+}
+
+type ReportSchedule struct {
+	ID        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	OrgID     uuid.UUID `json:"org_id"`
+	Kind      string    `json:"kind"`
+	LinkTo    uuid.UUID `json:"link_to"`
+	Frequency string    `json:"frequency"`
+	Timezone  string    `json:"timezone"`
+	Hour      int32     `json:"hour"`
+	Weekday   int32     `json:"weekday"`
+	Enabled   bool      `json:"enabled"`
+	SentAt    time.Time `json:"sent_at"`
 }
 
 type Team struct {
@@ -0,0 +1,168 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: notification_dedup.sql
+
+package entities
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteNotificationDedup = `-- name: DeleteNotificationDedup :exec
+DELETE FROM notification_dedup
+WHERE repo_id = $1 AND branch = $2 AND kind = ANY($3::text[])
+`
+
+type DeleteNotificationDedupParams struct {
+	RepoID uuid.UUID `json:"repo_id"`
+	Branch string    `json:"branch"`
+	Kinds  []string  `json:"kinds"`
+}
+
+func (q *Queries) DeleteNotificationDedup(ctx context.Context, arg DeleteNotificationDedupParams) error {
+	_, err := q.db.Exec(ctx, deleteNotificationDedup, arg.RepoID, arg.Branch, arg.Kinds)
+	return err
+}
+
+const getNotificationDedup = `-- name: GetNotificationDedup :one
+SELECT id, created_at, updated_at, repo_id, branch, kind, fingerprint, sent_at
+FROM notification_dedup
+WHERE repo_id = $1 AND branch = $2 AND kind = $3
+`
+
+type GetNotificationDedupParams struct {
+	RepoID uuid.UUID `json:"repo_id"`
+	Branch string    `json:"branch"`
+	Kind   string    `json:"kind"`
+}
+
+func (q *Queries) GetNotificationDedup(ctx context.Context, arg GetNotificationDedupParams) (NotificationDedup, error) {
+	row := q.db.QueryRow(ctx, getNotificationDedup, arg.RepoID, arg.Branch, arg.Kind)
+	var i NotificationDedup
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepoID,
+		&i.Branch,
+		&i.Kind,
+		&i.Fingerprint,
+		&i.SentAt,
+	)
+	return i, err
+}
+
+const getNotificationRate = `-- name: GetNotificationRate :one
+SELECT id, created_at, updated_at, recipient, channel, window_start, count
+FROM notification_rates
+WHERE recipient = $1 AND channel = $2
+`
+
+type GetNotificationRateParams struct {
+	Recipient uuid.UUID `json:"recipient"`
+	Channel   string    `json:"channel"`
+}
+
+func (q *Queries) GetNotificationRate(ctx context.Context, arg GetNotificationRateParams) (NotificationRate, error) {
+	row := q.db.QueryRow(ctx, getNotificationRate, arg.Recipient, arg.Channel)
+	var i NotificationRate
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Recipient,
+		&i.Channel,
+		&i.WindowStart,
+		&i.Count,
+	)
+	return i, err
+}
+
+const incrementNotificationRate = `-- name: IncrementNotificationRate :one
+INSERT INTO notification_rates (recipient, channel, window_start, count)
+VALUES ($1, $2, $3::timestamptz, 1)
+ON CONFLICT (recipient, channel) DO UPDATE
+SET
+    count = CASE
+      WHEN notification_rates.window_start <= $4::timestamptz THEN 1
+      ELSE notification_rates.count + 1
+    END,
+    window_start = CASE
+      WHEN notification_rates.window_start <= $4::timestamptz THEN EXCLUDED.window_start
+      ELSE notification_rates.window_start
+    END,
+    updated_at = now()
+RETURNING id, created_at, updated_at, recipient, channel, window_start, count
+`
+
+type IncrementNotificationRateParams struct {
+	Recipient uuid.UUID `json:"recipient"`
+	Channel   string    `json:"channel"`
+	Now       time.Time `json:"now"`
+	Expired   time.Time `json:"expired"`
+}
+
+func (q *Queries) IncrementNotificationRate(ctx context.Context, arg IncrementNotificationRateParams) (NotificationRate, error) {
+	row := q.db.QueryRow(ctx, incrementNotificationRate,
+		arg.Recipient,
+		arg.Channel,
+		arg.Now,
+		arg.Expired,
+	)
+	var i NotificationRate
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Recipient,
+		&i.Channel,
+		&i.WindowStart,
+		&i.Count,
+	)
+	return i, err
+}
+
+const upsertNotificationDedup = `-- name: UpsertNotificationDedup :one
+INSERT INTO notification_dedup (repo_id, branch, kind, fingerprint, sent_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (repo_id, branch, kind) DO UPDATE
+SET
+    fingerprint = EXCLUDED.fingerprint,
+    sent_at = EXCLUDED.sent_at,
+    updated_at = now()
+RETURNING id, created_at, updated_at, repo_id, branch, kind, fingerprint, sent_at
+`
+
+type UpsertNotificationDedupParams struct {
+	RepoID      uuid.UUID `json:"repo_id"`
+	Branch      string    `json:"branch"`
+	Kind        string    `json:"kind"`
+	Fingerprint string    `json:"fingerprint"`
+	SentAt      time.Time `json:"sent_at"`
+}
+
+func (q *Queries) UpsertNotificationDedup(ctx context.Context, arg UpsertNotificationDedupParams) (NotificationDedup, error) {
+	row := q.db.QueryRow(ctx, upsertNotificationDedup,
+		arg.RepoID,
+		arg.Branch,
+		arg.Kind,
+		arg.Fingerprint,
+		arg.SentAt,
+	)
+	var i NotificationDedup
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepoID,
+		&i.Branch,
+		&i.Kind,
+		&i.Fingerprint,
+		&i.SentAt,
+	)
+	return i, err
+}
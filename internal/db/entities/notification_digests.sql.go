@@ -0,0 +1,153 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: notification_digests.sql
+
+package entities
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createNotificationDigest = `-- name: CreateNotificationDigest :one
+INSERT INTO notification_digests (recipient, channel, repo_id, kind, branch, summary, deliver_after)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, created_at, updated_at, recipient, channel, repo_id, kind, branch, summary, deliver_after
+`
+
+type CreateNotificationDigestParams struct {
+	Recipient    uuid.UUID `json:"recipient"`
+	Channel      string    `json:"channel"`
+	RepoID       uuid.UUID `json:"repo_id"`
+	Kind         string    `json:"kind"`
+	Branch       string    `json:"branch"`
+	Summary      string    `json:"summary"`
+	DeliverAfter time.Time `json:"deliver_after"`
+}
+
+func (q *Queries) CreateNotificationDigest(ctx context.Context, arg CreateNotificationDigestParams) (NotificationDigest, error) {
+	row := q.db.QueryRow(ctx, createNotificationDigest,
+		arg.Recipient,
+		arg.Channel,
+		arg.RepoID,
+		arg.Kind,
+		arg.Branch,
+		arg.Summary,
+		arg.DeliverAfter,
+	)
+	var i NotificationDigest
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Recipient,
+		&i.Channel,
+		&i.RepoID,
+		&i.Kind,
+		&i.Branch,
+		&i.Summary,
+		&i.DeliverAfter,
+	)
+	return i, err
+}
+
+const deleteNotificationDigests = `-- name: DeleteNotificationDigests :exec
+DELETE FROM notification_digests
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) DeleteNotificationDigests(ctx context.Context, ids []uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteNotificationDigests, ids)
+	return err
+}
+
+const getNextNotificationDigest = `-- name: GetNextNotificationDigest :one
+SELECT deliver_after
+FROM notification_digests
+WHERE recipient = $1 AND channel = $2
+ORDER BY deliver_after
+LIMIT 1
+`
+
+type GetNextNotificationDigestParams struct {
+	Recipient uuid.UUID `json:"recipient"`
+	Channel   string    `json:"channel"`
+}
+
+func (q *Queries) GetNextNotificationDigest(ctx context.Context, arg GetNextNotificationDigestParams) (time.Time, error) {
+	row := q.db.QueryRow(ctx, getNextNotificationDigest, arg.Recipient, arg.Channel)
+	var deliver_after time.Time
+	err := row.Scan(&deliver_after)
+	return deliver_after, err
+}
+
+const listDueNotificationDigests = `-- name: ListDueNotificationDigests :many
+SELECT
+  nd.id, nd.created_at, nd.updated_at, nd.recipient, nd.channel, nd.repo_id, nd.kind, nd.branch, nd.summary, nd.deliver_after,
+  r.name AS repo_name,
+  r.url AS repo_url
+FROM notification_digests nd
+JOIN repos r ON r.id = nd.repo_id
+WHERE
+  nd.recipient = $1
+  AND nd.channel = $2
+  AND nd.deliver_after <= $3::timestamptz
+ORDER BY nd.created_at
+`
+
+type ListDueNotificationDigestsParams struct {
+	Recipient uuid.UUID `json:"recipient"`
+	Channel   string    `json:"channel"`
+	Now       time.Time `json:"now"`
+}
+
+type ListDueNotificationDigestsRow struct {
+	ID           uuid.UUID `json:"id"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+	Recipient    uuid.UUID `json:"recipient"`
+	Channel      string    `json:"channel"`
+	RepoID       uuid.UUID `json:"repo_id"`
+	Kind         string    `json:"kind"`
+	Branch       string    `json:"branch"`
+	Summary      string    `json:"summary"`
+	DeliverAfter time.Time `json:"deliver_after"`
+	RepoName     string    `json:"repo_name"`
+	RepoUrl      string    `json:"repo_url"`
+}
+
+func (q *Queries) ListDueNotificationDigests(ctx context.Context, arg ListDueNotificationDigestsParams) ([]ListDueNotificationDigestsRow, error) {
+	rows, err := q.db.Query(ctx, listDueNotificationDigests, arg.Recipient, arg.Channel, arg.Now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListDueNotificationDigestsRow
+	for rows.Next() {
+		var i ListDueNotificationDigestsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.Recipient,
+			&i.Channel,
+			&i.RepoID,
+			&i.Kind,
+			&i.Branch,
+			&i.Summary,
+			&i.DeliverAfter,
+			&i.RepoName,
+			&i.RepoUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
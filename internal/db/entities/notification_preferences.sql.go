@@ -0,0 +1,130 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: notification_preferences.sql
+
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const getNotificationPreference = `-- name: GetNotificationPreference :one
+SELECT id, created_at, updated_at, kind, link_to, muted, channel, timezone, quiet_start, quiet_end, digest, digest_hour
+FROM notification_preferences
+WHERE link_to = $1
+`
+
+func (q *Queries) GetNotificationPreference(ctx context.Context, linkTo uuid.UUID) (NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreference, linkTo)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Kind,
+		&i.LinkTo,
+		&i.Muted,
+		&i.Channel,
+		&i.Timezone,
+		&i.QuietStart,
+		&i.QuietEnd,
+		&i.Digest,
+		&i.DigestHour,
+	)
+	return i, err
+}
+
+const getTeamNotificationPreferenceByUser = `-- name: GetTeamNotificationPreferenceByUser :one
+SELECT np.id, np.created_at, np.updated_at, np.kind, np.link_to, np.muted, np.channel, np.timezone, np.quiet_start, np.quiet_end, np.digest, np.digest_hour
+FROM notification_preferences np
+JOIN team_users tu ON tu.team_id = np.link_to
+WHERE
+  np.kind = 'team'
+  AND tu.user_id = $1
+  AND tu.is_active = true
+ORDER BY tu.created_at
+LIMIT 1
+`
+
+func (q *Queries) GetTeamNotificationPreferenceByUser(ctx context.Context, userID uuid.UUID) (NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, getTeamNotificationPreferenceByUser, userID)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Kind,
+		&i.LinkTo,
+		&i.Muted,
+		&i.Channel,
+		&i.Timezone,
+		&i.QuietStart,
+		&i.QuietEnd,
+		&i.Digest,
+		&i.DigestHour,
+	)
+	return i, err
+}
+
+const upsertNotificationPreference = `-- name: UpsertNotificationPreference :one
+INSERT INTO notification_preferences (
+  kind, link_to, muted, channel, timezone, quiet_start, quiet_end, digest, digest_hour
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (link_to) DO UPDATE
+SET
+    muted = EXCLUDED.muted,
+    channel = EXCLUDED.channel,
+    timezone = EXCLUDED.timezone,
+    quiet_start = EXCLUDED.quiet_start,
+    quiet_end = EXCLUDED.quiet_end,
+    digest = EXCLUDED.digest,
+    digest_hour = EXCLUDED.digest_hour,
+    updated_at = now()
+RETURNING id, created_at, updated_at, kind, link_to, muted, channel, timezone, quiet_start, quiet_end, digest, digest_hour
+`
+
+type UpsertNotificationPreferenceParams struct {
+	Kind       string    `json:"kind"`
+	LinkTo     uuid.UUID `json:"link_to"`
+	Muted      []string  `json:"muted"`
+	Channel    string    `json:"channel"`
+	Timezone   string    `json:"timezone"`
+	QuietStart int32     `json:"quiet_start"`
+	QuietEnd   int32     `json:"quiet_end"`
+	Digest     bool      `json:"digest"`
+	DigestHour int32     `json:"digest_hour"`
+}
+
+func (q *Queries) UpsertNotificationPreference(ctx context.Context, arg UpsertNotificationPreferenceParams) (NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, upsertNotificationPreference,
+		arg.Kind,
+		arg.LinkTo,
+		arg.Muted,
+		arg.Channel,
+		arg.Timezone,
+		arg.QuietStart,
+		arg.QuietEnd,
+		arg.Digest,
+		arg.DigestHour,
+	)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Kind,
+		&i.LinkTo,
+		&i.Muted,
+		&i.Channel,
+		&i.Timezone,
+		&i.QuietStart,
+		&i.QuietEnd,
+		&i.Digest,
+		&i.DigestHour,
+	)
+	return i, err
+}
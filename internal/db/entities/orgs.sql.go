@@ -72,6 +72,32 @@ func (q *Queries) GetOrgSlugByID(ctx context.Context, id uuid.UUID) (string, err
 	return slug, err
 }
 
+const listOrgSlugs = `-- name: ListOrgSlugs :many
+SELECT slug
+FROM orgs
+ORDER BY created_at
+`
+
+func (q *Queries) ListOrgSlugs(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listOrgSlugs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var slug string
+		if err := rows.Scan(&slug); err != nil {
+			return nil, err
+		}
+		items = append(items, slug)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const setOrgHooks = `-- name: SetOrgHooks :exec
 UPDATE orgs
 SET hooks = $2
@@ -0,0 +1,82 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: pull_request_comments.sql
+
+package entities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deletePullRequestComment = `-- name: DeletePullRequestComment :exec
+DELETE FROM pull_request_comments
+WHERE repo_id = $1 AND number = $2
+`
+
+type DeletePullRequestCommentParams struct {
+	RepoID uuid.UUID `json:"repo_id"`
+	Number int64     `json:"number"`
+}
+
+func (q *Queries) DeletePullRequestComment(ctx context.Context, arg DeletePullRequestCommentParams) error {
+	_, err := q.db.Exec(ctx, deletePullRequestComment, arg.RepoID, arg.Number)
+	return err
+}
+
+const getPullRequestComment = `-- name: GetPullRequestComment :one
+SELECT id, created_at, updated_at, repo_id, number, comment_id
+FROM pull_request_comments
+WHERE repo_id = $1 AND number = $2
+`
+
+type GetPullRequestCommentParams struct {
+	RepoID uuid.UUID `json:"repo_id"`
+	Number int64     `json:"number"`
+}
+
+func (q *Queries) GetPullRequestComment(ctx context.Context, arg GetPullRequestCommentParams) (PullRequestComment, error) {
+	row := q.db.QueryRow(ctx, getPullRequestComment, arg.RepoID, arg.Number)
+	var i PullRequestComment
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepoID,
+		&i.Number,
+		&i.CommentID,
+	)
+	return i, err
+}
+
+const upsertPullRequestComment = `-- name: UpsertPullRequestComment :one
+INSERT INTO pull_request_comments (repo_id, number, comment_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (repo_id, number) DO UPDATE
+SET
+    comment_id = EXCLUDED.comment_id,
+    updated_at = now()
+RETURNING id, created_at, updated_at, repo_id, number, comment_id
+`
+
+type UpsertPullRequestCommentParams struct {
+	RepoID    uuid.UUID `json:"repo_id"`
+	Number    int64     `json:"number"`
+	CommentID int64     `json:"comment_id"`
+}
+
+func (q *Queries) UpsertPullRequestComment(ctx context.Context, arg UpsertPullRequestCommentParams) (PullRequestComment, error) {
+	row := q.db.QueryRow(ctx, upsertPullRequestComment, arg.RepoID, arg.Number, arg.CommentID)
+	var i PullRequestComment
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RepoID,
+		&i.Number,
+		&i.CommentID,
+	)
+	return i, err
+}
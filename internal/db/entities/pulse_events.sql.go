@@ -0,0 +1,303 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: pulse_events.sql
+
+package entities
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countPulseEventsForReport = `-- name: CountPulseEventsForReport :one
+SELECT
+  count(*) FILTER (WHERE scope = 'merge' AND action = 'failure') AS conflicts_detected,
+  count(*) FILTER (WHERE scope = 'merge' AND action = 'completed') AS conflicts_resolved,
+  count(*) FILTER (WHERE scope = 'drift' AND action = 'requested') AS stale_branches
+FROM pulse_events
+WHERE
+  CASE WHEN $1::text = 'team_id' THEN team_id ELSE subject_id END = $2::uuid
+  AND timestamp >= $3::timestamptz
+  AND timestamp < $4::timestamptz
+`
+
+type CountPulseEventsForReportParams struct {
+	By     string    `json:"by"`
+	ID     uuid.UUID `json:"id"`
+	FromAt time.Time `json:"from_at"`
+	ToAt   time.Time `json:"to_at"`
+}
+
+type CountPulseEventsForReportRow struct {
+	ConflictsDetected int64 `json:"conflicts_detected"`
+	ConflictsResolved int64 `json:"conflicts_resolved"`
+	StaleBranches     int64 `json:"stale_branches"`
+}
+
+func (q *Queries) CountPulseEventsForReport(ctx context.Context, arg CountPulseEventsForReportParams) (CountPulseEventsForReportRow, error) {
+	row := q.db.QueryRow(ctx, countPulseEventsForReport,
+		arg.By,
+		arg.ID,
+		arg.FromAt,
+		arg.ToAt,
+	)
+	var i CountPulseEventsForReportRow
+	err := row.Scan(&i.ConflictsDetected, &i.ConflictsResolved, &i.StaleBranches)
+	return i, err
+}
+
+const createPulseEvents = `-- name: CreatePulseEvents :exec
+INSERT INTO pulse_events (
+  id, version, parents, hook, scope, action, source, subject_id, subject_name, user_id, team_id, org_id, timestamp, payload
+)
+SELECT
+  r.id, r.version, coalesce(r.parents, '{}'), r.hook, r.scope, r.action, r.source, r.subject_id, r.subject_name,
+  r.user_id, r.team_id, r.org_id, r.timestamp, coalesce(r.payload, '{}')
+FROM jsonb_to_recordset($1::jsonb) AS r (
+  id uuid, version varchar, parents uuid[], hook integer, scope varchar, action varchar, source text, subject_id uuid,
+  subject_name varchar, user_id uuid, team_id uuid, org_id uuid, timestamp timestamptz, payload jsonb
+)
+ON CONFLICT (id) DO NOTHING
+`
+
+// inserts a batch of events, given as a json array of the events, in a single statement.
+func (q *Queries) CreatePulseEvents(ctx context.Context, rows []byte) error {
+	_, err := q.db.Exec(ctx, createPulseEvents, rows)
+	return err
+}
+
+const listPulseEventsForRepo = `-- name: ListPulseEventsForRepo :many
+SELECT id, version, parents, hook, scope, action, source, subject_id, subject_name, user_id, team_id, org_id, timestamp, payload
+FROM pulse_events
+WHERE
+  subject_id = $1::uuid
+  AND timestamp >= $2::timestamptz
+  AND timestamp < $3::timestamptz
+  AND scope = ANY($4::text[])
+  AND NOT (
+    cardinality(parents) > 0
+    AND parents[cardinality(parents)] IN (
+      SELECT d.id FROM pulse_events d WHERE d.subject_id = $1::uuid AND d.scope = ANY($5::text[])
+    )
+  )
+ORDER BY timestamp, id
+LIMIT $7::integer OFFSET $6::integer
+`
+
+type ListPulseEventsForRepoParams struct {
+	RepoID    uuid.UUID `json:"repo_id"`
+	FromAt    time.Time `json:"from_at"`
+	ToAt      time.Time `json:"to_at"`
+	Scopes    []string  `json:"scopes"`
+	Derived   []string  `json:"derived"`
+	RowOffset int32     `json:"row_offset"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+// selects a page of the events of a repo, skipping the ones derived from the events of the derived scopes.
+func (q *Queries) ListPulseEventsForRepo(ctx context.Context, arg ListPulseEventsForRepoParams) ([]PulseEvent, error) {
+	rows, err := q.db.Query(ctx, listPulseEventsForRepo,
+		arg.RepoID,
+		arg.FromAt,
+		arg.ToAt,
+		arg.Scopes,
+		arg.Derived,
+		arg.RowOffset,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PulseEvent
+	for rows.Next() {
+		var i PulseEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Version,
+			&i.Parents,
+			&i.Hook,
+			&i.Scope,
+			&i.Action,
+			&i.Source,
+			&i.SubjectID,
+			&i.SubjectName,
+			&i.UserID,
+			&i.TeamID,
+			&i.OrgID,
+			&i.Timestamp,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPulseEventsLineage = `-- name: ListPulseEventsLineage :many
+WITH roots AS (
+  SELECT coalesce(array_agg(DISTINCT CASE WHEN cardinality(parents) = 0 THEN id ELSE parents[1] END), '{}')::uuid[] AS ids
+  FROM pulse_events
+  WHERE
+    CASE
+      WHEN $2::uuid <> '00000000-0000-0000-0000-000000000000' THEN id = $2::uuid
+      WHEN $3::bigint > 0 THEN subject_id = $4::uuid AND (payload->>'number')::bigint = $3::bigint
+      ELSE subject_id = $4::uuid AND $5::text <> ''
+        AND coalesce(payload->>'head_branch', payload->>'branch') = $5::text
+    END
+)
+SELECT e.id, e.version, e.parents, e.hook, e.scope, e.action, e.source, e.subject_id, e.subject_name, e.user_id, e.team_id, e.org_id, e.timestamp, e.payload
+FROM pulse_events e, roots
+WHERE e.id = ANY(roots.ids) OR e.parents && roots.ids
+ORDER BY e.timestamp, e.id
+LIMIT $1::integer
+`
+
+type ListPulseEventsLineageParams struct {
+	RowLimit int32     `json:"row_limit"`
+	EventID  uuid.UUID `json:"event_id"`
+	PrNumber int64     `json:"pr_number"`
+	RepoID   uuid.UUID `json:"repo_id"`
+	Branch   string    `json:"branch"`
+}
+
+// selects the roots of the events matching either the event id, or the pull request number or the branch of the repo,
+// then the roots and the events descending from them.
+func (q *Queries) ListPulseEventsLineage(ctx context.Context, arg ListPulseEventsLineageParams) ([]PulseEvent, error) {
+	rows, err := q.db.Query(ctx, listPulseEventsLineage,
+		arg.RowLimit,
+		arg.EventID,
+		arg.PrNumber,
+		arg.RepoID,
+		arg.Branch,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PulseEvent
+	for rows.Next() {
+		var i PulseEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.Version,
+			&i.Parents,
+			&i.Hook,
+			&i.Scope,
+			&i.Action,
+			&i.Source,
+			&i.SubjectID,
+			&i.SubjectName,
+			&i.UserID,
+			&i.TeamID,
+			&i.OrgID,
+			&i.Timestamp,
+			&i.Payload,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPulsePullRequestsForReport = `-- name: ListPulsePullRequestsForReport :many
+WITH merged AS (
+  SELECT subject_id, (payload->>'number')::bigint AS number, min(payload->>'head_branch') AS branch, min(timestamp) AS merged_at
+  FROM pulse_events
+  WHERE
+    CASE WHEN $1::text = 'team_id' THEN team_id ELSE subject_id END = $2::uuid
+    AND timestamp >= $3::timestamptz
+    AND timestamp < $4::timestamptz
+    AND scope = 'pr' AND action = 'closed'
+    AND hook BETWEEN $5::integer AND $6::integer
+    AND coalesce((payload->>'merged')::boolean, false)
+  GROUP BY subject_id, (payload->>'number')::bigint
+)
+SELECT
+  m.subject_id,
+  m.number::bigint AS number,
+  coalesce(m.branch, '')::text AS branch,
+  m.merged_at::timestamptz AS merged_at,
+  coalesce((
+    SELECT min(e.timestamp) FROM pulse_events e
+    WHERE e.subject_id = m.subject_id AND e.scope = 'pr' AND e.action = 'opened' AND (e.payload->>'number')::bigint = m.number
+  ), 'epoch')::timestamptz AS opened_at,
+  coalesce((
+    SELECT max(e.timestamp) FROM pulse_events e
+    WHERE e.subject_id = m.subject_id AND e.scope = 'merge_queue' AND e.action = 'added'
+      AND (e.payload->>'number')::bigint = m.number AND e.timestamp <= m.merged_at
+  ), 'epoch')::timestamptz AS queued_at,
+  coalesce((
+    SELECT coalesce((e.payload->'lines'->>'added')::bigint, 0) + coalesce((e.payload->'lines'->>'removed')::bigint, 0)
+    FROM pulse_events e
+    WHERE e.subject_id = m.subject_id AND e.scope = 'diff' AND e.payload->>'branch' = m.branch AND e.timestamp <= m.merged_at
+    ORDER BY e.timestamp DESC
+    LIMIT 1
+  ), 0)::bigint AS lines
+FROM merged m
+`
+
+type ListPulsePullRequestsForReportParams struct {
+	By          string    `json:"by"`
+	ID          uuid.UUID `json:"id"`
+	FromAt      time.Time `json:"from_at"`
+	ToAt        time.Time `json:"to_at"`
+	RepoHookMin int32     `json:"repo_hook_min"`
+	RepoHookMax int32     `json:"repo_hook_max"`
+}
+
+type ListPulsePullRequestsForReportRow struct {
+	SubjectID uuid.UUID `json:"subject_id"`
+	Number    int64     `json:"number"`
+	Branch    string    `json:"branch"`
+	MergedAt  time.Time `json:"merged_at"`
+	OpenedAt  time.Time `json:"opened_at"`
+	QueuedAt  time.Time `json:"queued_at"`
+	Lines     int64     `json:"lines"`
+}
+
+func (q *Queries) ListPulsePullRequestsForReport(ctx context.Context, arg ListPulsePullRequestsForReportParams) ([]ListPulsePullRequestsForReportRow, error) {
+	rows, err := q.db.Query(ctx, listPulsePullRequestsForReport,
+		arg.By,
+		arg.ID,
+		arg.FromAt,
+		arg.ToAt,
+		arg.RepoHookMin,
+		arg.RepoHookMax,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListPulsePullRequestsForReportRow
+	for rows.Next() {
+		var i ListPulsePullRequestsForReportRow
+		if err := rows.Scan(
+			&i.SubjectID,
+			&i.Number,
+			&i.Branch,
+			&i.MergedAt,
+			&i.OpenedAt,
+			&i.QueuedAt,
+			&i.Lines,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,149 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.27.0
+// source: report_schedules.sql
+
+package entities
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const getReportSchedule = `-- name: GetReportSchedule :one
+SELECT id, created_at, updated_at, org_id, kind, link_to, frequency, timezone, hour, weekday, enabled, sent_at
+FROM report_schedules
+WHERE link_to = $1
+`
+
+func (q *Queries) GetReportSchedule(ctx context.Context, linkTo uuid.UUID) (ReportSchedule, error) {
+	row := q.db.QueryRow(ctx, getReportSchedule, linkTo)
+	var i ReportSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Kind,
+		&i.LinkTo,
+		&i.Frequency,
+		&i.Timezone,
+		&i.Hour,
+		&i.Weekday,
+		&i.Enabled,
+		&i.SentAt,
+	)
+	return i, err
+}
+
+const listEnabledReportSchedulesByOrgID = `-- name: ListEnabledReportSchedulesByOrgID :many
+SELECT id, created_at, updated_at, org_id, kind, link_to, frequency, timezone, hour, weekday, enabled, sent_at
+FROM report_schedules
+WHERE org_id = $1 AND enabled
+ORDER BY created_at
+`
+
+func (q *Queries) ListEnabledReportSchedulesByOrgID(ctx context.Context, orgID uuid.UUID) ([]ReportSchedule, error) {
+	rows, err := q.db.Query(ctx, listEnabledReportSchedulesByOrgID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ReportSchedule
+	for rows.Next() {
+		var i ReportSchedule
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrgID,
+			&i.Kind,
+			&i.LinkTo,
+			&i.Frequency,
+			&i.Timezone,
+			&i.Hour,
+			&i.Weekday,
+			&i.Enabled,
+			&i.SentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateReportScheduleSentAt = `-- name: UpdateReportScheduleSentAt :exec
+UPDATE report_schedules
+SET sent_at = $2
+WHERE id = $1
+`
+
+type UpdateReportScheduleSentAtParams struct {
+	ID     uuid.UUID `json:"id"`
+	SentAt time.Time `json:"sent_at"`
+}
+
+func (q *Queries) UpdateReportScheduleSentAt(ctx context.Context, arg UpdateReportScheduleSentAtParams) error {
+	_, err := q.db.Exec(ctx, updateReportScheduleSentAt, arg.ID, arg.SentAt)
+	return err
+}
+
+const upsertReportSchedule = `-- name: UpsertReportSchedule :one
+INSERT INTO report_schedules (org_id, kind, link_to, frequency, timezone, hour, weekday, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (link_to) DO UPDATE
+SET
+    frequency = EXCLUDED.frequency,
+    timezone = EXCLUDED.timezone,
+    hour = EXCLUDED.hour,
+    weekday = EXCLUDED.weekday,
+    enabled = EXCLUDED.enabled,
+    updated_at = now()
+RETURNING id, created_at, updated_at, org_id, kind, link_to, frequency, timezone, hour, weekday, enabled, sent_at
+`
+
+type UpsertReportScheduleParams struct {
+	OrgID     uuid.UUID `json:"org_id"`
+	Kind      string    `json:"kind"`
+	LinkTo    uuid.UUID `json:"link_to"`
+	Frequency string    `json:"frequency"`
+	Timezone  string    `json:"timezone"`
+	Hour      int32     `json:"hour"`
+	Weekday   int32     `json:"weekday"`
+	Enabled   bool      `json:"enabled"`
+}
+
+func (q *Queries) UpsertReportSchedule(ctx context.Context, arg UpsertReportScheduleParams) (ReportSchedule, error) {
+	row := q.db.QueryRow(ctx, upsertReportSchedule,
+		arg.OrgID,
+		arg.Kind,
+		arg.LinkTo,
+		arg.Frequency,
+		arg.Timezone,
+		arg.Hour,
+		arg.Weekday,
+		arg.Enabled,
+	)
+	var i ReportSchedule
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Kind,
+		&i.LinkTo,
+		&i.Frequency,
+		&i.Timezone,
+		&i.Hour,
+		&i.Weekday,
+		&i.Enabled,
+		&i.SentAt,
+	)
+	return i, err
+}
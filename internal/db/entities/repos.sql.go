@@ -27,7 +27,7 @@ func (q *Queries) ActivateRepoByHookID(ctx context.Context, hookID uuid.UUID) er
 const createRepo = `-- name: CreateRepo :one
 INSERT INTO repos (org_id, name, hook, hook_id, url)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active
+RETURNING id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
 `
 
 type CreateRepoParams struct {
@@ -61,6 +61,11 @@ func (q *Queries) CreateRepo(ctx context.Context, arg CreateRepoParams) (Repo, e
 		&i.StaleDuration,
 		&i.Url,
 		&i.IsActive,
+		&i.PushDebounce,
+		&i.AutoUpdate,
+		&i.DriftBehind,
+		&i.DriftDuration,
+		&i.DriftChurn,
 	)
 	return i, err
 }
@@ -75,8 +80,45 @@ func (q *Queries) DeleteRepo(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
+const getOrgRepoByName = `-- name: GetOrgRepoByName :one
+SELECT id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
+FROM repos
+WHERE org_id = $1 AND name = $2
+`
+
+type GetOrgRepoByNameParams struct {
+	OrgID uuid.UUID `json:"org_id"`
+	Name  string    `json:"name"`
+}
+
+func (q *Queries) GetOrgRepoByName(ctx context.Context, arg GetOrgRepoByNameParams) (Repo, error) {
+	row := q.db.QueryRow(ctx, getOrgRepoByName, arg.OrgID, arg.Name)
+	var i Repo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Name,
+		&i.Hook,
+		&i.HookID,
+		&i.DefaultBranch,
+		&i.IsMonorepo,
+		&i.Threshold,
+		&i.StaleDuration,
+		&i.Url,
+		&i.IsActive,
+		&i.PushDebounce,
+		&i.AutoUpdate,
+		&i.DriftBehind,
+		&i.DriftDuration,
+		&i.DriftChurn,
+	)
+	return i, err
+}
+
 const getOrgReposByOrgID = `-- name: GetOrgReposByOrgID :many
-SELECT id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active
+SELECT id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
 FROM repos
 WHERE org_id = $1
 `
@@ -104,6 +146,11 @@ func (q *Queries) GetOrgReposByOrgID(ctx context.Context, orgID uuid.UUID) ([]Re
 			&i.StaleDuration,
 			&i.Url,
 			&i.IsActive,
+			&i.PushDebounce,
+			&i.AutoUpdate,
+			&i.DriftBehind,
+			&i.DriftDuration,
+			&i.DriftChurn,
 		); err != nil {
 			return nil, err
 		}
@@ -117,7 +164,7 @@ func (q *Queries) GetOrgReposByOrgID(ctx context.Context, orgID uuid.UUID) ([]Re
 
 const getRepo = `-- name: GetRepo :one
 SELECT
-  id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active
+  id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
 FROM
   repos
 WHERE
@@ -141,12 +188,17 @@ func (q *Queries) GetRepo(ctx context.Context, id uuid.UUID) (Repo, error) {
 		&i.StaleDuration,
 		&i.Url,
 		&i.IsActive,
+		&i.PushDebounce,
+		&i.AutoUpdate,
+		&i.DriftBehind,
+		&i.DriftDuration,
+		&i.DriftChurn,
 	)
 	return i, err
 }
 
 const getRepoByID = `-- name: GetRepoByID :one
-SELECT id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active
+SELECT id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
 FROM repos
 WHERE id = $1
 `
@@ -168,13 +220,77 @@ func (q *Queries) GetRepoByID(ctx context.Context, id uuid.UUID) (Repo, error) {
 		&i.StaleDuration,
 		&i.Url,
 		&i.IsActive,
+		&i.PushDebounce,
+		&i.AutoUpdate,
+		&i.DriftBehind,
+		&i.DriftDuration,
+		&i.DriftChurn,
+	)
+	return i, err
+}
+
+const getRepoForGitea = `-- name: GetRepoForGitea :one
+SELECT
+ repo.id, repo.created_at, repo.updated_at, repo.org_id, repo.name, repo.hook, repo.hook_id, repo.default_branch, repo.is_monorepo, repo.threshold, repo.stale_duration, repo.url, repo.is_active, repo.push_debounce, repo.auto_update, repo.drift_behind, repo.drift_duration, repo.drift_churn,
+ org.id, org.created_at, org.updated_at, org.name, org.domain, org.slug, org.hooks
+FROM
+  gitea_repos gitea_repo
+JOIN
+  gitea_installations installation ON gitea_repo.installation_id = installation.id
+JOIN
+  repos repo on gitea_repo.id = repo.hook_id
+JOIN
+  orgs org ON repo.org_id = org.id
+WHERE
+  installation.owner_id = $1 AND gitea_repo.gitea_id = $2 AND installation.is_active = true
+`
+
+type GetRepoForGiteaParams struct {
+	OwnerID int64 `json:"owner_id"`
+	GiteaID int64 `json:"gitea_id"`
+}
+
+type GetRepoForGiteaRow struct {
+	Repo Repo `json:"repo"`
+	Org  Org  `json:"org"`
+}
+
+func (q *Queries) GetRepoForGitea(ctx context.Context, arg GetRepoForGiteaParams) (GetRepoForGiteaRow, error) {
+	row := q.db.QueryRow(ctx, getRepoForGitea, arg.OwnerID, arg.GiteaID)
+	var i GetRepoForGiteaRow
+	err := row.Scan(
+		&i.Repo.ID,
+		&i.Repo.CreatedAt,
+		&i.Repo.UpdatedAt,
+		&i.Repo.OrgID,
+		&i.Repo.Name,
+		&i.Repo.Hook,
+		&i.Repo.HookID,
+		&i.Repo.DefaultBranch,
+		&i.Repo.IsMonorepo,
+		&i.Repo.Threshold,
+		&i.Repo.StaleDuration,
+		&i.Repo.Url,
+		&i.Repo.IsActive,
+		&i.Repo.PushDebounce,
+		&i.Repo.AutoUpdate,
+		&i.Repo.DriftBehind,
+		&i.Repo.DriftDuration,
+		&i.Repo.DriftChurn,
+		&i.Org.ID,
+		&i.Org.CreatedAt,
+		&i.Org.UpdatedAt,
+		&i.Org.Name,
+		&i.Org.Domain,
+		&i.Org.Slug,
+		&i.Org.Hooks,
 	)
 	return i, err
 }
 
 const getRepoForGithub = `-- name: GetRepoForGithub :one
 SELECT
- repo.id, repo.created_at, repo.updated_at, repo.org_id, repo.name, repo.hook, repo.hook_id, repo.default_branch, repo.is_monorepo, repo.threshold, repo.stale_duration, repo.url, repo.is_active,
+ repo.id, repo.created_at, repo.updated_at, repo.org_id, repo.name, repo.hook, repo.hook_id, repo.default_branch, repo.is_monorepo, repo.threshold, repo.stale_duration, repo.url, repo.is_active, repo.push_debounce, repo.auto_update, repo.drift_behind, repo.drift_duration, repo.drift_churn,
  org.id, org.created_at, org.updated_at, org.name, org.domain, org.slug, org.hooks
 FROM
   github_repos github_repo
@@ -213,6 +329,125 @@ func (q *Queries) GetRepoForGithub(ctx context.Context, arg GetRepoForGithubPara
 		&i.Repo.StaleDuration,
 		&i.Repo.Url,
 		&i.Repo.IsActive,
+		&i.Repo.PushDebounce,
+		&i.Repo.AutoUpdate,
+		&i.Repo.DriftBehind,
+		&i.Repo.DriftDuration,
+		&i.Repo.DriftChurn,
+		&i.Org.ID,
+		&i.Org.CreatedAt,
+		&i.Org.UpdatedAt,
+		&i.Org.Name,
+		&i.Org.Domain,
+		&i.Org.Slug,
+		&i.Org.Hooks,
+	)
+	return i, err
+}
+
+const getRepoForGitlab = `-- name: GetRepoForGitlab :one
+SELECT
+ repo.id, repo.created_at, repo.updated_at, repo.org_id, repo.name, repo.hook, repo.hook_id, repo.default_branch, repo.is_monorepo, repo.threshold, repo.stale_duration, repo.url, repo.is_active, repo.push_debounce, repo.auto_update, repo.drift_behind, repo.drift_duration, repo.drift_churn,
+ org.id, org.created_at, org.updated_at, org.name, org.domain, org.slug, org.hooks
+FROM
+  gitlab_repos gitlab_repo
+JOIN
+  gitlab_installations installation ON gitlab_repo.installation_id = installation.id
+JOIN
+  repos repo on gitlab_repo.id = repo.hook_id
+JOIN
+  orgs org ON repo.org_id = org.id
+WHERE
+  gitlab_repo.installation_id = $1 AND gitlab_repo.gitlab_id = $2 AND installation.is_active = true
+`
+
+type GetRepoForGitlabParams struct {
+	InstallationID uuid.UUID `json:"installation_id"`
+	GitlabID       int64     `json:"gitlab_id"`
+}
+
+type GetRepoForGitlabRow struct {
+	Repo Repo `json:"repo"`
+	Org  Org  `json:"org"`
+}
+
+func (q *Queries) GetRepoForGitlab(ctx context.Context, arg GetRepoForGitlabParams) (GetRepoForGitlabRow, error) {
+	row := q.db.QueryRow(ctx, getRepoForGitlab, arg.InstallationID, arg.GitlabID)
+	var i GetRepoForGitlabRow
+	err := row.Scan(
+		&i.Repo.ID,
+		&i.Repo.CreatedAt,
+		&i.Repo.UpdatedAt,
+		&i.Repo.OrgID,
+		&i.Repo.Name,
+		&i.Repo.Hook,
+		&i.Repo.HookID,
+		&i.Repo.DefaultBranch,
+		&i.Repo.IsMonorepo,
+		&i.Repo.Threshold,
+		&i.Repo.StaleDuration,
+		&i.Repo.Url,
+		&i.Repo.IsActive,
+		&i.Repo.PushDebounce,
+		&i.Repo.AutoUpdate,
+		&i.Repo.DriftBehind,
+		&i.Repo.DriftDuration,
+		&i.Repo.DriftChurn,
+		&i.Org.ID,
+		&i.Org.CreatedAt,
+		&i.Org.UpdatedAt,
+		&i.Org.Name,
+		&i.Org.Domain,
+		&i.Org.Slug,
+		&i.Org.Hooks,
+	)
+	return i, err
+}
+
+const getRepoForLocal = `-- name: GetRepoForLocal :one
+SELECT
+ repo.id, repo.created_at, repo.updated_at, repo.org_id, repo.name, repo.hook, repo.hook_id, repo.default_branch, repo.is_monorepo, repo.threshold, repo.stale_duration, repo.url, repo.is_active, repo.push_debounce, repo.auto_update, repo.drift_behind, repo.drift_duration, repo.drift_churn,
+ org.id, org.created_at, org.updated_at, org.name, org.domain, org.slug, org.hooks
+FROM
+  repos repo
+JOIN
+  orgs org ON repo.org_id = org.id
+WHERE
+  repo.hook = $1 AND repo.hook_id = $2
+`
+
+type GetRepoForLocalParams struct {
+	Hook   int32     `json:"hook"`
+	HookID uuid.UUID `json:"hook_id"`
+}
+
+type GetRepoForLocalRow struct {
+	Repo Repo `json:"repo"`
+	Org  Org  `json:"org"`
+}
+
+func (q *Queries) GetRepoForLocal(ctx context.Context, arg GetRepoForLocalParams) (GetRepoForLocalRow, error) {
+	row := q.db.QueryRow(ctx, getRepoForLocal, arg.Hook, arg.HookID)
+	var i GetRepoForLocalRow
+	err := row.Scan(
+		&i.Repo.ID,
+		&i.Repo.CreatedAt,
+		&i.Repo.UpdatedAt,
+		&i.Repo.OrgID,
+		&i.Repo.Name,
+		&i.Repo.Hook,
+		&i.Repo.HookID,
+		&i.Repo.DefaultBranch,
+		&i.Repo.IsMonorepo,
+		&i.Repo.Threshold,
+		&i.Repo.StaleDuration,
+		&i.Repo.Url,
+		&i.Repo.IsActive,
+		&i.Repo.PushDebounce,
+		&i.Repo.AutoUpdate,
+		&i.Repo.DriftBehind,
+		&i.Repo.DriftDuration,
+		&i.Repo.DriftChurn,
 		&i.Org.ID,
 		&i.Org.CreatedAt,
 		&i.Org.UpdatedAt,
@@ -225,7 +460,7 @@ func (q *Queries) GetRepoForGithub(ctx context.Context, arg GetRepoForGithubPara
 }
 
 const getReposByHookAndHookID = `-- name: GetReposByHookAndHookID :one
-SELECT id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active
+SELECT id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
 FROM repos
 WHERE hook = $1 AND hook_id = $2
 `
@@ -252,13 +487,18 @@ func (q *Queries) GetReposByHookAndHookID(ctx context.Context, arg GetReposByHoo
 		&i.StaleDuration,
 		&i.Url,
 		&i.IsActive,
+		&i.PushDebounce,
+		&i.AutoUpdate,
+		&i.DriftBehind,
+		&i.DriftDuration,
+		&i.DriftChurn,
 	)
 	return i, err
 }
 
 const listRepos = `-- name: ListRepos :many
 SELECT
-  repo.id, repo.created_at, repo.updated_at, repo.org_id, repo.name, repo.hook, repo.hook_id, repo.default_branch, repo.is_monorepo, repo.threshold, repo.stale_duration, repo.url, repo.is_active,
+  repo.id, repo.created_at, repo.updated_at, repo.org_id, repo.name, repo.hook, repo.hook_id, repo.default_branch, repo.is_monorepo, repo.threshold, repo.stale_duration, repo.url, repo.is_active, repo.push_debounce, repo.auto_update, repo.drift_behind, repo.drift_duration, repo.drift_churn,
   CASE
     WHEN chat_link.id IS NOT NULL AND chat_link.link_to IS NOT NULL THEN TRUE
     ELSE FALSE
@@ -293,6 +533,11 @@ type ListReposRow struct {
 	StaleDuration pgtype.Interval `json:"stale_duration"`
 	Url           string          `json:"url"`
 	IsActive      bool            `json:"is_active"`
+	PushDebounce  pgtype.Interval `json:"push_debounce"`
+	AutoUpdate    string          `json:"auto_update"`
+	DriftBehind   int32           `json:"drift_behind"`
+	DriftDuration pgtype.Interval `json:"drift_duration"`
+	DriftChurn    int32           `json:"drift_churn"`
 	HasChat       bool            `json:"has_chat"`
 	ChannelName   string          `json:"channel_name"`
 }
@@ -320,6 +565,11 @@ func (q *Queries) ListRepos(ctx context.Context, orgID uuid.UUID) ([]ListReposRo
 			&i.StaleDuration,
 			&i.Url,
 			&i.IsActive,
+			&i.PushDebounce,
+			&i.AutoUpdate,
+			&i.DriftBehind,
+			&i.DriftDuration,
+			&i.DriftChurn,
 			&i.HasChat,
 			&i.ChannelName,
 		); err != nil {
@@ -354,9 +604,14 @@ SET
     default_branch = $6,
     is_monorepo = $7,
     threshold = $8,
-    stale_duration = $9
+    stale_duration = $9,
+    push_debounce = $10,
+    auto_update = $11,
+    drift_behind = $12,
+    drift_duration = $13,
+    drift_churn = $14
 WHERE id = $1
-RETURNING id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active
+RETURNING id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
 `
 
 type UpdateRepoParams struct {
@@ -369,6 +624,11 @@ type UpdateRepoParams struct {
 	IsMonorepo    bool            `json:"is_monorepo"`
 	Threshold     int32           `json:"threshold"`
 	StaleDuration pgtype.Interval `json:"stale_duration"`
+	PushDebounce  pgtype.Interval `json:"push_debounce"`
+	AutoUpdate    string          `json:"auto_update"`
+	DriftBehind   int32           `json:"drift_behind"`
+	DriftDuration pgtype.Interval `json:"drift_duration"`
+	DriftChurn    int32           `json:"drift_churn"`
 }
 
 func (q *Queries) UpdateRepo(ctx context.Context, arg UpdateRepoParams) (Repo, error) {
@@ -382,6 +642,65 @@ func (q *Queries) UpdateRepo(ctx context.Context, arg UpdateRepoParams) (Repo, e
 		arg.IsMonorepo,
 		arg.Threshold,
 		arg.StaleDuration,
+		arg.PushDebounce,
+		arg.AutoUpdate,
+		arg.DriftBehind,
+		arg.DriftDuration,
+		arg.DriftChurn,
+	)
+	var i Repo
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrgID,
+		&i.Name,
+		&i.Hook,
+		&i.HookID,
+		&i.DefaultBranch,
+		&i.IsMonorepo,
+		&i.Threshold,
+		&i.StaleDuration,
+		&i.Url,
+		&i.IsActive,
+		&i.PushDebounce,
+		&i.AutoUpdate,
+		&i.DriftBehind,
+		&i.DriftDuration,
+		&i.DriftChurn,
+	)
+	return i, err
+}
+
+const updateRepoSettings = `-- name: UpdateRepoSettings :one
+UPDATE repos
+SET
+    push_debounce = $2,
+    auto_update = $3,
+    drift_behind = $4,
+    drift_duration = $5,
+    drift_churn = $6
+WHERE id = $1
+RETURNING id, created_at, updated_at, org_id, name, hook, hook_id, default_branch, is_monorepo, threshold, stale_duration, url, is_active, push_debounce, auto_update, drift_behind, drift_duration, drift_churn
+`
+
+type UpdateRepoSettingsParams struct {
+	ID            uuid.UUID       `json:"id"`
+	PushDebounce  pgtype.Interval `json:"push_debounce"`
+	AutoUpdate    string          `json:"auto_update"`
+	DriftBehind   int32           `json:"drift_behind"`
+	DriftDuration pgtype.Interval `json:"drift_duration"`
+	DriftChurn    int32           `json:"drift_churn"`
+}
+
+func (q *Queries) UpdateRepoSettings(ctx context.Context, arg UpdateRepoSettingsParams) (Repo, error) {
+	row := q.db.QueryRow(ctx, updateRepoSettings,
+		arg.ID,
+		arg.PushDebounce,
+		arg.AutoUpdate,
+		arg.DriftBehind,
+		arg.DriftDuration,
+		arg.DriftChurn,
 	)
 	var i Repo
 	err := row.Scan(
@@ -398,6 +717,11 @@ func (q *Queries) UpdateRepo(ctx context.Context, arg UpdateRepoParams) (Repo, e
 		&i.StaleDuration,
 		&i.Url,
 		&i.IsActive,
+		&i.PushDebounce,
+		&i.AutoUpdate,
+		&i.DriftBehind,
+		&i.DriftDuration,
+		&i.DriftChurn,
 	)
 	return i, err
 }
@@ -68,3 +68,30 @@ func (q *Queries) GetTeamUser(ctx context.Context, userID uuid.UUID) (TeamUser,
 	)
 	return i, err
 }
+
+const getTeamUserByTeam = `-- name: GetTeamUserByTeam :one
+SELECT id, created_at, updated_at, team_id, user_id, role, is_active, is_admin
+FROM team_users
+WHERE team_id = $1 AND user_id = $2
+`
+
+type GetTeamUserByTeamParams struct {
+	TeamID uuid.UUID `json:"team_id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+func (q *Queries) GetTeamUserByTeam(ctx context.Context, arg GetTeamUserByTeamParams) (TeamUser, error) {
+	row := q.db.QueryRow(ctx, getTeamUserByTeam, arg.TeamID, arg.UserID)
+	var i TeamUser
+	err := row.Scan(
+		&i.ID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TeamID,
+		&i.UserID,
+		&i.Role,
+		&i.IsActive,
+		&i.IsAdmin,
+	)
+	return i, err
+}
@@ -25,6 +25,7 @@ func IntervalToProto(interval pgtype.Interval) *durationpb.Duration {
 func DurationToInterval(d time.Duration) pgtype.Interval {
 	return pgtype.Interval{
 		Microseconds: int64(d / time.Microsecond),
+		Valid:        true,
 	}
 }
 
@@ -32,5 +33,6 @@ func DurationToInterval(d time.Duration) pgtype.Interval {
 func ProtoToInterval(d *durationpb.Duration) pgtype.Interval {
 	return pgtype.Interval{
 		Microseconds: int64(d.AsDuration() / time.Microsecond),
+		Valid:        true,
 	}
 }
@@ -0,0 +1,2 @@
+alter table repos
+  drop column push_debounce;
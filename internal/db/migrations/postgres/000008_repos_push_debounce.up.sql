@@ -0,0 +1,3 @@
+-- core::repos::push_debounce
+alter table repos
+  add column push_debounce interval not null default '2 minutes';
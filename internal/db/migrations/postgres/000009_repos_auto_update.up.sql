@@ -0,0 +1,3 @@
+-- core::repos::auto_update
+alter table repos
+  add column auto_update varchar(255) not null default 'none';
@@ -0,0 +1,8 @@
+drop trigger if exists update_branch_drifts_updated_at on branch_drifts;
+
+drop table if exists branch_drifts;
+
+alter table repos
+  drop column drift_behind,
+  drop column drift_duration,
+  drop column drift_churn;
@@ -0,0 +1,25 @@
+-- core::repos::drift
+alter table repos
+  add column drift_behind integer not null default 50,
+  add column drift_duration interval not null default '14 days',
+  add column drift_churn integer not null default 10;
+
+-- core::branch_drifts::create
+create table branch_drifts (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  repo_id uuid not null references repos (id) on delete cascade,
+  branch varchar(255) not null,
+  ahead integer not null default 0,
+  behind integer not null default 0,
+  synced_at timestamptz not null,
+  churn text[] not null default '{}',
+  unique (repo_id, branch)
+);
+
+-- core::branch_drifts::trigger
+create trigger update_branch_drifts_updated_at
+  after update on branch_drifts
+  for each row
+  execute function update_updated_at();
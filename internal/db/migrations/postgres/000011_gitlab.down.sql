@@ -0,0 +1,3 @@
+drop table if exists gitlab_repos;
+
+drop table if exists gitlab_installations;
@@ -0,0 +1,42 @@
+-- integrations/gitlab::gitlab_installations::create
+create table gitlab_installations (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  org_id uuid not null references orgs (id),
+  url varchar(255) not null,
+  group_id bigint not null,
+  group_path varchar(255) not null,
+  access_token text not null,
+  is_active boolean not null default true,
+  constraint gitlab_installations_url_group_id_unique unique (url, group_id)
+);
+
+-- integrations/gitlab::gitlab_installations::trigger
+create trigger update_gitlab_installations_updated_at
+  after update on gitlab_installations
+  for each row
+  execute function update_updated_at();
+
+-- integrations/gitlab::gitlab_repos::create
+create table gitlab_repos (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  installation_id uuid not null references gitlab_installations (id),
+  gitlab_id bigint not null,
+  webhook_id bigint not null default 0,
+  name varchar(255) not null,
+  full_name varchar(255) not null,
+  url varchar(255) not null,
+  is_active boolean not null default true
+);
+
+-- integrations/gitlab::gitlab_repos::index
+create index gitlab_repos_installation_id_idx on gitlab_repos (installation_id);
+
+-- integrations/gitlab::gitlab_repos::trigger
+create trigger update_gitlab_repos_updated_at
+  after update on gitlab_repos
+  for each row
+  execute function update_updated_at();
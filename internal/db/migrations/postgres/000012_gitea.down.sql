@@ -0,0 +1,3 @@
+drop table if exists gitea_repos;
+
+drop table if exists gitea_installations;
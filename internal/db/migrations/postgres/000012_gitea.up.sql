@@ -0,0 +1,40 @@
+-- integrations/gitea::gitea_installations::create
+create table gitea_installations (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  org_id uuid not null references orgs (id),
+  owner_id bigint not null,
+  owner_login varchar(255) not null,
+  webhook_id bigint not null default 0,
+  is_active boolean not null default true,
+  constraint gitea_installations_owner_id_unique unique (owner_id)
+);
+
+-- integrations/gitea::gitea_installations::trigger
+create trigger update_gitea_installations_updated_at
+  after update on gitea_installations
+  for each row
+  execute function update_updated_at();
+
+-- integrations/gitea::gitea_repos::create
+create table gitea_repos (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  installation_id uuid not null references gitea_installations (id),
+  gitea_id bigint not null,
+  name varchar(255) not null,
+  full_name varchar(255) not null,
+  url varchar(255) not null,
+  is_active boolean not null default true
+);
+
+-- integrations/gitea::gitea_repos::index
+create index gitea_repos_installation_id_idx on gitea_repos (installation_id);
+
+-- integrations/gitea::gitea_repos::trigger
+create trigger update_gitea_repos_updated_at
+  after update on gitea_repos
+  for each row
+  execute function update_updated_at();
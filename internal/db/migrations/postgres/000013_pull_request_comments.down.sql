@@ -0,0 +1,3 @@
+drop trigger if exists update_pull_request_comments_updated_at on pull_request_comments;
+
+drop table if exists pull_request_comments;
@@ -0,0 +1,16 @@
+-- core::pull_request_comments::create
+create table pull_request_comments (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  repo_id uuid not null references repos (id) on delete cascade,
+  number bigint not null,
+  comment_id bigint not null,
+  unique (repo_id, number)
+);
+
+-- core::pull_request_comments::trigger
+create trigger update_pull_request_comments_updated_at
+  after update on pull_request_comments
+  for each row
+  execute function update_updated_at();
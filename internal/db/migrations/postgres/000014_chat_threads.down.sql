@@ -0,0 +1,3 @@
+drop trigger if exists update_chat_threads_updated_at on chat_threads;
+
+drop table if exists chat_threads;
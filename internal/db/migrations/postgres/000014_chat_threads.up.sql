@@ -0,0 +1,19 @@
+-- core::chat_threads::create
+create table chat_threads (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  repo_id uuid not null references repos (id) on delete cascade,
+  branch varchar(255) not null,
+  hook integer not null,
+  target varchar(255) not null,
+  channel varchar(255) not null,
+  ts varchar(255) not null,
+  unique (repo_id, branch, hook, target)
+);
+
+-- core::chat_threads::trigger
+create trigger update_chat_threads_updated_at
+  after update on chat_threads
+  for each row
+  execute function update_updated_at();
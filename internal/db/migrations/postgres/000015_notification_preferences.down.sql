@@ -0,0 +1,7 @@
+drop trigger if exists update_notification_digests_updated_at on notification_digests;
+
+drop table if exists notification_digests;
+
+drop trigger if exists update_notification_preferences_updated_at on notification_preferences;
+
+drop table if exists notification_preferences;
@@ -0,0 +1,49 @@
+-- core::notification_preferences::create
+create table notification_preferences (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  kind varchar(63) not null,
+  link_to uuid not null,
+  muted text[] not null default '{}',
+  channel varchar(63) not null default 'dm',
+  timezone varchar(63) not null default 'UTC',
+  quiet_start integer not null default 0,
+  quiet_end integer not null default 0,
+  digest boolean not null default false,
+  digest_hour integer not null default 9,
+  constraint notification_preferences_link_to_unique unique (link_to),
+  constraint notification_preferences_kind_check check (kind in ('user', 'team')),
+  constraint notification_preferences_channel_check check (channel in ('dm', 'team', 'email')),
+  constraint notification_preferences_quiet_check check (quiet_start between 0 and 1439 and quiet_end between 0 and 1439),
+  constraint notification_preferences_digest_hour_check check (digest_hour between 0 and 23)
+);
+
+-- core::notification_preferences::trigger
+create trigger update_notification_preferences_updated_at
+  after update on notification_preferences
+  for each row
+  execute function update_updated_at();
+
+-- core::notification_digests::create
+create table notification_digests (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  recipient uuid not null,
+  channel varchar(63) not null,
+  repo_id uuid not null references repos (id) on delete cascade,
+  kind varchar(63) not null,
+  branch varchar(255) not null,
+  summary text not null,
+  deliver_after timestamptz not null
+);
+
+-- core::notification_digests::trigger
+create trigger update_notification_digests_updated_at
+  after update on notification_digests
+  for each row
+  execute function update_updated_at();
+
+-- core::notification_digests::index
+create index notification_digests_recipient_idx on notification_digests (recipient, channel, deliver_after);
@@ -0,0 +1,5 @@
+drop trigger if exists update_notification_rates_updated_at on notification_rates;
+drop trigger if exists update_notification_dedup_updated_at on notification_dedup;
+
+drop table if exists notification_rates;
+drop table if exists notification_dedup;
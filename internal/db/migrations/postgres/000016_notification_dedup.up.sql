@@ -0,0 +1,36 @@
+-- core::notification_dedup::create
+create table notification_dedup (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  repo_id uuid not null references repos (id) on delete cascade,
+  branch varchar(255) not null,
+  kind varchar(63) not null,
+  fingerprint varchar(63) not null,
+  sent_at timestamptz not null,
+  unique (repo_id, branch, kind)
+);
+
+-- core::notification_dedup::trigger
+create trigger update_notification_dedup_updated_at
+  after update on notification_dedup
+  for each row
+  execute function update_updated_at();
+
+-- core::notification_rates::create
+create table notification_rates (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  recipient uuid not null,
+  channel varchar(63) not null,
+  window_start timestamptz not null,
+  count integer not null default 0,
+  unique (recipient, channel)
+);
+
+-- core::notification_rates::trigger
+create trigger update_notification_rates_updated_at
+  after update on notification_rates
+  for each row
+  execute function update_updated_at();
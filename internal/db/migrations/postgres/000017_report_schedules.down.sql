@@ -0,0 +1,3 @@
+drop trigger if exists update_report_schedules_updated_at on report_schedules;
+
+drop table if exists report_schedules;
@@ -0,0 +1,29 @@
+-- core::report_schedules::create
+create table report_schedules (
+  id uuid primary key default uuid_generate_v7(),
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now(),
+  org_id uuid not null references orgs (id) on delete cascade,
+  kind varchar(63) not null,
+  link_to uuid not null,
+  frequency varchar(63) not null default 'weekly',
+  timezone varchar(63) not null default 'UTC',
+  hour integer not null default 9,
+  weekday integer not null default 1,
+  enabled boolean not null default true,
+  sent_at timestamptz not null default to_timestamp(0),
+  constraint report_schedules_link_to_unique unique (link_to),
+  constraint report_schedules_kind_check check (kind in ('repo', 'team')),
+  constraint report_schedules_frequency_check check (frequency in ('daily', 'weekly')),
+  constraint report_schedules_hour_check check (hour between 0 and 23),
+  constraint report_schedules_weekday_check check (weekday between 0 and 6)
+);
+
+-- core::report_schedules::trigger
+create trigger update_report_schedules_updated_at
+  after update on report_schedules
+  for each row
+  execute function update_updated_at();
+
+-- core::report_schedules::index
+create index report_schedules_org_id_idx on report_schedules (org_id) where enabled;
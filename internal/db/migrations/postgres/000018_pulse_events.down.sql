@@ -0,0 +1 @@
+drop table if exists pulse_events;
@@ -0,0 +1,23 @@
+-- pulse::events::create
+-- the events of all the orgs, for the postgres sink of pulse. Unlike clickhouse, there is no table per org.
+create table pulse_events (
+  id uuid primary key,
+  version varchar(63) not null,
+  parents uuid[] not null default '{}',
+  hook integer not null,
+  scope varchar(63) not null,
+  action varchar(63) not null,
+  source text not null,
+  subject_id uuid not null,
+  subject_name varchar(63) not null,
+  user_id uuid not null,
+  team_id uuid not null,
+  org_id uuid not null,
+  timestamp timestamptz not null,
+  payload jsonb not null default '{}'
+);
+
+-- pulse::events::index
+create index pulse_events_subject_id_idx on pulse_events (subject_id, timestamp);
+create index pulse_events_team_id_idx on pulse_events (team_id, timestamp);
+create index pulse_events_org_id_idx on pulse_events (org_id, timestamp);
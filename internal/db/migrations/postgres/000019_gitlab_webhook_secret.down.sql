@@ -0,0 +1 @@
+alter table gitlab_installations drop column if exists webhook_secret;
@@ -0,0 +1,4 @@
+-- integrations/gitlab::gitlab_installations::webhook_secret
+-- the secret of the project hooks of the installation, concealed with the id of the org. The hooks created before it
+-- must be updated by installing the group again.
+alter table gitlab_installations add column webhook_secret text not null default '';
@@ -0,0 +1,2 @@
+alter table repos
+  drop constraint if exists repos_auto_update_check;
@@ -0,0 +1,9 @@
+-- core::repos::auto_update
+-- the strategy to update the branches is one of the strategies known to the branch workflow, the unknown values set
+-- before the check are reset to none.
+update repos
+  set auto_update = 'none'
+  where auto_update not in ('none', 'rebase', 'merge');
+
+alter table repos
+  add constraint repos_auto_update_check check (auto_update in ('none', 'rebase', 'merge'));
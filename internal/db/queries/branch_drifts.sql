@@ -0,0 +1,22 @@
+-- name: UpsertBranchDrift :one
+INSERT INTO branch_drifts (repo_id, branch, ahead, behind, synced_at, churn)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (repo_id, branch) DO UPDATE
+SET
+    ahead = EXCLUDED.ahead,
+    behind = EXCLUDED.behind,
+    synced_at = EXCLUDED.synced_at,
+    churn = EXCLUDED.churn,
+    updated_at = now()
+RETURNING *;
+
+-- name: ListBranchDrifts :many
+SELECT drift.*
+FROM branch_drifts AS drift
+JOIN repos AS repo ON repo.id = drift.repo_id
+WHERE drift.repo_id = $1 AND repo.org_id = $2
+ORDER BY drift.behind DESC, drift.branch;
+
+-- name: DeleteBranchDrift :exec
+DELETE FROM branch_drifts
+WHERE repo_id = $1 AND branch = $2;
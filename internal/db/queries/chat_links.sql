@@ -7,3 +7,22 @@ RETURNING *;
 SELECT *
 FROM chat_links
 WHERE link_to = $1;
+
+-- name: GetChatLinkByProviderUser :one
+SELECT *
+FROM chat_links
+WHERE
+  hook = sqlc.arg(hook)
+  AND kind = 'user'
+  AND data ->> 'provider_team_id' = sqlc.arg(team_id)::text
+  AND data ->> 'provider_user_id' = sqlc.arg(user_id)::text;
+
+-- name: ListChatLinksByHook :many
+SELECT *
+FROM chat_links
+WHERE hook = $1;
+
+-- name: UpdateChatLinkData :exec
+UPDATE chat_links
+SET data = $2
+WHERE id = $1;
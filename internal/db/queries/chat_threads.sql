@@ -0,0 +1,18 @@
+-- name: GetChatThread :one
+SELECT *
+FROM chat_threads
+WHERE repo_id = $1 AND branch = $2 AND hook = $3 AND target = $4;
+
+-- name: UpsertChatThread :one
+INSERT INTO chat_threads (repo_id, branch, hook, target, channel, ts)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (repo_id, branch, hook, target) DO UPDATE
+SET
+    channel = EXCLUDED.channel,
+    ts = EXCLUDED.ts,
+    updated_at = now()
+RETURNING *;
+
+-- name: DeleteChatThread :exec
+DELETE FROM chat_threads
+WHERE id = $1;
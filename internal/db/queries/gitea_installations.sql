@@ -0,0 +1,27 @@
+-- name: CreateGiteaInstallation :one
+INSERT INTO gitea_installations (org_id, owner_id, owner_login)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetGiteaInstallation :one
+SELECT *
+FROM gitea_installations
+WHERE id = $1;
+
+-- name: GetGiteaInstallationByOwnerID :one
+SELECT *
+FROM gitea_installations
+WHERE owner_id = $1;
+
+-- name: UpdateGiteaInstallation :one
+UPDATE gitea_installations
+SET
+    owner_login = $2,
+    is_active = $3
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateGiteaInstallationWebhookID :exec
+UPDATE gitea_installations
+SET webhook_id = $2
+WHERE id = $1;
@@ -0,0 +1,24 @@
+-- name: CreateGiteaRepo :one
+INSERT INTO gitea_repos (installation_id, gitea_id, name, full_name, url)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetGiteaRepoByID :one
+SELECT *
+FROM gitea_repos
+WHERE id = $1;
+
+-- name: GetGiteaRepoByInstallationIDAndGiteaID :one
+SELECT *
+FROM gitea_repos
+WHERE installation_id = $1 AND gitea_id = $2;
+
+-- name: SuspendedGiteaRepo :exec
+UPDATE gitea_repos
+SET is_active = false
+WHERE id = $1;
+
+-- name: ActivateGiteaRepo :exec
+UPDATE gitea_repos
+SET is_active = true
+WHERE id = $1;
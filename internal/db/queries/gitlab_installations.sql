@@ -0,0 +1,24 @@
+-- name: CreateGitlabInstallation :one
+INSERT INTO gitlab_installations (org_id, url, group_id, group_path, access_token, webhook_secret)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetGitlabInstallation :one
+SELECT *
+FROM gitlab_installations
+WHERE id = $1;
+
+-- name: GetGitlabInstallationByURLAndGroupID :one
+SELECT *
+FROM gitlab_installations
+WHERE url = $1 AND group_id = $2;
+
+-- name: UpdateGitlabInstallation :one
+UPDATE gitlab_installations
+SET
+    group_path = $2,
+    access_token = $3,
+    webhook_secret = $4,
+    is_active = $5
+WHERE id = $1
+RETURNING *;
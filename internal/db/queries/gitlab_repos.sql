@@ -0,0 +1,29 @@
+-- name: CreateGitlabRepo :one
+INSERT INTO gitlab_repos (installation_id, gitlab_id, name, full_name, url)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetGitlabRepoByID :one
+SELECT *
+FROM gitlab_repos
+WHERE id = $1;
+
+-- name: GetGitlabRepoByInstallationIDAndGitlabID :one
+SELECT *
+FROM gitlab_repos
+WHERE installation_id = $1 AND gitlab_id = $2;
+
+-- name: UpdateGitlabRepoWebhookID :exec
+UPDATE gitlab_repos
+SET webhook_id = $2
+WHERE id = $1;
+
+-- name: SuspendedGitlabRepo :exec
+UPDATE gitlab_repos
+SET is_active = false
+WHERE id = $1;
+
+-- name: ActivateGitlabRepo :exec
+UPDATE gitlab_repos
+SET is_active = true
+WHERE id = $1;
@@ -0,0 +1,39 @@
+-- name: GetNotificationDedup :one
+SELECT *
+FROM notification_dedup
+WHERE repo_id = $1 AND branch = $2 AND kind = $3;
+
+-- name: UpsertNotificationDedup :one
+INSERT INTO notification_dedup (repo_id, branch, kind, fingerprint, sent_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (repo_id, branch, kind) DO UPDATE
+SET
+    fingerprint = EXCLUDED.fingerprint,
+    sent_at = EXCLUDED.sent_at,
+    updated_at = now()
+RETURNING *;
+
+-- name: DeleteNotificationDedup :exec
+DELETE FROM notification_dedup
+WHERE repo_id = $1 AND branch = $2 AND kind = ANY(sqlc.arg(kinds)::text[]);
+
+-- name: GetNotificationRate :one
+SELECT *
+FROM notification_rates
+WHERE recipient = $1 AND channel = $2;
+
+-- name: IncrementNotificationRate :one
+INSERT INTO notification_rates (recipient, channel, window_start, count)
+VALUES ($1, $2, sqlc.arg(now)::timestamptz, 1)
+ON CONFLICT (recipient, channel) DO UPDATE
+SET
+    count = CASE
+      WHEN notification_rates.window_start <= sqlc.arg(expired)::timestamptz THEN 1
+      ELSE notification_rates.count + 1
+    END,
+    window_start = CASE
+      WHEN notification_rates.window_start <= sqlc.arg(expired)::timestamptz THEN EXCLUDED.window_start
+      ELSE notification_rates.window_start
+    END,
+    updated_at = now()
+RETURNING *;
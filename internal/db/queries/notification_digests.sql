@@ -0,0 +1,28 @@
+-- name: CreateNotificationDigest :one
+INSERT INTO notification_digests (recipient, channel, repo_id, kind, branch, summary, deliver_after)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: ListDueNotificationDigests :many
+SELECT
+  nd.*,
+  r.name AS repo_name,
+  r.url AS repo_url
+FROM notification_digests nd
+JOIN repos r ON r.id = nd.repo_id
+WHERE
+  nd.recipient = $1
+  AND nd.channel = $2
+  AND nd.deliver_after <= sqlc.arg(now)::timestamptz
+ORDER BY nd.created_at;
+
+-- name: GetNextNotificationDigest :one
+SELECT deliver_after
+FROM notification_digests
+WHERE recipient = $1 AND channel = $2
+ORDER BY deliver_after
+LIMIT 1;
+
+-- name: DeleteNotificationDigests :exec
+DELETE FROM notification_digests
+WHERE id = ANY(sqlc.arg(ids)::uuid[]);
@@ -0,0 +1,32 @@
+-- name: GetNotificationPreference :one
+SELECT *
+FROM notification_preferences
+WHERE link_to = $1;
+
+-- name: GetTeamNotificationPreferenceByUser :one
+SELECT np.*
+FROM notification_preferences np
+JOIN team_users tu ON tu.team_id = np.link_to
+WHERE
+  np.kind = 'team'
+  AND tu.user_id = $1
+  AND tu.is_active = true
+ORDER BY tu.created_at
+LIMIT 1;
+
+-- name: UpsertNotificationPreference :one
+INSERT INTO notification_preferences (
+  kind, link_to, muted, channel, timezone, quiet_start, quiet_end, digest, digest_hour
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (link_to) DO UPDATE
+SET
+    muted = EXCLUDED.muted,
+    channel = EXCLUDED.channel,
+    timezone = EXCLUDED.timezone,
+    quiet_start = EXCLUDED.quiet_start,
+    quiet_end = EXCLUDED.quiet_end,
+    digest = EXCLUDED.digest,
+    digest_hour = EXCLUDED.digest_hour,
+    updated_at = now()
+RETURNING *;
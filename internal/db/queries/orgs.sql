@@ -23,3 +23,8 @@ UPDATE orgs
 SET name = $2, domain = LOWER($3), slug = $4
 WHERE id = $1
 RETURNING *;
+
+-- name: ListOrgSlugs :many
+SELECT slug
+FROM orgs
+ORDER BY created_at;
@@ -0,0 +1,17 @@
+-- name: GetPullRequestComment :one
+SELECT *
+FROM pull_request_comments
+WHERE repo_id = $1 AND number = $2;
+
+-- name: UpsertPullRequestComment :one
+INSERT INTO pull_request_comments (repo_id, number, comment_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (repo_id, number) DO UPDATE
+SET
+    comment_id = EXCLUDED.comment_id,
+    updated_at = now()
+RETURNING *;
+
+-- name: DeletePullRequestComment :exec
+DELETE FROM pull_request_comments
+WHERE repo_id = $1 AND number = $2;
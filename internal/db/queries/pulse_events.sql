@@ -0,0 +1,98 @@
+-- name: CreatePulseEvents :exec
+-- inserts a batch of events, given as a json array of the events, in a single statement.
+INSERT INTO pulse_events (
+  id, version, parents, hook, scope, action, source, subject_id, subject_name, user_id, team_id, org_id, timestamp, payload
+)
+SELECT
+  r.id, r.version, coalesce(r.parents, '{}'), r.hook, r.scope, r.action, r.source, r.subject_id, r.subject_name,
+  r.user_id, r.team_id, r.org_id, r.timestamp, coalesce(r.payload, '{}')
+FROM jsonb_to_recordset(@rows::jsonb) AS r (
+  id uuid, version varchar, parents uuid[], hook integer, scope varchar, action varchar, source text, subject_id uuid,
+  subject_name varchar, user_id uuid, team_id uuid, org_id uuid, timestamp timestamptz, payload jsonb
+)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: CountPulseEventsForReport :one
+SELECT
+  count(*) FILTER (WHERE scope = 'merge' AND action = 'failure') AS conflicts_detected,
+  count(*) FILTER (WHERE scope = 'merge' AND action = 'completed') AS conflicts_resolved,
+  count(*) FILTER (WHERE scope = 'drift' AND action = 'requested') AS stale_branches
+FROM pulse_events
+WHERE
+  CASE WHEN @by::text = 'team_id' THEN team_id ELSE subject_id END = @id::uuid
+  AND timestamp >= @from_at::timestamptz
+  AND timestamp < @to_at::timestamptz;
+
+-- name: ListPulsePullRequestsForReport :many
+WITH merged AS (
+  SELECT subject_id, (payload->>'number')::bigint AS number, min(payload->>'head_branch') AS branch, min(timestamp) AS merged_at
+  FROM pulse_events
+  WHERE
+    CASE WHEN @by::text = 'team_id' THEN team_id ELSE subject_id END = @id::uuid
+    AND timestamp >= @from_at::timestamptz
+    AND timestamp < @to_at::timestamptz
+    AND scope = 'pr' AND action = 'closed'
+    AND hook BETWEEN @repo_hook_min::integer AND @repo_hook_max::integer
+    AND coalesce((payload->>'merged')::boolean, false)
+  GROUP BY subject_id, (payload->>'number')::bigint
+)
+SELECT
+  m.subject_id,
+  m.number::bigint AS number,
+  coalesce(m.branch, '')::text AS branch,
+  m.merged_at::timestamptz AS merged_at,
+  coalesce((
+    SELECT min(e.timestamp) FROM pulse_events e
+    WHERE e.subject_id = m.subject_id AND e.scope = 'pr' AND e.action = 'opened' AND (e.payload->>'number')::bigint = m.number
+  ), 'epoch')::timestamptz AS opened_at,
+  coalesce((
+    SELECT max(e.timestamp) FROM pulse_events e
+    WHERE e.subject_id = m.subject_id AND e.scope = 'merge_queue' AND e.action = 'added'
+      AND (e.payload->>'number')::bigint = m.number AND e.timestamp <= m.merged_at
+  ), 'epoch')::timestamptz AS queued_at,
+  coalesce((
+    SELECT coalesce((e.payload->'lines'->>'added')::bigint, 0) + coalesce((e.payload->'lines'->>'removed')::bigint, 0)
+    FROM pulse_events e
+    WHERE e.subject_id = m.subject_id AND e.scope = 'diff' AND e.payload->>'branch' = m.branch AND e.timestamp <= m.merged_at
+    ORDER BY e.timestamp DESC
+    LIMIT 1
+  ), 0)::bigint AS lines
+FROM merged m;
+
+-- name: ListPulseEventsForRepo :many
+-- selects a page of the events of a repo, skipping the ones derived from the events of the derived scopes.
+SELECT *
+FROM pulse_events
+WHERE
+  subject_id = @repo_id::uuid
+  AND timestamp >= @from_at::timestamptz
+  AND timestamp < @to_at::timestamptz
+  AND scope = ANY(@scopes::text[])
+  AND NOT (
+    cardinality(parents) > 0
+    AND parents[cardinality(parents)] IN (
+      SELECT d.id FROM pulse_events d WHERE d.subject_id = @repo_id::uuid AND d.scope = ANY(@derived::text[])
+    )
+  )
+ORDER BY timestamp, id
+LIMIT @row_limit::integer OFFSET @row_offset::integer;
+
+-- name: ListPulseEventsLineage :many
+-- selects the roots of the events matching either the event id, or the pull request number or the branch of the repo,
+-- then the roots and the events descending from them.
+WITH roots AS (
+  SELECT coalesce(array_agg(DISTINCT CASE WHEN cardinality(parents) = 0 THEN id ELSE parents[1] END), '{}')::uuid[] AS ids
+  FROM pulse_events
+  WHERE
+    CASE
+      WHEN @event_id::uuid <> '00000000-0000-0000-0000-000000000000' THEN id = @event_id::uuid
+      WHEN @pr_number::bigint > 0 THEN subject_id = @repo_id::uuid AND (payload->>'number')::bigint = @pr_number::bigint
+      ELSE subject_id = @repo_id::uuid AND @branch::text <> ''
+        AND coalesce(payload->>'head_branch', payload->>'branch') = @branch::text
+    END
+)
+SELECT e.*
+FROM pulse_events e, roots
+WHERE e.id = ANY(roots.ids) OR e.parents && roots.ids
+ORDER BY e.timestamp, e.id
+LIMIT @row_limit::integer;
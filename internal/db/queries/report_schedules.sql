@@ -0,0 +1,28 @@
+-- name: GetReportSchedule :one
+SELECT *
+FROM report_schedules
+WHERE link_to = $1;
+
+-- name: ListEnabledReportSchedulesByOrgID :many
+SELECT *
+FROM report_schedules
+WHERE org_id = $1 AND enabled
+ORDER BY created_at;
+
+-- name: UpsertReportSchedule :one
+INSERT INTO report_schedules (org_id, kind, link_to, frequency, timezone, hour, weekday, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (link_to) DO UPDATE
+SET
+    frequency = EXCLUDED.frequency,
+    timezone = EXCLUDED.timezone,
+    hour = EXCLUDED.hour,
+    weekday = EXCLUDED.weekday,
+    enabled = EXCLUDED.enabled,
+    updated_at = now()
+RETURNING *;
+
+-- name: UpdateReportScheduleSentAt :exec
+UPDATE report_schedules
+SET sent_at = $2
+WHERE id = $1;
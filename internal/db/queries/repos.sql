@@ -18,7 +18,23 @@ SET
     default_branch = $6,
     is_monorepo = $7,
     threshold = $8,
-    stale_duration = $9
+    stale_duration = $9,
+    push_debounce = $10,
+    auto_update = $11,
+    drift_behind = $12,
+    drift_duration = $13,
+    drift_churn = $14
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateRepoSettings :one
+UPDATE repos
+SET
+    push_debounce = $2,
+    auto_update = $3,
+    drift_behind = $4,
+    drift_duration = $5,
+    drift_churn = $6
 WHERE id = $1
 RETURNING *;
 
@@ -53,6 +69,11 @@ SELECT *
 FROM repos
 WHERE org_id = $1;
 
+-- name: GetOrgRepoByName :one
+SELECT *
+FROM repos
+WHERE org_id = $1 AND name = $2;
+
 -- name: GetReposByHookAndHookID :one
 SELECT *
 FROM repos
@@ -88,3 +109,44 @@ JOIN
   orgs org ON repo.org_id = org.id
 WHERE
   github_repo.installation_id = $1 AND github_repo.github_id = $2;
+
+-- name: GetRepoForGitlab :one
+SELECT
+ sqlc.embed(repo),
+ sqlc.embed(org)
+FROM
+  gitlab_repos gitlab_repo
+JOIN
+  gitlab_installations installation ON gitlab_repo.installation_id = installation.id
+JOIN
+  repos repo on gitlab_repo.id = repo.hook_id
+JOIN
+  orgs org ON repo.org_id = org.id
+WHERE
+  gitlab_repo.installation_id = $1 AND gitlab_repo.gitlab_id = $2 AND installation.is_active = true;
+
+-- name: GetRepoForGitea :one
+SELECT
+ sqlc.embed(repo),
+ sqlc.embed(org)
+FROM
+  gitea_repos gitea_repo
+JOIN
+  gitea_installations installation ON gitea_repo.installation_id = installation.id
+JOIN
+  repos repo on gitea_repo.id = repo.hook_id
+JOIN
+  orgs org ON repo.org_id = org.id
+WHERE
+  installation.owner_id = $1 AND gitea_repo.gitea_id = $2 AND installation.is_active = true;
+
+-- name: GetRepoForLocal :one
+SELECT
+ sqlc.embed(repo),
+ sqlc.embed(org)
+FROM
+  repos repo
+JOIN
+  orgs org ON repo.org_id = org.id
+WHERE
+  repo.hook = $1 AND repo.hook_id = $2;
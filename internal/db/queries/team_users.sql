@@ -7,3 +7,8 @@ RETURNING *;
 SELECT *
 FROM team_users
 WHERE user_id = $1;
+
+-- name: GetTeamUserByTeam :one
+SELECT *
+FROM team_users
+WHERE team_id = $1 AND user_id = $2;
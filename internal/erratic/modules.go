@@ -1,13 +1,19 @@
 package erratic
 
 const (
-	CommonModule      int = 100
-	AuthModule        int = 200
-	CoreModule        int = 300
-	CoreKernelModule  int = 301
-	CoreReposModule   int = 302
-	CoreChatModule    int = 303
-	HooksModule       int = 400
-	HooksGithubModule int = 401
-	HooksSlackModule  int = 402
+	CommonModule       int = 100
+	AuthModule         int = 200
+	CoreModule         int = 300
+	CoreKernelModule   int = 301
+	CoreReposModule    int = 302
+	CoreChatModule     int = 303
+	HooksModule        int = 400
+	HooksGithubModule  int = 401
+	HooksSlackModule   int = 402
+	HooksGitlabModule  int = 403
+	HooksGiteaModule   int = 404
+	HooksLocalModule   int = 405
+	HooksTeamsModule   int = 406
+	HooksWebhookModule int = 407
+	HooksEmailModule   int = 408
 )
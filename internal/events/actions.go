@@ -10,6 +10,7 @@ const (
 	ActionDeleted      Action = "deleted"   // ActionDeleted indicates the removal.
 	ActionUpdated      Action = "updated"   // ActionUpdated indicates that something has been modified.
 	ActionForced       Action = "forced"    // ActionForced indicates an action was applied regardless of normal constraints.
+	ActionOpened       Action = "opened"    // ActionOpened indicates a pull request has been opened.
 	ActionReopened     Action = "reopened"  // ActionReopened indicates a previously closed item has been reopened.
 	ActionClosed       Action = "closed"    // ActionClosed indicates an item or process has reached a terminal/inactive state.
 	ActionStarted      Action = "started"   // ActionStarted indicates the start of a process or task.
@@ -14,5 +14,9 @@ type (
 		Scope   Scope       `json:"scope"`     // Scope is the Event category (e.g., branch, pull_request).
 		Action  Action      `json:"action"`    // Action is the Triggering action (e.g., created, updated, merged).
 		Source  string      `json:"source"`    // Source is the Event source.
+
+		// Replayed marks the events signalled again by a replay, and the events derived from them, which are not
+		// persisted again.
+		Replayed bool `json:"replayed,omitempty"`
 	}
 )
@@ -126,6 +126,8 @@ func NextWithHook[H1 Hook, H2 Hook, F Payload, T Payload](event *Event[H1, F], h
 		Scope:   scope,
 		Action:  action,
 		Source:  event.Context.Source,
+
+		Replayed: event.Context.Replayed,
 	}
 
 	return New[H2, T]().SetContext(ctx).SetSubject(event.Subject)
@@ -1,6 +1,8 @@
 package events
 
 import (
+	"fmt"
+
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
@@ -10,3 +12,20 @@ type (
 		eventsv1.RepoHook | eventsv1.ChatHook
 	}
 )
+
+const (
+	// RepoHookMin and RepoHookMax bound the values of eventsv1.RepoHook. The chat hooks are numbered from 2000, and
+	// echo some of the events of the repo hooks, e.g. the pull requests merged.
+	RepoHookMin int32 = 1000
+	RepoHookMax int32 = 1999
+)
+
+var (
+	// RepoHookFilter is the SQL condition on the hook column selecting the events of the repo hooks.
+	RepoHookFilter = fmt.Sprintf("hook BETWEEN %d AND %d", RepoHookMin, RepoHookMax)
+)
+
+// IsRepoHook reports whether the hook of an event is a repo hook.
+func IsRepoHook(hook int32) bool {
+	return hook >= RepoHookMin && hook <= RepoHookMax
+}
@@ -12,6 +12,7 @@ type (
 		eventsv1.GitRef |
 			eventsv1.Push | eventsv1.Rebase | eventsv1.PullRequest | eventsv1.PullRequestLabel | eventsv1.PullRequestReview |
 			eventsv1.PullRequestReviewComment |
-			eventsv1.Merge | eventsv1.Diff | eventsv1.MergeQueue
+			eventsv1.Merge | eventsv1.Diff | eventsv1.MergeQueue | eventsv1.Drift | eventsv1.Pipeline | eventsv1.Command |
+			eventsv1.Digest | eventsv1.Report
 	}
 )
@@ -17,4 +17,9 @@ const (
 	ScopePrLabel    Scope = "pr_label"    // ScopePrLabel scopes pull request label event.
 	ScopeMerge      Scope = "merge"       // ScopeMerge scopes merge event.
 	ScopeMergeQueue Scope = "merge_queue" // ScopeMergeQueue scopes merge queue event.
+	ScopeDrift      Scope = "drift"       // ScopeDrift scopes branch drift event.
+	ScopePipeline   Scope = "pipeline"    // ScopePipeline scopes ci pipeline event.
+	ScopeCommand    Scope = "command"     // ScopeCommand scopes command given on a pull request.
+	ScopeDigest     Scope = "digest"      // ScopeDigest scopes digest of deferred notifications.
+	ScopeReport     Scope = "report"      // ScopeReport scopes scheduled report of a repo or a team.
 )
@@ -26,5 +26,7 @@ type (
 
 const (
 	SubjectNameRepos = "repos"
+	SubjectNameUsers = "users"
 	SubjectNameChat  = "chat"
+	SubjectNameTeams = "teams"
 )
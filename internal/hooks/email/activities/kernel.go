@@ -0,0 +1,96 @@
+package activities
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/email/cast"
+	"go.breu.io/quantm/internal/hooks/email/config"
+	"go.breu.io/quantm/internal/hooks/email/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/email/errors"
+	"go.breu.io/quantm/internal/hooks/email/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Kernel implements kernel.Chat with emails, for the users without a chat.
+	Kernel struct{}
+)
+
+func (k *Kernel) NotifyLinesExceed(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error {
+	return k.send(ctx, event.Subject, cast.DiffEventToNotification(event))
+}
+
+func (k *Kernel) NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error {
+	return k.send(ctx, event.Subject, cast.MergeEventToNotification(event))
+}
+
+func (k *Kernel) NotifyBranchDrift(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) error {
+	return k.send(ctx, event.Subject, cast.DriftEventToNotification(event))
+}
+
+func (k *Kernel) NotifyDigest(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) error {
+	return k.send(ctx, event.Subject, cast.DigestEventToNotification(event))
+}
+
+func (k *Kernel) NotifyReport(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Report]) error {
+	return k.send(ctx, event.Subject, cast.ReportEventToNotification(event))
+}
+
+// send sends the notification to the addresses of the subject.
+func (k *Kernel) send(ctx context.Context, subject events.Subject, notification *defs.Notification) error {
+	addresses, err := k.addresses(ctx, subject)
+	if err != nil {
+		return err
+	}
+
+	message, err := fns.Render(notification)
+	if err != nil {
+		return err
+	}
+
+	return fns.NewClient(config.Instance()).Send(ctx, addresses, message)
+}
+
+// addresses returns the email addresses linked to the target of the subject. A user without a link to email addresses,
+// e.g. a user on Slack preferring to be notified by email, falls back to the email address of the account.
+func (k *Kernel) addresses(ctx context.Context, subject events.Subject) ([]string, error) {
+	link, err := db.Queries().GetChatLink(ctx, target(subject))
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+
+	if err == nil && link.Hook == int32(eventsv1.ChatHook_CHAT_HOOK_EMAIL) {
+		data := &defs.LinkData{}
+		if err := data.Unmarshal(link.Data); err != nil {
+			return nil, err
+		}
+
+		return data.Addresses, nil
+	}
+
+	if subject.UserID == uuid.Nil {
+		return nil, pkgerrors.ErrNoRecipients
+	}
+
+	user, err := db.Queries().GetUserByID(ctx, subject.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{user.Email}, nil
+}
+
+// target returns the user the event belongs to, falling back to the subject itself, i.e. the repo.
+func target(subject events.Subject) uuid.UUID {
+	if subject.UserID != uuid.Nil {
+		return subject.UserID
+	}
+
+	return subject.ID
+}
@@ -0,0 +1,22 @@
+package email
+
+import (
+	"go.breu.io/quantm/internal/hooks/email/activities"
+	"go.breu.io/quantm/internal/hooks/email/config"
+	"go.breu.io/quantm/internal/hooks/email/nomad"
+)
+
+type (
+	Config = config.Config
+
+	KernelImpl = activities.Kernel
+)
+
+var (
+	DefaultPort = config.DefaultPort
+
+	WithConfig = config.WithConfig
+	Configure  = config.Instance
+
+	NomadHandler = nomad.NewEmailServiceHandler
+)
@@ -0,0 +1,187 @@
+package cast
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"go.breu.io/quantm/internal/core/reports"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/email/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+const (
+	// max_items caps the items listed in a section.
+	max_items = 25
+)
+
+// DiffEventToNotification renders the notification for a change exceeding the line threshold of the repo.
+func DiffEventToNotification(event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) *defs.Notification {
+	lines := event.Payload.GetLines()
+	files := event.Payload.GetFiles()
+
+	renamed := make([]string, len(files.GetRenamed()))
+	for i, file := range files.GetRenamed() {
+		renamed[i] = file.GetOld() + " -> " + file.GetNew()
+	}
+
+	return &defs.Notification{
+		Subject: fmt.Sprintf("[%s] Line threshold exceeded", repo(event.Context.Source)),
+		Title:   "Line Exceed Detected",
+		Intro:   "The number of lines in this pull request exceeds the allowed threshold. Please review and adjust accordingly.",
+		Facts: []defs.Fact{
+			{Title: "Repository", Value: repo(event.Context.Source)},
+			{Title: "Total Lines", Value: fmt.Sprintf("%d", lines.GetAdded()+lines.GetRemoved())},
+			{Title: "Lines Added", Value: fmt.Sprintf("%d", lines.GetAdded())},
+			{Title: "Lines Deleted", Value: fmt.Sprintf("%d", lines.GetRemoved())},
+		},
+		Sections: sections(
+			section("Added Files", files.GetAdded()),
+			section("Deleted Files", files.GetDeleted()),
+			section("Modified Files", files.GetModified()),
+			section("Renamed Files", renamed),
+		),
+		Action: "View Repository",
+		URL:    event.Context.Source,
+	}
+}
+
+// MergeEventToNotification renders the notification for a branch conflicting with the default branch.
+func MergeEventToNotification(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) *defs.Notification {
+	head := event.Payload.GetHeadBranch()
+	base := event.Payload.GetBaseBranch()
+
+	return &defs.Notification{
+		Subject: fmt.Sprintf("[%s] Merge conflict on %s", repo(event.Context.Source), head),
+		Title:   "Merge Conflict Detected",
+		Intro: fmt.Sprintf(
+			"We've detected a merge conflict in your feature branch, %s. "+
+				"This means there are changes in your branch that clash with recent updates on %s.",
+			head, base,
+		),
+		Facts: []defs.Fact{
+			{Title: "Repository", Value: repo(event.Context.Source)},
+			{Title: "Branch", Value: head},
+			{Title: "Default Branch", Value: base},
+			{Title: "Conflicting Files", Value: fmt.Sprintf("%d", len(event.Payload.GetFiles()))},
+		},
+		Sections: sections(section("Affected Files", event.Payload.GetFiles())),
+		Action:   "View Branch",
+		URL:      branch(event.Context.Source, head),
+	}
+}
+
+// DriftEventToNotification renders the notification for a branch drifting far behind the default branch.
+func DriftEventToNotification(event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) *defs.Notification {
+	days := 0
+	if event.Payload.GetSyncedAt() != nil {
+		days = int(time.Since(event.Payload.GetSyncedAt().AsTime()).Hours() / 24)
+	}
+
+	return &defs.Notification{
+		Subject: fmt.Sprintf("[%s] %s has drifted behind %s", repo(event.Context.Source), event.Payload.GetBranch(), event.Payload.GetTrunk()),
+		Title:   "Branch Drift Detected",
+		Intro: fmt.Sprintf(
+			"Your feature branch, %s, has drifted far behind %s. "+
+				"Bringing it up to date now keeps the eventual merge small and predictable.",
+			event.Payload.GetBranch(), event.Payload.GetTrunk(),
+		),
+		Facts: []defs.Fact{
+			{Title: "Repository", Value: repo(event.Context.Source)},
+			{Title: "Branch", Value: event.Payload.GetBranch()},
+			{Title: "Commits Behind", Value: fmt.Sprintf("%d", event.Payload.GetBehind())},
+			{Title: "Days Since Sync", Value: fmt.Sprintf("%d", days)},
+		},
+		Sections: sections(section("Churned Files", event.Payload.GetChurn())),
+		Action:   "View Branch",
+		URL:      branch(event.Context.Source, event.Payload.GetBranch()),
+	}
+}
+
+// DigestEventToNotification renders the notifications deferred by the notification preferences, grouped by repo.
+func DigestEventToNotification(event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) *defs.Notification {
+	repos := make([]string, 0)
+	items := make(map[string][]string)
+
+	for _, item := range event.Payload.GetItems() {
+		if _, ok := items[item.GetRepo()]; !ok {
+			repos = append(repos, item.GetRepo())
+		}
+
+		items[item.GetRepo()] = append(items[item.GetRepo()], item.GetBranch()+": "+item.GetSummary())
+	}
+
+	all := make([]defs.Section, len(repos))
+	for i, name := range repos {
+		all[i] = section(name, items[name])
+	}
+
+	return &defs.Notification{
+		Subject:  fmt.Sprintf("[quantm] Digest of %d notifications", len(event.Payload.GetItems())),
+		Title:    "Your quantm Digest",
+		Intro:    "The notifications held back by your notification preferences since the last digest.",
+		Sections: sections(all...),
+	}
+}
+
+// ReportEventToNotification renders the scheduled report of a repo or a team.
+func ReportEventToNotification(event *events.Event[eventsv1.ChatHook, eventsv1.Report]) *defs.Notification {
+	title := reports.Title(event.Payload)
+	metrics := reports.Metrics(event.Payload)
+
+	facts := make([]defs.Fact, len(metrics))
+	for i, metric := range metrics {
+		facts[i] = defs.Fact{Title: metric.Title, Value: metric.Value}
+	}
+
+	notification := &defs.Notification{
+		Subject:  fmt.Sprintf("[%s] %s, %s", event.Payload.GetName(), title, reports.Span(event.Payload)),
+		Title:    title,
+		Intro:    fmt.Sprintf("The activity from %s.", reports.Span(event.Payload)),
+		Facts:    facts,
+		Sections: sections(section("Largest Pull Requests", reports.Largest(event.Payload))),
+	}
+
+	if event.Payload.GetSource() != "" {
+		notification.Action = "View Repository"
+		notification.URL = event.Payload.GetSource()
+	}
+
+	return notification
+}
+
+// section caps the items of the section. A section without items has no heading.
+func section(heading string, items []string) defs.Section {
+	if len(items) == 0 {
+		return defs.Section{}
+	}
+
+	if len(items) > max_items {
+		items = append(items[:max_items:max_items], fmt.Sprintf("and %d more", len(items)-max_items))
+	}
+
+	return defs.Section{Heading: heading, Items: items}
+}
+
+// sections drops the empty sections.
+func sections(all ...defs.Section) []defs.Section {
+	result := make([]defs.Section, 0, len(all))
+
+	for _, s := range all {
+		if s.Heading != "" {
+			result = append(result, s)
+		}
+	}
+
+	return result
+}
+
+func repo(source string) string {
+	return path.Base(strings.TrimSuffix(source, "/"))
+}
+
+func branch(source, name string) string {
+	return strings.TrimSuffix(source, "/") + "/tree/" + name
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	_once sync.Once
+	_c    *Config
+)
+
+type (
+	// Config holds the configuration of the SMTP server the notifications are sent through.
+	Config struct {
+		Host     string `koanf:"HOST" validate:"required,hostname|ip"`
+		Port     int    `koanf:"PORT" validate:"required,min=1,max=65535"`
+		Username string `koanf:"USERNAME"` // optional, the server is used without authentication when empty.
+		Password string `koanf:"PASSWORD"`
+		From     string `koanf:"FROM" validate:"required,email"`
+	}
+
+	ConfigOption func(*Config)
+)
+
+const (
+	DefaultPort = 587
+)
+
+func (c *Config) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// Enabled reports whether the email integration is configured.
+func (c *Config) Enabled() bool {
+	return c.Host != ""
+}
+
+// Address returns the host:port of the SMTP server.
+func (c *Config) Address() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// Start is a no-op function that satisfies the graceful Service interface.
+func (c *Config) Start(ctx context.Context) error {
+	slog.Info("hooks/email: configured", "address", c.Address(), "from", c.From)
+
+	return nil
+}
+
+// Stop is a no-op function that satisfies the graceful Service interface.
+func (c *Config) Stop(ctx context.Context) error { return nil }
+
+// WithConfig copies the values from the given Config into the target Config.
+func WithConfig(cfg *Config) ConfigOption {
+	return func(config *Config) {
+		config.Host = cfg.Host
+		config.Port = cfg.Port
+		config.Username = cfg.Username
+		config.Password = cfg.Password
+		config.From = cfg.From
+	}
+}
+
+// Instance returns the singleton instance of the email configuration.
+func Instance(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		_c = &Config{Port: DefaultPort}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
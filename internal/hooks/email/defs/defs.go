@@ -0,0 +1,54 @@
+package defs
+
+import (
+	"encoding/json"
+)
+
+// Kind constants.
+const (
+	KindEmail = "email"
+)
+
+type (
+	// LinkData is the data of a chat link to email addresses.
+	LinkData struct {
+		Addresses []string `json:"addresses"`
+	}
+
+	// Notification is the content of an email, rendered both as html and as plain text.
+	Notification struct {
+		Subject  string
+		Title    string
+		Intro    string
+		Facts    []Fact
+		Sections []Section
+		Action   string // label of the link to URL.
+		URL      string
+	}
+
+	Fact struct {
+		Title string
+		Value string
+	}
+
+	// Section is a list of items under a heading, e.g. the conflicting files.
+	Section struct {
+		Heading string
+		Items   []string
+	}
+
+	// Message is a rendered email.
+	Message struct {
+		Subject string
+		Text    string
+		HTML    string
+	}
+)
+
+func (d *LinkData) Marshal() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+func (d *LinkData) Unmarshal(data []byte) error {
+	return json.Unmarshal(data, d)
+}
@@ -0,0 +1,9 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	ErrNoRecipients = errors.New("no recipients")
+)
@@ -0,0 +1,174 @@
+package fns_test
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/hooks/email/config"
+	"go.breu.io/quantm/internal/hooks/email/defs"
+	"go.breu.io/quantm/internal/hooks/email/fns"
+)
+
+type (
+	// sink is a local SMTP server keeping the messages it receives. The first busy sessions are refused with a
+	// transient reply.
+	sink struct {
+		mu       sync.Mutex
+		listener net.Listener
+		busy     int
+		rcpts    []string
+		messages []string
+	}
+)
+
+func (s *sink) serve(t *testing.T) {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		s.session(t, conn)
+	}
+}
+
+func (s *sink) session(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+
+	if s.busy > 0 {
+		s.busy--
+		_ = tp.PrintfLine("421 busy, try again later")
+
+		return
+	}
+
+	_ = tp.PrintfLine("220 sink")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		switch verb := strings.ToUpper(strings.Fields(line)[0]); verb {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250 sink")
+		case "MAIL":
+			_ = tp.PrintfLine("250 ok")
+		case "RCPT":
+			s.mu.Lock()
+			s.rcpts = append(s.rcpts, strings.Trim(strings.TrimPrefix(line, "RCPT TO:"), "<>"))
+			s.mu.Unlock()
+
+			_ = tp.PrintfLine("250 ok")
+		case "DATA":
+			_ = tp.PrintfLine("354 go ahead")
+
+			data, err := tp.ReadDotLines()
+			require.NoError(t, err)
+
+			s.mu.Lock()
+			s.messages = append(s.messages, strings.Join(data, "\n"))
+			s.mu.Unlock()
+
+			_ = tp.PrintfLine("250 queued")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("502 unknown")
+		}
+	}
+}
+
+// received returns the recipients and the messages received so far.
+func (s *sink) received() ([]string, []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rcpts, s.messages
+}
+
+func start(t *testing.T, busy int) (*sink, *config.Config) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	s := &sink{listener: listener, busy: busy}
+	go s.serve(t)
+
+	addr := listener.Addr().(*net.TCPAddr)
+
+	return s, &config.Config{Host: addr.IP.String(), Port: addr.Port, From: "quantm@example.com"}
+}
+
+func TestRender(t *testing.T) {
+	notification := &defs.Notification{
+		Subject:  "[quantm] Merge conflict on feature",
+		Title:    "Merge Conflict Detected",
+		Intro:    "<b>feature</b> conflicts with main.",
+		Facts:    []defs.Fact{{Title: "Branch", Value: "feature"}},
+		Sections: []defs.Section{{Heading: "Affected Files", Items: []string{"a.go"}}},
+		Action:   "View Branch",
+		URL:      "https://github.com/breuhq/quantm/tree/feature",
+	}
+
+	message, err := fns.Render(notification)
+	require.NoError(t, err)
+
+	assert.Equal(t, notification.Subject, message.Subject)
+	assert.Contains(t, message.HTML, "&lt;b&gt;feature&lt;/b&gt;")
+	assert.Contains(t, message.HTML, `<li><code>a.go</code></li>`)
+	assert.Contains(t, message.Text, "Branch: feature")
+	assert.Contains(t, message.Text, "- a.go")
+	assert.Contains(t, message.Text, "View Branch: https://github.com/breuhq/quantm/tree/feature")
+}
+
+func TestClientSend(t *testing.T) {
+	s, cfg := start(t, 0)
+
+	message := &defs.Message{Subject: "Merge conflict", Text: "plain body", HTML: "<p>html body</p>"}
+
+	err := fns.NewClient(cfg).Send(context.Background(), []string{"dev@example.com"}, message)
+	require.NoError(t, err)
+
+	rcpts, messages := s.received()
+	require.Len(t, messages, 1)
+	assert.Equal(t, []string{"dev@example.com"}, rcpts)
+	assert.Contains(t, messages[0], "Subject: Merge conflict")
+	assert.Contains(t, messages[0], "Content-Type: multipart/alternative")
+	assert.Contains(t, messages[0], "plain body")
+	assert.Contains(t, messages[0], "<p>html body</p>")
+}
+
+func TestClientSendRetries(t *testing.T) {
+	s, cfg := start(t, 2)
+
+	client := fns.NewClient(cfg, fns.WithRetry(3, time.Millisecond))
+
+	err := client.Send(context.Background(), []string{"dev@example.com"}, &defs.Message{Subject: "s", Text: "t", HTML: "h"})
+	require.NoError(t, err)
+
+	_, messages := s.received()
+	assert.Len(t, messages, 1)
+}
+
+func TestClientSendGivesUp(t *testing.T) {
+	_, cfg := start(t, 5)
+
+	client := fns.NewClient(cfg, fns.WithRetry(2, time.Millisecond))
+
+	err := client.Send(context.Background(), []string{"dev@example.com"}, &defs.Message{Subject: "s", Text: "t", HTML: "h"})
+	assert.ErrorContains(t, err, "421")
+}
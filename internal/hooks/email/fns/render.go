@@ -0,0 +1,33 @@
+package fns
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+
+	"go.breu.io/quantm/internal/hooks/email/defs"
+)
+
+var (
+	//go:embed templates/*
+	templates embed.FS
+
+	html = htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/notification.html"))
+	text = texttemplate.Must(texttemplate.ParseFS(templates, "templates/notification.txt"))
+)
+
+// Render renders the notification as an email with both a html and a plain text body.
+func Render(notification *defs.Notification) (*defs.Message, error) {
+	var h, t bytes.Buffer
+
+	if err := html.Execute(&h, notification); err != nil {
+		return nil, err
+	}
+
+	if err := text.Execute(&t, notification); err != nil {
+		return nil, err
+	}
+
+	return &defs.Message{Subject: notification.Subject, HTML: h.String(), Text: t.String()}, nil
+}
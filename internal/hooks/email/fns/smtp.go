@@ -0,0 +1,217 @@
+package fns
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+
+	"go.breu.io/quantm/internal/hooks/email/config"
+	"go.breu.io/quantm/internal/hooks/email/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/email/errors"
+)
+
+type (
+	// Client sends the emails through the configured SMTP server. Sending is retried with exponential backoff on network
+	// errors and transient (4xx) replies of the server, permanent (5xx) replies are final.
+	Client struct {
+		cfg      *config.Config
+		attempts uint
+		delay    time.Duration
+	}
+
+	ClientOption func(*Client)
+)
+
+const (
+	DefaultAttempts = 4
+	DefaultDelay    = 500 * time.Millisecond
+	DefaultTimeout  = 30 * time.Second
+)
+
+// Send sends the message to the recipients.
+func (c *Client) Send(ctx context.Context, to []string, message *defs.Message) error {
+	if len(to) == 0 {
+		return pkgerrors.ErrNoRecipients
+	}
+
+	body, err := c.compose(to, message)
+	if err != nil {
+		return err
+	}
+
+	return retry.Do(
+		func() error { return c.send(ctx, to, body) },
+		retry.Context(ctx),
+		retry.Attempts(c.attempts),
+		retry.Delay(c.delay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.LastErrorOnly(true),
+		retry.OnRetry(func(count uint, err error) {
+			slog.Warn("email: sending failed, retrying ...", "attempt", count+1, "error", err.Error())
+		}),
+	)
+}
+
+// send delivers the composed message in a single SMTP session.
+func (c *Client) send(ctx context.Context, to []string, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", c.cfg.Address())
+	if err != nil {
+		return err
+	}
+
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, c.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return permanent(err)
+	}
+
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: c.cfg.Host, MinVersion: tls.VersionTLS12}); err != nil {
+			return permanent(err)
+		}
+	}
+
+	if c.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, c.cfg.Host)); err != nil {
+			return permanent(err)
+		}
+	}
+
+	if err := client.Mail(c.cfg.From); err != nil {
+		return permanent(err)
+	}
+
+	for _, rcpt := range to {
+		if err := client.Rcpt(rcpt); err != nil {
+			return permanent(err)
+		}
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return permanent(err)
+	}
+
+	if _, err := w.Write(body); err != nil {
+		return permanent(err)
+	}
+
+	if err := w.Close(); err != nil {
+		return permanent(err)
+	}
+
+	// the message is accepted once the data is closed, failing to quit must not send it twice.
+	_ = client.Quit()
+
+	return nil
+}
+
+// compose builds the MIME message, with the plain text and the html body as alternatives.
+func (c *Client) compose(to []string, message *defs.Message) ([]byte, error) {
+	var buf bytes.Buffer
+
+	mw := multipart.NewWriter(&buf)
+
+	headers := []string{
+		"From: " + c.cfg.From,
+		"To: " + strings.Join(to, ", "),
+		"Subject: " + mime.QEncoding.Encode("utf-8", message.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"Message-ID: " + message_id(c.cfg.From),
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + mw.Boundary(),
+	}
+
+	buf.WriteString(strings.Join(headers, "\r\n") + "\r\n\r\n")
+
+	for _, part := range [][2]string{{"text/plain", message.Text}, {"text/html", message.HTML}} {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part[0]+"; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+
+		pw, err := mw.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write([]byte(part[1])); err != nil {
+			return nil, err
+		}
+
+		if err := qw.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// permanent marks the permanent (5xx) replies of the server as unrecoverable.
+func permanent(err error) error {
+	var reply *textproto.Error
+	if errors.As(err, &reply) && reply.Code >= 500 {
+		return retry.Unrecoverable(err)
+	}
+
+	return err
+}
+
+// message_id generates a unique message id in the domain of the sender.
+func message_id(from string) string {
+	domain := "quantm.io"
+	if _, after, found := strings.Cut(from, "@"); found {
+		domain = after
+	}
+
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain)
+}
+
+// WithRetry sets the number of attempts to send an email and the delay before the first retry, doubled on every retry.
+func WithRetry(attempts uint, delay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.attempts = attempts
+		c.delay = delay
+	}
+}
+
+// NewClient creates a client for the SMTP server, retrying with the defaults unless configured otherwise.
+func NewClient(cfg *config.Config, opts ...ClientOption) *Client {
+	c := &Client{cfg: cfg, attempts: DefaultAttempts, delay: DefaultDelay}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
@@ -0,0 +1,69 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/email/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	emailv1 "go.breu.io/quantm/internal/proto/hooks/email/v1"
+	"go.breu.io/quantm/internal/proto/hooks/email/v1/emailv1connect"
+)
+
+type (
+	EmailService struct {
+		emailv1connect.UnimplementedEmailServiceHandler
+	}
+)
+
+// Link links email addresses to a repo or to a user.
+func (s *EmailService) Link(
+	ctx context.Context, req *connect.Request[emailv1.LinkRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	link_to, err := uuid.Parse(req.Msg.GetLinkTo())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksEmailModule).WithReason("invalid link_to UUID").Wrap(err)
+	}
+
+	existing, err := db.Queries().GetChatLink(ctx, link_to)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, erratic.NewDatabaseError(erratic.HooksEmailModule).WithReason("failed to query chat link").Wrap(err)
+	}
+
+	if existing.ID != uuid.Nil {
+		return nil, erratic.NewExistsError(erratic.HooksEmailModule).WithReason("chat link already exists")
+	}
+
+	link := &defs.LinkData{Addresses: req.Msg.GetAddresses()}
+
+	data, err := link.Marshal()
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksEmailModule).WithReason("unable to encode chat link").Wrap(err)
+	}
+
+	params := entities.CreateChatLinkParams{
+		Hook:   int32(eventsv1.ChatHook_CHAT_HOOK_EMAIL),
+		Kind:   defs.KindEmail,
+		LinkTo: link_to,
+		Data:   data,
+	}
+
+	if _, err := db.Queries().CreateChatLink(ctx, params); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.HooksEmailModule).WithReason("failed to save chat link").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func NewEmailServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return emailv1connect.NewEmailServiceHandler(&EmailService{}, opts...)
+}
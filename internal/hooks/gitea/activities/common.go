@@ -0,0 +1,172 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitea/cast"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// HydrateRepoEvent enriches a repository event using database data. It fetches the repository of the installed
+// organization, optionally adding user information if an email is provided. For non-default branches, it retrieves the
+// parent event ID from the core workflow.
+func HydrateRepoEvent(ctx context.Context, payload *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	params := entities.GetRepoForGiteaParams{OwnerID: payload.OwnerID, GiteaID: payload.RepoID}
+
+	row, err := db.Queries().GetRepoForGitea(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	hydrated := cast.RepoForGiteaToHydratedRepoEvent(row)
+
+	chat_link, err := db.Queries().GetChatLink(ctx, row.Repo.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Warn("unable to get chat link, notification will not work.") // TODO: user should know.
+		} else {
+			return nil, err
+		}
+	}
+
+	hydrated.ChatLinks.Repo = &chat_link
+
+	if payload.Email != "" {
+		user, _ := db.Queries().GetUserByEmail(ctx, payload.Email)
+		hydrated.User = &user
+	}
+
+	if (payload.Branch != "" && payload.Branch != hydrated.Repo.DefaultBranch) || payload.ShouldFetchParent {
+		parent, err := durable.
+			OnCore().
+			QueryWorkflow(ctx, hydrated.RepoWorkflowOptions(), repos.QueryRepoForEventParent, payload.Branch)
+
+		if err == nil {
+			_ = parent.Get(&hydrated.ParentID)
+		}
+	}
+
+	return hydrated, nil
+}
+
+// AddRepo adds a Gitea repository or activates an existing one using a database transaction. It retrieves the
+// repository; if found, it activates it. Otherwise, it creates database entries for both the Gitea and core
+// repositories.
+func AddRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	repo, err := qtx.GetGiteaRepoByInstallationIDAndGiteaID(ctx, entities.GetGiteaRepoByInstallationIDAndGiteaIDParams{
+		InstallationID: payload.InstallationID,
+		GiteaID:        payload.Repo.ID,
+	})
+
+	if err == nil {
+		if err := qtx.ActivateGiteaRepo(ctx, repo.ID); err != nil {
+			return err
+		}
+
+		if err := qtx.ActivateRepoByHookID(ctx, repo.ID); err != nil {
+			return err
+		}
+
+		return tx.Commit(ctx)
+	}
+
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	create := entities.CreateGiteaRepoParams{
+		InstallationID: payload.InstallationID,
+		GiteaID:        payload.Repo.ID,
+		Name:           payload.Repo.Name,
+		FullName:       payload.Repo.FullName,
+		Url:            payload.Repo.HTMLURL,
+	}
+
+	created, err := qtx.CreateGiteaRepo(ctx, create)
+	if err != nil {
+		return err
+	}
+
+	reqst := entities.CreateRepoParams{
+		OrgID:  payload.OrgID,
+		Hook:   int32(eventsv1.RepoHook_REPO_HOOK_GITEA),
+		HookID: created.ID,
+		Name:   payload.Repo.Name,
+		Url:    payload.Repo.HTMLURL,
+	}
+
+	if _, err := qtx.CreateRepo(ctx, reqst); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// SuspendRepo suspends a Gitea repository, handling cases where it doesn't exist. It retrieves the repository and, if
+// found, suspends both its Gitea and core repository entries using a database transaction.
+func SuspendRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	repo, err := db.Queries().
+		GetGiteaRepoByInstallationIDAndGiteaID(
+			ctx,
+			entities.GetGiteaRepoByInstallationIDAndGiteaIDParams{
+				InstallationID: payload.InstallationID,
+				GiteaID:        payload.Repo.ID,
+			},
+		)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+
+		return err
+	}
+
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := qtx.SuspendedGiteaRepo(ctx, repo.ID); err != nil {
+		return err
+	}
+
+	if err := qtx.SuspendedRepoByHookID(ctx, repo.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// SignalRepo signals a Gitea repository event to the core workflow.
+func SignalRepo[P events.Payload](ctx context.Context, hydrated *defs.HydratedQuantmEvent[P]) error {
+	_, err := durable.OnCore().SignalWithStartWorkflow(
+		ctx,
+		hydrated.Meta.RepoWorkflowOptions(),
+		hydrated.Signal,
+		hydrated.Event,
+		repos.RepoWorkflow,
+		repos.NewRepoWorkflowState(hydrated.Meta.GetRepo(), hydrated.Meta.GetRepoChatLink()),
+	)
+
+	return err
+}
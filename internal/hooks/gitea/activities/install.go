@@ -0,0 +1,111 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitea/config"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/gitea/errors"
+	"go.breu.io/quantm/internal/hooks/gitea/fns"
+)
+
+type (
+	Install struct{}
+)
+
+// GetGiteaOrg resolves the organization of the install request.
+func (a *Install) GetGiteaOrg(ctx context.Context, request *defs.RequestInstall) (*defs.Org, error) {
+	return client().GetOrg(ctx, request.Owner)
+}
+
+// GetOrCreateGiteaInstallation retrieves a Gitea installation from the database by the id of the organization. If the
+// installation does not exist, it creates a new one, otherwise it reactivates it. An organization installed by another
+// org is rejected, it must be uninstalled first.
+func (a *Install) GetOrCreateGiteaInstallation(
+	ctx context.Context, payload *defs.InstallationPayload,
+) (*entities.GiteaInstallation, error) {
+	install, err := db.Queries().GetGiteaInstallationByOwnerID(ctx, payload.Org.ID)
+	if err == nil {
+		if install.OrgID != payload.Request.OrgID {
+			slog.Warn("gitea: organization installed by another org", "owner", payload.Org.Name, "org", payload.Request.OrgID)
+			return nil, pkgerrors.ErrInstalledByOtherOrg
+		}
+
+		update := entities.UpdateGiteaInstallationParams{
+			ID:         install.ID,
+			OwnerLogin: payload.Org.Name,
+			IsActive:   true,
+		}
+
+		install, err = db.Queries().UpdateGiteaInstallation(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+
+		return &install, nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		create := entities.CreateGiteaInstallationParams{
+			OrgID:      payload.Request.OrgID,
+			OwnerID:    payload.Org.ID,
+			OwnerLogin: payload.Org.Name,
+		}
+
+		install, err = db.Queries().CreateGiteaInstallation(ctx, create)
+		if err != nil {
+			return nil, err
+		}
+
+		return &install, nil
+	}
+
+	return nil, err
+}
+
+// AddGiteaOrgHook adds the webhook to the organization, if it has not been added before.
+func (a *Install) AddGiteaOrgHook(ctx context.Context, install *entities.GiteaInstallation) error {
+	if install.WebhookID != 0 {
+		return nil
+	}
+
+	hook := &defs.Hook{
+		Type: "gitea",
+		Config: defs.HookConfig{
+			URL:         config.Instance().WebhookURL,
+			ContentType: "json",
+			Secret:      config.Instance().WebhookSecret,
+		},
+		Events: []string{"push", "create", "delete", "pull_request", "repository"},
+		Active: true,
+	}
+
+	created, err := client().AddOrgHook(ctx, install.OwnerLogin, hook)
+	if err != nil {
+		return err
+	}
+
+	return db.Queries().UpdateGiteaInstallationWebhookID(
+		ctx, entities.UpdateGiteaInstallationWebhookIDParams{ID: install.ID, WebhookID: created.ID},
+	)
+}
+
+// ListGiteaOrgRepos lists all the repositories of the installed organization.
+func (a *Install) ListGiteaOrgRepos(ctx context.Context, install *entities.GiteaInstallation) ([]defs.Repository, error) {
+	return client().ListOrgRepos(ctx, install.OwnerLogin)
+}
+
+func (a *Install) AddGiteaRepoToInstall(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	return AddRepo(ctx, payload)
+}
+
+// client returns the api client for the configured instance.
+func client() *fns.Client {
+	return fns.NewClient(config.Instance().URL, config.Instance().Token)
+}
@@ -0,0 +1,29 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitea/config"
+	"go.breu.io/quantm/internal/hooks/gitea/fns"
+)
+
+type (
+	// Kernel implements kernel.Repo for Gitea. Only cloning is supported so far, the other actions return
+	// kernel.ErrNotSupported.
+	Kernel struct {
+		kernel.BaseRepo
+	}
+)
+
+// TokenizedCloneUrl returns the clone url of the repository, authenticating with the token of the configured bot user.
+func (k *Kernel) TokenizedCloneUrl(ctx context.Context, repo *entities.Repo) (string, error) {
+	gtrepo, err := db.Queries().GetGiteaRepoByID(ctx, repo.HookID)
+	if err != nil {
+		return "", err
+	}
+
+	return fns.TokenizedCloneUrl(config.Instance().URL, gtrepo.FullName, config.Instance().Token)
+}
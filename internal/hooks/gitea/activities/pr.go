@@ -0,0 +1,21 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// PullRequest groups all the activities required for the Gitea Pull Request.
+	PullRequest struct{}
+)
+
+func (pr *PullRequest) HydrateGiteaPREvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+func (pr *PullRequest) SignalRepoWithGiteaPR(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.PullRequest]) error {
+	return SignalRepo(ctx, hydrated)
+}
@@ -0,0 +1,24 @@
+package activities
+
+import (
+	"context"
+	"time"
+
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Push groups all the activities required for the Gitea Push.
+	Push struct{}
+)
+
+func (p *Push) HydrateGiteaPushEvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	time.Sleep(2 * time.Second) // FIXME: this is a quick hack to get the parent id, same as github.
+
+	return HydrateRepoEvent(ctx, params)
+}
+
+func (p *Push) SignalRepoWithGiteaPush(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.Push]) error {
+	return SignalRepo(ctx, hydrated)
+}
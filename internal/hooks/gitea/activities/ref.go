@@ -0,0 +1,23 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Ref groups all the activities required for the Gitea Ref.
+	Ref struct{}
+)
+
+// HydrateGiteaRefEvent hydrates the branch event with the given parameters.
+func (r *Ref) HydrateGiteaRefEvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+// SignalRepoWithGiteaRef signals the repository with the hydrated branch event.
+func (r *Ref) SignalRepoWithGiteaRef(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.GitRef]) error {
+	return SignalRepo(ctx, hydrated)
+}
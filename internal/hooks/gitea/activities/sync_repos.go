@@ -0,0 +1,39 @@
+package activities
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+)
+
+type (
+	InstallRepos struct{}
+)
+
+func (a *InstallRepos) GiteaRepoAdded(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	return AddRepo(ctx, payload)
+}
+
+func (a *InstallRepos) GiteaRepoRemoved(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	return SuspendRepo(ctx, payload)
+}
+
+// GetGiteaInstallationForSync returns the installation of the organization, or nil if the organization is not
+// installed.
+func (a *InstallRepos) GetGiteaInstallationForSync(ctx context.Context, owner_id int64) (*entities.GiteaInstallation, error) {
+	install, err := db.Queries().GetGiteaInstallationByOwnerID(ctx, owner_id)
+	if err == nil {
+		return &install, nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+
+	return nil, err
+}
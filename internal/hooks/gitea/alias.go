@@ -0,0 +1,36 @@
+package gitea
+
+import (
+	"go.breu.io/quantm/internal/hooks/gitea/activities"
+	"go.breu.io/quantm/internal/hooks/gitea/config"
+	"go.breu.io/quantm/internal/hooks/gitea/nomad"
+	"go.breu.io/quantm/internal/hooks/gitea/web"
+	"go.breu.io/quantm/internal/hooks/gitea/workflows"
+)
+
+type (
+	InstallActivity      = activities.Install
+	InstallReposActivity = activities.InstallRepos
+	PushActivity         = activities.Push
+	RefActivity          = activities.Ref
+	PullRequestActivity  = activities.PullRequest
+
+	KernelImpl = activities.Kernel
+
+	Config  = config.Config
+	Webhook = web.Webhook
+)
+
+var (
+	Configure  = config.Configure
+	WithConfig = config.WithConfig
+	Get        = config.Instance
+
+	InstallWorkflow     = workflows.GiteaInstall
+	RefWorkflow         = workflows.GiteaRef
+	PushWorkflow        = workflows.GiteaPush
+	PullRequestWorkflow = workflows.GiteaPullRequest
+	SyncReposWorkflow   = workflows.GiteaSyncRepos
+
+	NomadHandler = nomad.NewGiteaServiceHandler
+)
@@ -0,0 +1,15 @@
+package cast
+
+import (
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+)
+
+// RepoForGiteaToHydratedRepoEvent converts a database row into a HydratedEvent.
+func RepoForGiteaToHydratedRepoEvent(row entities.GetRepoForGiteaRow) *defs.HydratedRepoEvent {
+	return &defs.HydratedRepoEvent{
+		Repo:      &row.Repo,
+		Org:       &row.Org,
+		ChatLinks: &defs.ChatLinks{},
+	}
+}
@@ -0,0 +1,77 @@
+package cast
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func RefToProto(ref *defs.WebhookRef) eventsv1.GitRef {
+	return eventsv1.GitRef{
+		Ref:  ref.Ref,
+		Kind: ref.RefType,
+	}
+}
+
+func PushToProto(push *defs.Push) eventsv1.Push {
+	return eventsv1.Push{
+		Ref:        push.Ref,
+		Before:     push.Before,
+		After:      push.After,
+		Repository: push.GetRepositoryName(),
+		SenderId:   push.Sender.ID,
+		Timestamp:  timestamppb.New(time.Now()),
+		Commits:    CommitsToProto(push.Commits),
+	}
+}
+
+func CommitsToProto(commits []defs.Commit) []*eventsv1.Commit {
+	result := make([]*eventsv1.Commit, len(commits))
+	for i, commit := range commits {
+		result[i] = &eventsv1.Commit{
+			Sha:       commit.ID,
+			Message:   commit.Message,
+			Url:       commit.URL,
+			Timestamp: timestamppb.New(commit.Timestamp),
+			Added:     commit.Added,
+			Removed:   commit.Removed,
+			Modified:  commit.Modified,
+		}
+	}
+
+	return result
+}
+
+func PullRequestToProto(pr *defs.PR) eventsv1.PullRequest {
+	return eventsv1.PullRequest{
+		Number:     pr.GetNumber(),
+		Title:      pr.PullRequest.Title,
+		Body:       pr.PullRequest.Body,
+		Author:     pr.PullRequest.User.Login,
+		HeadBranch: pr.GetHeadBranch(),
+		BaseBranch: pr.GetBaseBranch(),
+		Timestamp:  timestamppb.New(pr.PullRequest.UpdatedAt),
+		Merged:     pr.PullRequest.Merged,
+	}
+}
+
+// PullRequestAction maps the pull request action to the pull request action of the GitHub hook, so that the core
+// handles both alike. Returns false for actions the core has no use for, e.g. assignments, reviews and labels.
+func PullRequestAction(action string) (events.Action, bool) {
+	switch action {
+	case "opened":
+		return events.ActionOpened, true
+	case "reopened":
+		return events.ActionReopened, true
+	case "edited", "synchronized":
+		return events.ActionUpdated, true
+	case "closed":
+		return events.ActionClosed, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,92 @@
+package cast_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitea/cast"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+)
+
+func fixture[T any](t *testing.T, name string) *T {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	payload := new(T)
+	require.NoError(t, json.Unmarshal(data, payload))
+
+	return payload
+}
+
+func TestPushToProto(t *testing.T) {
+	t.Parallel()
+
+	push := fixture[defs.Push](t, "push.json")
+
+	assert.Equal(t, int64(42), push.GetRepositoryID())
+	assert.Equal(t, int64(7), push.GetOwnerID())
+	assert.Equal(t, "jane@example.com", push.GetPusherEmail())
+
+	pb := cast.PushToProto(push)
+
+	assert.Equal(t, "refs/heads/feature/login", pb.GetRef())
+	assert.Equal(t, push.Before, pb.GetBefore())
+	assert.Equal(t, push.After, pb.GetAfter())
+	assert.Equal(t, "api", pb.GetRepository())
+	assert.Equal(t, int64(3), pb.GetSenderId())
+	require.Len(t, pb.GetCommits(), 1)
+	assert.Equal(t, "bffeb74224043ba2feb48d137756c8a9331c449a", pb.GetCommits()[0].GetSha())
+	assert.Equal(t, []string{"web/login.html"}, pb.GetCommits()[0].GetAdded())
+	assert.Equal(t, []string{"web/index.html"}, pb.GetCommits()[0].GetModified())
+	assert.True(t, pb.GetCommits()[0].GetTimestamp().AsTime().Equal(time.Date(2024, 3, 5, 9, 11, 12, 0, time.UTC)))
+}
+
+func TestRefToProto(t *testing.T) {
+	t.Parallel()
+
+	ref := fixture[defs.WebhookRef](t, "create.json")
+
+	pb := cast.RefToProto(ref)
+
+	assert.Equal(t, "feature/login", pb.GetRef())
+	assert.Equal(t, "branch", pb.GetKind())
+	assert.Equal(t, int64(7), ref.GetOwnerID())
+}
+
+func TestPullRequestToProto(t *testing.T) {
+	t.Parallel()
+
+	pr := fixture[defs.PR](t, "pull_request.json")
+
+	pb := cast.PullRequestToProto(pr)
+
+	assert.Equal(t, int64(12), pb.GetNumber())
+	assert.Equal(t, "Add login form", pb.GetTitle())
+	assert.Equal(t, "Adds the login form.", pb.GetBody())
+	assert.Equal(t, "jane", pb.GetAuthor())
+	assert.Equal(t, "feature/login", pb.GetHeadBranch())
+	assert.Equal(t, "main", pb.GetBaseBranch())
+	assert.True(t, pb.GetTimestamp().AsTime().Equal(time.Date(2024, 3, 5, 10, 11, 30, 0, time.UTC)))
+
+	action, ok := cast.PullRequestAction(pr.GetAction())
+
+	assert.True(t, ok)
+	assert.Equal(t, events.ActionUpdated, action)
+
+	action, ok = cast.PullRequestAction("closed")
+
+	assert.True(t, ok)
+	assert.Equal(t, events.ActionClosed, action)
+
+	_, ok = cast.PullRequestAction("label_updated")
+	assert.False(t, ok)
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	pkgerrors "go.breu.io/quantm/internal/hooks/gitea/errors"
+)
+
+type (
+	// Config holds configuration settings for the Gitea integration. Forgejo, being a fork of Gitea, is configured the
+	// same way.
+	Config struct {
+		URL           string `koanf:"URL" validate:"required,url"`         // Base URL of the instance.
+		Token         string `koanf:"TOKEN" validate:"required"`           // Access token of the bot user.
+		WebhookURL    string `koanf:"WEBHOOK_URL" validate:"required,url"` // Public URL the organization webhooks deliver to.
+		WebhookSecret string `koanf:"WEBHOOK_SECRET" validate:"required"`  // Secret for verifying webhook requests.
+	}
+
+	// ConfigOption is a function that modifies a Config.
+	ConfigOption func(*Config)
+)
+
+func (cfg *Config) Validate() error {
+	validate := validator.New()
+	return validate.Struct(cfg)
+}
+
+// Enabled reports whether the Gitea integration is configured. Gitea is optional, unlike GitHub.
+func (cfg *Config) Enabled() bool {
+	return cfg.Token != ""
+}
+
+// Start is a no-op function that satisfies the graceful Service interface.
+func (cfg *Config) Start(ctx context.Context) error {
+	slog.Info("hooks/gitea: configured", "url", cfg.URL)
+
+	return nil
+}
+
+// Stop is a no-op function that satisfies the graceful Service interface.
+func (cfg *Config) Stop(ctx context.Context) error { return nil }
+
+// SignPayload generates a signature for a given payload.
+//
+// Calculates the HMAC-SHA256 hash of the payload using the webhook secret. Unlike GitHub, Gitea sends the hex encoded
+// hash as is, without the "sha256=" prefix.
+func (cfg *Config) SignPayload(payload []byte) string {
+	key := hmac.New(sha256.New, []byte(cfg.WebhookSecret))
+	key.Write(payload)
+
+	return hex.EncodeToString(key.Sum(nil))
+}
+
+// VerifyWebhookSignature verifies the signature of a webhook payload.
+//
+// Verifies that the provided signature matches the signature generated by signing the payload with the webhook secret.
+// Returns an error if the signatures don't match.
+func (cfg *Config) VerifyWebhookSignature(payload []byte, signature string) error {
+	if !hmac.Equal([]byte(cfg.SignPayload(payload)), []byte(strings.ToLower(signature))) {
+		return pkgerrors.ErrVerifySignature
+	}
+
+	return nil
+}
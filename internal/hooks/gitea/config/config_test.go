@@ -0,0 +1,26 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/hooks/gitea/config"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.Config{WebhookSecret: "secret"}
+	payload := []byte(`{"ref":"refs/heads/main"}`)
+
+	// printf '%s' '{"ref":"refs/heads/main"}' | openssl dgst -sha256 -hmac secret
+	signature := "d8f89f0618acd61fe621aa4e64078c0e2bca15d0b578b7f3eb734f55883c5320"
+
+	assert.Equal(t, signature, cfg.SignPayload(payload))
+	assert.NoError(t, cfg.VerifyWebhookSignature(payload, signature))
+	assert.NoError(t, cfg.VerifyWebhookSignature(payload, strings.ToUpper(signature)))
+	assert.Error(t, cfg.VerifyWebhookSignature(payload, "sha256="+signature))
+	assert.Error(t, cfg.VerifyWebhookSignature([]byte(`{}`), signature))
+}
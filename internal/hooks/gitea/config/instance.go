@@ -0,0 +1,78 @@
+package config
+
+import (
+	"log/slog"
+	"sync"
+)
+
+var (
+	_c    *Config   // Global connection instance.
+	_once sync.Once // Ensures connection initialization occurs only once.
+)
+
+// WithURL sets the URL field of the Config.
+func WithURL(url string) ConfigOption {
+	return func(config *Config) {
+		config.URL = url
+	}
+}
+
+// WithToken sets the Token field of the Config.
+func WithToken(token string) ConfigOption {
+	return func(config *Config) {
+		config.Token = token
+	}
+}
+
+// WithWebhookURL sets the WebhookURL field of the Config.
+func WithWebhookURL(url string) ConfigOption {
+	return func(config *Config) {
+		config.WebhookURL = url
+	}
+}
+
+// WithWebhookSecret sets the WebhookSecret field of the Config.
+func WithWebhookSecret(secret string) ConfigOption {
+	return func(config *Config) {
+		config.WebhookSecret = secret
+	}
+}
+
+// WithConfig copies the values from the given Config into the target Config.
+func WithConfig(cfg *Config) ConfigOption {
+	return func(config *Config) {
+		config.URL = cfg.URL
+		config.Token = cfg.Token
+		config.WebhookURL = cfg.WebhookURL
+		config.WebhookSecret = cfg.WebhookSecret
+	}
+}
+
+// Configure returns the singleton instance of the Gitea configuration.
+//
+// The function uses a `sync.Once` to ensure that the configuration is initialized only once.
+func Configure(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		_c = &Config{}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
+
+func Instance(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		slog.Warn("gitea: instance not initialized, this should not happen. Make sure that the configuration is loaded before calling this function.") // nolint
+
+		_c = &Config{}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
@@ -0,0 +1,26 @@
+package defs
+
+type (
+	// Org is the organization as returned by the Gitea API.
+	Org struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullName string `json:"full_name"`
+	}
+
+	// HookConfig is the config of a webhook as expected by the Gitea API.
+	HookConfig struct {
+		URL         string `json:"url"`
+		ContentType string `json:"content_type"`
+		Secret      string `json:"secret,omitempty"`
+	}
+
+	// Hook is a webhook as expected and returned by the Gitea API.
+	Hook struct {
+		ID     int64      `json:"id,omitempty"`
+		Type   string     `json:"type"`
+		Config HookConfig `json:"config"`
+		Events []string   `json:"events"`
+		Active bool       `json:"active"`
+	}
+)
@@ -0,0 +1,13 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+)
+
+type (
+	// RequestInstall is the request to install quantm on an organization of the Gitea instance.
+	RequestInstall struct {
+		OrgID uuid.UUID `json:"org_id"`
+		Owner string    `json:"owner"` // name of the organization on the instance.
+	}
+)
@@ -0,0 +1,23 @@
+package defs
+
+type (
+	// WebhookEvent is the value of the X-Gitea-Event (or X-Forgejo-Event) header.
+	WebhookEvent string
+)
+
+const (
+	WebhookEventUnspecified WebhookEvent = ""
+	WebhookEventPush        WebhookEvent = "push"
+	WebhookEventCreate      WebhookEvent = "create"
+	WebhookEventDelete      WebhookEvent = "delete"
+	WebhookEventPullRequest WebhookEvent = "pull_request"
+	WebhookEventRepository  WebhookEvent = "repository"
+)
+
+const (
+	NoCommit = "0000000000000000000000000000000000000000" // sha of before on create, and of after on delete.
+)
+
+func (e WebhookEvent) String() string {
+	return string(e)
+}
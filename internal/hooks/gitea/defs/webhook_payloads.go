@@ -0,0 +1,174 @@
+package defs
+
+import (
+	"time"
+)
+
+// The payloads mirror the structs of modules/structs in Gitea, trimmed down to the fields quantm needs. Forgejo sends
+// the same payloads.
+
+type (
+	User struct {
+		ID       int64  `json:"id"`
+		Login    string `json:"login"`
+		FullName string `json:"full_name"`
+		Email    string `json:"email"`
+		Username string `json:"username"`
+	}
+
+	Repository struct {
+		ID            int64  `json:"id"`
+		Owner         User   `json:"owner"`
+		Name          string `json:"name"`
+		FullName      string `json:"full_name"`
+		HTMLURL       string `json:"html_url"`
+		CloneURL      string `json:"clone_url"`
+		DefaultBranch string `json:"default_branch"`
+		Private       bool   `json:"private"`
+	}
+
+	CommitUser struct {
+		Name     string `json:"name"`
+		Email    string `json:"email"`
+		Username string `json:"username"`
+	}
+
+	Commit struct {
+		ID        string     `json:"id"`
+		Message   string     `json:"message"`
+		URL       string     `json:"url"`
+		Author    CommitUser `json:"author"`
+		Committer CommitUser `json:"committer"`
+		Timestamp time.Time  `json:"timestamp"`
+		Added     []string   `json:"added"`
+		Removed   []string   `json:"removed"`
+		Modified  []string   `json:"modified"`
+	}
+
+	Label struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+
+	PRBranch struct {
+		Label  string `json:"label"`
+		Ref    string `json:"ref"`
+		Sha    string `json:"sha"`
+		RepoID int64  `json:"repo_id"`
+	}
+
+	PullRequest struct {
+		ID        int64      `json:"id"`
+		Number    int64      `json:"number"`
+		User      User       `json:"user"`
+		Title     string     `json:"title"`
+		Body      string     `json:"body"`
+		Labels    []Label    `json:"labels"`
+		State     string     `json:"state"`
+		HTMLURL   string     `json:"html_url"`
+		Merged    bool       `json:"merged"`
+		MergedAt  *time.Time `json:"merged_at"`
+		Base      PRBranch   `json:"base"`
+		Head      PRBranch   `json:"head"`
+		CreatedAt time.Time  `json:"created_at"`
+		UpdatedAt time.Time  `json:"updated_at"`
+	}
+
+	// Push is the payload of the push webhook.
+	Push struct {
+		Ref          string     `json:"ref"`
+		Before       string     `json:"before"`
+		After        string     `json:"after"`
+		CompareURL   string     `json:"compare_url"`
+		Commits      []Commit   `json:"commits"`
+		TotalCommits int64      `json:"total_commits"`
+		Repository   Repository `json:"repository"`
+		Pusher       User       `json:"pusher"`
+		Sender       User       `json:"sender"`
+	}
+
+	// WebhookRef is the payload of both the create and the delete webhooks.
+	WebhookRef struct {
+		Sha        string     `json:"sha"`
+		Ref        string     `json:"ref"`
+		RefType    string     `json:"ref_type"`
+		Repository Repository `json:"repository"`
+		Sender     User       `json:"sender"`
+	}
+
+	// PR is the payload of the pull request webhook.
+	PR struct {
+		Action      string      `json:"action"`
+		Number      int64       `json:"number"`
+		PullRequest PullRequest `json:"pull_request"`
+		Repository  Repository  `json:"repository"`
+		Sender      User        `json:"sender"`
+	}
+
+	// WebhookRepository is the payload of the repository webhook, sent to organization webhooks when a repository is
+	// created or deleted.
+	WebhookRepository struct {
+		Action       string     `json:"action"`
+		Repository   Repository `json:"repository"`
+		Organization User       `json:"organization"`
+		Sender       User       `json:"sender"`
+	}
+)
+
+// ---------------------------------- Push Event ----------------------------------.
+
+func (p *Push) GetRepositoryID() int64 {
+	return p.Repository.ID
+}
+
+func (p *Push) GetOwnerID() int64 {
+	return p.Repository.Owner.ID
+}
+
+func (p *Push) GetRepositoryName() string {
+	return p.Repository.Name
+}
+
+func (p *Push) GetPusherEmail() string {
+	return p.Pusher.Email
+}
+
+// ---------------------------------- Ref Event ----------------------------------.
+
+func (r *WebhookRef) GetRepositoryID() int64 {
+	return r.Repository.ID
+}
+
+func (r *WebhookRef) GetOwnerID() int64 {
+	return r.Repository.Owner.ID
+}
+
+// ---------------------------------- Pull Request Event ----------------------------------.
+
+func (pr *PR) GetAction() string {
+	return pr.Action
+}
+
+func (pr *PR) GetNumber() int64 {
+	return pr.Number
+}
+
+func (pr *PR) GetHeadBranch() string {
+	return pr.PullRequest.Head.Ref
+}
+
+func (pr *PR) GetBaseBranch() string {
+	return pr.PullRequest.Base.Ref
+}
+
+func (pr *PR) GetRepositoryID() int64 {
+	return pr.Repository.ID
+}
+
+func (pr *PR) GetOwnerID() int64 {
+	return pr.Repository.Owner.ID
+}
+
+func (pr *PR) GetSenderEmail() string {
+	return pr.Sender.Email
+}
@@ -0,0 +1,49 @@
+package defs
+
+import (
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/utils"
+)
+
+// NewInstallWorkflowOptions standardize the workflow options for Install Workflow.
+//
+//	io.ctrlplane.hooks.gitea.install.${org_id}.${owner}
+func NewInstallWorkflowOptions(org_id, owner string) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("gitea"),
+		durable.WithSubject("install"),
+		durable.WithSubjectID(org_id),
+		durable.WithScope(owner),
+	)
+}
+
+// NewSyncReposWorkflowOptions standardize the workflow options for SyncRepos Workflow.
+//
+//	io.ctrlplane.hooks.gitea.install.${owner_id}.sync-repos.${action}.${event_id}
+func NewSyncReposWorkflowOptions(owner_id int64, action, event_id string) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("gitea"),
+		durable.WithSubject("install"),
+		durable.WithSubjectID(utils.Int64ToString(owner_id)),
+		durable.WithScope("sync-repos"),
+		durable.WithAction(action),
+		durable.WithActionID(event_id),
+	)
+}
+
+// NewRefWorkflowOptions generates a workflow ID for the Gitea repository events based on a Git ref, a specified scope,
+// and action. It mirrors the workflow IDs of the GitHub hook.
+//
+//	io.ctrlplane.hooks.gitea.repo.${repo_id}.${ref}.${scope}.${scope_id}.${action}.${event_id}
+func NewRefWorkflowOptions(repo_id int64, ref, scope, scope_id, action, event_id string) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("gitea"),
+		durable.WithSubject("repo"),
+		durable.WithSubjectID(utils.Int64ToString(repo_id)),
+		durable.WithKind(ref),
+		durable.WithScope(scope),
+		durable.WithScopeID(scope_id),
+		durable.WithAction(action),
+		durable.WithActionID(event_id),
+	)
+}
@@ -0,0 +1,13 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	ErrMissingHeaderGiteaEvent     = errors.New("missing X-Gitea-Event Header")
+	ErrMissingHeaderGiteaSignature = errors.New("missing X-Gitea-Signature Header")
+	ErrVerifySignature             = errors.New("HMAC verification failed")
+	ErrUnexpectedStatus            = errors.New("unexpected status from gitea")
+	ErrInstalledByOtherOrg         = errors.New("organization is installed by another org")
+)
@@ -0,0 +1,149 @@
+package fns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	"go.breu.io/quantm/internal/hooks/gitea/errors"
+)
+
+type (
+	// Client is a minimal client for the Gitea REST API, covering only what the hook needs. Forgejo serves the same API.
+	Client struct {
+		base  string
+		token string
+		http  *http.Client
+	}
+)
+
+const (
+	per_page = 50 // default MAX_RESPONSE_ITEMS of gitea.
+)
+
+// GetOrg fetches the organization by its name.
+func (c *Client) GetOrg(ctx context.Context, name string) (*defs.Org, error) {
+	org := &defs.Org{}
+
+	if err := c.do(ctx, http.MethodGet, "orgs/"+url.PathEscape(name), nil, nil, org); err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// ListOrgRepos lists all the repositories of the organization.
+func (c *Client) ListOrgRepos(ctx context.Context, name string) ([]defs.Repository, error) {
+	repos := make([]defs.Repository, 0)
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(per_page))
+		query.Set("page", strconv.Itoa(page))
+
+		batch := make([]defs.Repository, 0)
+
+		if err := c.do(ctx, http.MethodGet, "orgs/"+url.PathEscape(name)+"/repos", query, nil, &batch); err != nil {
+			return nil, err
+		}
+
+		repos = append(repos, batch...)
+
+		if len(batch) < per_page {
+			return repos, nil
+		}
+	}
+}
+
+// AddOrgHook adds the webhook to the organization. Organization webhooks receive the events of all the repositories
+// of the organization, including the ones created later.
+func (c *Client) AddOrgHook(ctx context.Context, name string, hook *defs.Hook) (*defs.Hook, error) {
+	result := &defs.Hook{}
+
+	if err := c.do(ctx, http.MethodPost, "orgs/"+url.PathEscape(name)+"/hooks", nil, hook, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// do sends the request to the api and decodes the response into result.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, result any) error {
+	endpoint, err := url.JoinPath(c.base, "api", "v1")
+	if err != nil {
+		return err
+	}
+
+	endpoint += "/" + path
+
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", "token "+c.token)
+	req.Header.Set("Accept", "application/json")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s %s: %s", errors.ErrUnexpectedStatus, method, path, res.Status)
+	}
+
+	if result != nil {
+		if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewClient creates a client for the Gitea instance at base, authenticating with the given access token.
+func NewClient(base, token string) *Client {
+	return &Client{base: base, token: token, http: http.DefaultClient}
+}
+
+// TokenizedCloneUrl returns the clone url of the repository, authenticating with the given access token. Gitea accepts
+// the access token as the password for any username.
+func TokenizedCloneUrl(base, full_name, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	u = u.JoinPath(full_name + ".git")
+	u.User = url.UserPassword("quantm", token)
+
+	return u.String(), nil
+}
@@ -0,0 +1,45 @@
+package nomad
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	"go.breu.io/quantm/internal/hooks/gitea/workflows"
+	giteav1 "go.breu.io/quantm/internal/proto/hooks/gitea/v1"
+	"go.breu.io/quantm/internal/proto/hooks/gitea/v1/giteav1connect"
+)
+
+type (
+	GiteaService struct {
+		giteav1connect.UnimplementedGiteaServiceHandler
+	}
+)
+
+func (s *GiteaService) Install(
+	ctx context.Context, req *connect.Request[giteav1.InstallRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	org_id, err := uuid.Parse(req.Msg.OrgId)
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksGiteaModule).WithReason("invalid org id").Wrap(err)
+	}
+
+	opts := defs.NewInstallWorkflowOptions(org_id.String(), req.Msg.Owner)
+	args := &defs.RequestInstall{OrgID: org_id, Owner: req.Msg.Owner}
+
+	if _, err := durable.OnHooks().ExecuteWorkflow(ctx, opts, workflows.GiteaInstall, args); err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksGiteaModule).WithReason("unable to start install").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func NewGiteaServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return giteav1connect.NewGiteaServiceHandler(&GiteaService{}, opts...)
+}
@@ -0,0 +1,183 @@
+package web
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/gitea/config"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	"go.breu.io/quantm/internal/hooks/gitea/workflows"
+	"go.breu.io/quantm/internal/utils"
+)
+
+type (
+	// Webhook is a Gitea Webhook event receiver responsible for scheduling transient workflows.
+	//
+	// Transient workflows gather the necessary context to formulate QuantmEvents, package them,
+	// and then dispatch them to the appropriate workflow within the Quantm core for processing.
+	Webhook struct{}
+
+	// WebhookEventHandler is a function that handles Gitea Webhook events.
+	WebhookEventHandler func(ctx echo.Context, event defs.WebhookEvent, id string) error
+
+	// WebhookEventHandlers is a map of Gitea Webhook event names to their handlers.
+	WebhookEventHandlers map[defs.WebhookEvent]WebhookEventHandler
+)
+
+// Handler handles Gitea Webhook events. Forgejo sends its own X-Forgejo-* headers next to the X-Gitea-* headers, so
+// either of them is accepted.
+func (h *Webhook) Handler(ctx echo.Context) error {
+	// Get the signature from the request header. If the signature is missing, return an unauthorized error.
+	signature := h.header(ctx, "Signature")
+	if signature == "" {
+		return erratic.NewFailedPreconditionError(erratic.HooksGiteaModule).WithReason("missing X-Gitea-Signature header")
+	}
+
+	// Read the request body and then reset it for subsequent use.
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return erratic.NewSystemError(erratic.HooksGiteaModule).WithReason("failed to read request body").Wrap(err)
+	}
+
+	ctx.Request().Body = io.NopCloser(bytes.NewBuffer(body))
+
+	// Verify the signature. Return an unauthorized error if the signature is invalid.
+	if err := config.Instance().VerifyWebhookSignature(body, signature); err != nil {
+		return erratic.NewAuthzError(erratic.HooksGiteaModule).WithReason("invalid webhook signature").Wrap(err)
+	}
+
+	// Get the event type from the request header.
+	event := defs.WebhookEvent(h.header(ctx, "Event"))
+	if event == defs.WebhookEventUnspecified {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	// Get the event handler for the event type. If the event handler is not found, ignore the event.
+	fn, found := h.on(event)
+	if !found {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	id := h.header(ctx, "Delivery")
+
+	// Execute the event handler.
+	if err := fn(ctx, event, id); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// on returns the event handler for the given event type.
+func (h *Webhook) on(event defs.WebhookEvent) (WebhookEventHandler, bool) {
+	handlers := WebhookEventHandlers{
+		defs.WebhookEventCreate:      h.ref,
+		defs.WebhookEventDelete:      h.ref,
+		defs.WebhookEventPush:        h.push,
+		defs.WebhookEventPullRequest: h.pr,
+		defs.WebhookEventRepository:  h.repository,
+	}
+
+	fn, ok := handlers[event]
+
+	return fn, ok
+}
+
+// ref handles the create and delete events.
+func (h *Webhook) ref(ctx echo.Context, event defs.WebhookEvent, id string) error {
+	payload := &defs.WebhookRef{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGiteaModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	if payload.RefType != "branch" {
+		return nil
+	}
+
+	opts := defs.NewRefWorkflowOptions(payload.GetRepositoryID(), payload.Ref, payload.RefType, "", event.String(), id)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GiteaRef, payload, event)
+	if err != nil {
+		return erratic.NewSystemError(erratic.HooksGiteaModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// push handles the push event.
+func (h *Webhook) push(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.Push{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGiteaModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	if payload.After == defs.NoCommit {
+		return nil
+	}
+
+	opts := defs.NewRefWorkflowOptions(payload.GetRepositoryID(), payload.Ref, "push", payload.After, "created", id)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GiteaPush, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGiteaModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// pr handles the pull request event.
+func (h *Webhook) pr(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.PR{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGiteaModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	opts := defs.NewRefWorkflowOptions(
+		payload.GetRepositoryID(), payload.GetHeadBranch(), "pr", utils.Int64ToString(payload.GetNumber()), payload.GetAction(), id,
+	)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GiteaPullRequest, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGiteaModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// repository handles the repository event, sent to organization webhooks when a repository is created or deleted.
+func (h *Webhook) repository(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.WebhookRepository{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGiteaModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	opts := defs.NewSyncReposWorkflowOptions(payload.Organization.ID, payload.Action, id)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GiteaSyncRepos, payload)
+	if err != nil {
+		return erratic.NewSystemError(erratic.HooksGiteaModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// header returns the value of the X-Gitea-${name} header, falling back to X-Forgejo-${name}.
+func (h *Webhook) header(ctx echo.Context, name string) string {
+	if value := ctx.Request().Header.Get("X-Gitea-" + name); value != "" {
+		return value
+	}
+
+	return ctx.Request().Header.Get("X-Forgejo-" + name)
+}
@@ -0,0 +1,52 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitea/activities"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+)
+
+// GiteaInstall installs quantm on an organization of the Gitea instance. The organization is resolved with the token
+// of the configured bot user, the installation is recorded, the organization webhook is added, and every repository
+// of the organization is added as a repo. Repositories created later are synced by GiteaSyncRepos.
+func GiteaInstall(ctx workflow.Context, request *defs.RequestInstall) error {
+	acts := &activities.Install{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	org := &defs.Org{}
+	if err := workflow.ExecuteActivity(ctx, acts.GetGiteaOrg, request).Get(ctx, org); err != nil {
+		return err
+	}
+
+	install := &entities.GiteaInstallation{}
+	payload := &defs.InstallationPayload{Request: request, Org: org}
+
+	if err := workflow.ExecuteActivity(ctx, acts.GetOrCreateGiteaInstallation, payload).Get(ctx, install); err != nil {
+		return err
+	}
+
+	if err := workflow.ExecuteActivity(ctx, acts.AddGiteaOrgHook, install).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	repos := make([]defs.Repository, 0)
+	if err := workflow.ExecuteActivity(ctx, acts.ListGiteaOrgRepos, install).Get(ctx, &repos); err != nil {
+		return err
+	}
+
+	selector := workflow.NewSelector(ctx)
+
+	for _, repo := range repos {
+		payload := &defs.SyncRepoPayload{InstallationID: install.ID, OrgID: install.OrgID, Repo: repo}
+		selector.AddFuture(workflow.ExecuteActivity(ctx, acts.AddGiteaRepoToInstall, payload), func(f workflow.Future) {})
+	}
+
+	for range repos {
+		selector.Select(ctx)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitea/activities"
+	"go.breu.io/quantm/internal/hooks/gitea/cast"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The GiteaPullRequest workflow processes Gitea webhook pull request events. It hydrates the event with repository,
+// user, and team metadata, then converts the defs.PR payload into a QuantmEvent and signals the repository.
+func GiteaPullRequest(ctx workflow.Context, pr *defs.PR) error {
+	acts := &activities.PullRequest{}
+	hydrated := &defs.HydratedRepoEvent{}
+
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	action, ok := cast.PullRequestAction(pr.GetAction())
+	if !ok {
+		return nil
+	}
+
+	payload := &defs.HydratedRepoEventPayload{
+		OwnerID: pr.GetOwnerID(),
+		RepoID:  pr.GetRepositoryID(),
+		Email:   pr.GetSenderEmail(),
+		Branch:  pr.GetHeadBranch(),
+	}
+
+	if err := workflow.ExecuteActivity(ctx, acts.HydrateGiteaPREvent, payload).Get(ctx, hydrated); err != nil {
+		return err
+	}
+
+	proto := cast.PullRequestToProto(pr)
+	event := new_event(hydrated, events.ScopePr, action, &proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.PullRequest]{Event: event, Meta: hydrated, Signal: repos.SignalPullRequest}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGiteaPR, hevent).Get(ctx, nil)
+}
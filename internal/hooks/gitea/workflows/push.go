@@ -0,0 +1,49 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitea/activities"
+	"go.breu.io/quantm/internal/hooks/gitea/cast"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The GiteaPush workflow processes Gitea webhook push events, converting the defs.Push payload into a QuantmEvent.
+// This involves hydrating the event with repository, user, and team metadata, constructing and persisting a
+// QuantmEvent encompassing the hydrated details and original payload, and finally signaling the repository.
+//
+// Gitea does not report force pushes, so the action is always created.
+func GiteaPush(ctx workflow.Context, push *defs.Push) error {
+	acts := &activities.Push{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	proto := cast.PushToProto(push)
+	hre := &defs.HydratedRepoEvent{} // hre -> hydrated repo event
+
+	{
+		payload := &defs.HydratedRepoEventPayload{
+			OwnerID: push.GetOwnerID(),
+			RepoID:  push.GetRepositoryID(),
+			Email:   push.GetPusherEmail(),
+			Branch:  repos.BranchNameFromRef(push.Ref),
+		}
+		if err := workflow.ExecuteActivity(ctx, acts.HydrateGiteaPushEvent, payload).Get(ctx, hre); err != nil {
+			return err
+		}
+	}
+
+	event := new_event(hre, events.ScopePush, events.ActionCreated, &proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.Push]{Event: event, Meta: hre, Signal: repos.SignalPush}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGiteaPush, hevent).Get(ctx, nil)
+}
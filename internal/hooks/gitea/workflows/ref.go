@@ -0,0 +1,55 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitea/activities"
+	"go.breu.io/quantm/internal/hooks/gitea/cast"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The GiteaRef workflow processes Gitea webhook create and delete events for branches, converting the defs.WebhookRef
+// payload into a QuantmEvent, persisting it, and finally signaling the repository.
+func GiteaRef(ctx workflow.Context, payload *defs.WebhookRef, event defs.WebhookEvent) error {
+	acts := &activities.Ref{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+	logger := workflow.GetLogger(ctx)
+
+	if payload.RefType != "branch" {
+		logger.Warn("ref: unhandled ref event", "type", payload.RefType)
+		return nil
+	}
+
+	proto := cast.RefToProto(payload)
+	meta := &defs.HydratedRepoEvent{}
+
+	{
+		payload := &defs.HydratedRepoEventPayload{
+			OwnerID: payload.GetOwnerID(),
+			RepoID:  payload.GetRepositoryID(),
+		}
+		if err := workflow.ExecuteActivity(ctx, acts.HydrateGiteaRefEvent, payload).Get(ctx, meta); err != nil {
+			return err
+		}
+	}
+
+	action := events.ActionCreated
+	if event == defs.WebhookEventDelete {
+		action = events.ActionDeleted
+	}
+
+	evt := new_event(meta, events.ScopeBranch, action, &proto)
+
+	if err := pulse.Persist(ctx, evt); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.GitRef]{Event: evt, Meta: meta, Signal: repos.SignalRef}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGiteaRef, hevent).Get(ctx, nil)
+}
@@ -0,0 +1,40 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitea/activities"
+	"go.breu.io/quantm/internal/hooks/gitea/defs"
+)
+
+// GiteaSyncRepos synchronizes a repository created in or deleted from an installed organization. Organizations that
+// are not installed are ignored.
+func GiteaSyncRepos(ctx workflow.Context, payload *defs.WebhookRepository) error {
+	acts := &activities.InstallRepos{}
+	install := &entities.GiteaInstallation{}
+
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	if err := workflow.
+		ExecuteActivity(ctx, acts.GetGiteaInstallationForSync, payload.Organization.ID).
+		Get(ctx, &install); err != nil {
+		return err
+	}
+
+	if install == nil {
+		return nil
+	}
+
+	sync := &defs.SyncRepoPayload{InstallationID: install.ID, OrgID: install.OrgID, Repo: payload.Repository}
+
+	switch payload.Action {
+	case "created":
+		return workflow.ExecuteActivity(ctx, acts.GiteaRepoAdded, sync).Get(ctx, nil)
+	case "deleted":
+		return workflow.ExecuteActivity(ctx, acts.GiteaRepoRemoved, sync).Get(ctx, nil)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	gh "github.com/google/go-github/v62/github"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/hooks/github/config"
+	"go.breu.io/quantm/internal/hooks/github/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Command groups the activities required for the commands given on pull requests.
+	Command struct{}
+)
+
+var (
+	// command_permissions are the permissions on the repo allowed to give commands.
+	command_permissions = []string{"admin", "write"}
+)
+
+func (c *Command) HydrateGithubCommandEvent(
+	ctx context.Context, params *defs.HydratedRepoEventPayload,
+) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+// ResolveGithubCommand looks up the head of the pull request and of the default branch the command is given against.
+func (c *Command) ResolveGithubCommand(ctx context.Context, payload *defs.CommandPayload) (*defs.CommandTarget, error) {
+	client, err := config.Instance().GetClientForInstallationID(payload.InstallationID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, name, _ := strings.Cut(payload.RepoFullName, "/")
+
+	pr, _, err := client.PullRequests.Get(ctx, owner, name, int(payload.Number))
+	if err != nil {
+		return nil, err
+	}
+
+	trunk, _, err := client.Repositories.GetBranch(ctx, owner, name, pr.GetBase().GetRepo().GetDefaultBranch(), 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &defs.CommandTarget{
+		Branch: pr.GetHead().GetRef(),
+		Sha:    pr.GetHead().GetSHA(),
+		Trunk:  trunk.GetCommit().GetSHA(),
+	}, nil
+}
+
+// AuthorizeGithubCommand checks that the sender is linked to a quantm user of the organization owning the repo, and
+// has write access to the repo on GitHub. A denial is not an error, the reason is replied to the sender instead.
+func (c *Command) AuthorizeGithubCommand(ctx context.Context, payload *defs.CommandPayload) (*defs.CommandAuthz, error) {
+	linked, err := db.Queries().GetGithubUserByGithubID(ctx, payload.SenderID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &defs.CommandAuthz{Reason: fmt.Sprintf("@%s is not linked to a quantm user.", payload.Login)}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := db.Queries().GetUserByID(ctx, linked.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.OrgID != payload.OrgID {
+		return &defs.CommandAuthz{Reason: fmt.Sprintf("@%s is not a member of the organization owning the repo.", payload.Login)}, nil
+	}
+
+	client, err := config.Instance().GetClientForInstallationID(payload.InstallationID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, name, _ := strings.Cut(payload.RepoFullName, "/")
+
+	level, _, err := client.Repositories.GetPermissionLevel(ctx, owner, name, payload.Login)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(command_permissions, level.GetPermission()) {
+		return &defs.CommandAuthz{Reason: fmt.Sprintf("@%s needs write access to the repo.", payload.Login)}, nil
+	}
+
+	return &defs.CommandAuthz{Allowed: true, UserID: user.ID}, nil
+}
+
+// ReplyToGithubCommand reacts on the comment holding the command, and posts the reply if there is one.
+func (c *Command) ReplyToGithubCommand(ctx context.Context, payload *defs.CommandReplyPayload) error {
+	client, err := config.Instance().GetClientForInstallationID(payload.Command.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	owner, name, _ := strings.Cut(payload.Command.RepoFullName, "/")
+
+	if payload.Reaction != "" {
+		_, _, err = client.Reactions.CreateIssueCommentReaction(ctx, owner, name, payload.Command.CommentID, payload.Reaction)
+		if err != nil {
+			return err
+		}
+	}
+
+	if payload.Body == "" {
+		return nil
+	}
+
+	_, _, err = client.Issues.CreateComment(ctx, owner, name, int(payload.Command.Number), &gh.IssueComment{Body: &payload.Body})
+
+	return err
+}
+
+func (c *Command) SignalRepoWithGithubCommand(
+	ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.Command],
+) error {
+	return SignalRepo(ctx, hydrated)
+}
@@ -2,20 +2,42 @@ package activities
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	ghi "github.com/bradleyfalzon/ghinstallation/v2"
+	gh "github.com/google/go-github/v62/github"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 
+	"go.breu.io/quantm/internal/core/kernel"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/github/cast"
 	"go.breu.io/quantm/internal/hooks/github/config"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
 type (
+	// Kernel implements kernel.Repo for GitHub, acting on behalf of the installation of the repository.
 	Kernel struct{}
+
+	// remote is a github client for the installation of a repository, along with the owner and the name of the repo.
+	remote struct {
+		client *gh.Client
+		owner  string
+		name   string
+	}
+)
+
+const (
+	per_page        = 100
+	max_annotations = 50 // GitHub accepts at most 50 annotations per request, the rest are appended by updates.
 )
 
 func (k *Kernel) TokenizedCloneUrl(ctx context.Context, repo *entities.Repo) (string, error) {
@@ -45,3 +67,369 @@ func (k *Kernel) TokenizedCloneUrl(ctx context.Context, repo *entities.Repo) (st
 func (k *Kernel) DetectChanges(ctx context.Context, event *events.Event[eventsv1.RepoHook, eventsv1.Push]) error {
 	return nil
 }
+
+func (k *Kernel) CreateRef(ctx context.Context, repo *entities.Repo, ref, sha string) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = r.client.Git.CreateRef(ctx, r.owner, r.name, &gh.Reference{Ref: &ref, Object: &gh.GitObject{SHA: &sha}})
+
+	return err
+}
+
+func (k *Kernel) UpdateRef(ctx context.Context, repo *entities.Repo, ref, sha string, force bool) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = r.client.Git.UpdateRef(ctx, r.owner, r.name, &gh.Reference{Ref: &ref, Object: &gh.GitObject{SHA: &sha}}, force)
+
+	return err
+}
+
+func (k *Kernel) DeleteRef(ctx context.Context, repo *entities.Repo, ref string) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.Git.DeleteRef(ctx, r.owner, r.name, ref)
+
+	return err
+}
+
+func (k *Kernel) ListBranches(ctx context.Context, repo *entities.Repo) ([]string, error) {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]string, 0)
+	opts := &gh.BranchListOptions{ListOptions: gh.ListOptions{PerPage: per_page}}
+
+	for {
+		page, response, err := r.client.Repositories.ListBranches(ctx, r.owner, r.name, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, branch := range page {
+			branches = append(branches, branch.GetName())
+		}
+
+		if response.NextPage == 0 {
+			return branches, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func (k *Kernel) OpenPullRequest(ctx context.Context, repo *entities.Repo, opts *kernel.PullRequestOptions) (*eventsv1.PullRequest, error) {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	create := &gh.NewPullRequest{Title: &opts.Title, Body: &opts.Body, Head: &opts.Head, Base: &opts.Base, Draft: &opts.Draft}
+
+	pr, _, err := r.client.PullRequests.Create(ctx, r.owner, r.name, create)
+	if err != nil {
+		return nil, err
+	}
+
+	return cast.GithubPullRequestToProto(pr), nil
+}
+
+func (k *Kernel) UpdatePullRequest(
+	ctx context.Context, repo *entities.Repo, number int64, opts *kernel.PullRequestOptions,
+) (*eventsv1.PullRequest, error) {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	edit := &gh.PullRequest{}
+
+	if opts.Title != "" {
+		edit.Title = &opts.Title
+	}
+
+	if opts.Body != "" {
+		edit.Body = &opts.Body
+	}
+
+	if opts.Base != "" {
+		edit.Base = &gh.PullRequestBranch{Ref: &opts.Base}
+	}
+
+	pr, _, err := r.client.PullRequests.Edit(ctx, r.owner, r.name, int(number), edit)
+	if err != nil {
+		return nil, err
+	}
+
+	return cast.GithubPullRequestToProto(pr), nil
+}
+
+func (k *Kernel) MergePullRequest(ctx context.Context, repo *entities.Repo, number int64, opts *kernel.MergeOptions) (string, error) {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return "", err
+	}
+
+	merge := &gh.PullRequestOptions{CommitTitle: opts.Title, SHA: opts.Sha, MergeMethod: string(opts.Method)}
+
+	result, _, err := r.client.PullRequests.Merge(ctx, r.owner, r.name, int(number), opts.Message, merge)
+	if err != nil {
+		return "", err
+	}
+
+	return result.GetSHA(), nil
+}
+
+func (k *Kernel) ListOpenPullRequests(ctx context.Context, repo *entities.Repo) ([]*eventsv1.PullRequest, error) {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*eventsv1.PullRequest, 0)
+	opts := &gh.PullRequestListOptions{State: "open", ListOptions: gh.ListOptions{PerPage: per_page}}
+
+	for {
+		page, response, err := r.client.PullRequests.List(ctx, r.owner, r.name, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pr := range page {
+			prs = append(prs, cast.GithubPullRequestToProto(pr))
+		}
+
+		if response.NextPage == 0 {
+			return prs, nil
+		}
+
+		opts.Page = response.NextPage
+	}
+}
+
+func (k *Kernel) AddLabels(ctx context.Context, repo *entities.Repo, number int64, labels ...string) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = r.client.Issues.AddLabelsToIssue(ctx, r.owner, r.name, int(number), labels)
+
+	return err
+}
+
+func (k *Kernel) RemoveLabel(ctx context.Context, repo *entities.Repo, number int64, label string) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.Issues.RemoveLabelForIssue(ctx, r.owner, r.name, int(number), label)
+
+	return err
+}
+
+func (k *Kernel) CreateComment(ctx context.Context, repo *entities.Repo, number int64, body string) (int64, error) {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return 0, err
+	}
+
+	comment, _, err := r.client.Issues.CreateComment(ctx, r.owner, r.name, int(number), &gh.IssueComment{Body: &body})
+	if err != nil {
+		return 0, err
+	}
+
+	return comment.GetID(), nil
+}
+
+func (k *Kernel) UpdateComment(ctx context.Context, repo *entities.Repo, id int64, body string) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	_, res, err := r.client.Issues.EditComment(ctx, r.owner, r.name, id, &gh.IssueComment{Body: &body})
+	if res != nil && res.StatusCode == http.StatusNotFound {
+		return kernel.ErrNotFound
+	}
+
+	return err
+}
+
+func (k *Kernel) SetCommitStatus(ctx context.Context, repo *entities.Repo, status *kernel.CommitStatus) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	state := string(status.State)
+	create := &gh.RepoStatus{State: &state, Context: &status.Name, Description: &status.Description}
+
+	if status.TargetUrl != "" {
+		create.TargetURL = &status.TargetUrl
+	}
+
+	_, _, err = r.client.Repositories.CreateStatus(ctx, r.owner, r.name, status.Sha, create)
+
+	return err
+}
+
+// SetCheckRun updates the latest check run of the app with the same name on the commit in place, e.g. as a pull
+// request moves up the merge queue. GitHub keeps the annotations of every update, so a report carrying annotations, or
+// replacing a check run that has some, is a new check run instead. Annotations beyond the first batch are appended with
+// further updates of the check run.
+func (k *Kernel) SetCheckRun(ctx context.Context, repo *entities.Repo, check *kernel.CheckRun) error {
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return err
+	}
+
+	batches := k.annotations(check.Annotations)
+	output := &gh.CheckRunOutput{Title: &check.Title, Summary: &check.Summary, Annotations: batches[0]}
+
+	if check.Text != "" {
+		output.Text = &check.Text
+	}
+
+	update := gh.UpdateCheckRunOptions{Name: check.Name, Status: gh.String(string(check.Status)), Output: output}
+
+	if check.DetailsUrl != "" {
+		update.DetailsURL = &check.DetailsUrl
+	}
+
+	if check.Status == kernel.CheckStatusCompleted {
+		update.Conclusion = gh.String(string(check.Conclusion))
+		update.CompletedAt = &gh.Timestamp{Time: time.Now()}
+	}
+
+	var id int64
+
+	if len(check.Annotations) == 0 {
+		opts := &gh.ListCheckRunsOptions{CheckName: &check.Name, Filter: gh.String("latest"), AppID: gh.Int64(config.Instance().AppID)}
+
+		existing, _, err := r.client.Checks.ListCheckRunsForRef(ctx, r.owner, r.name, check.Sha, opts)
+		if err != nil {
+			return err
+		}
+
+		if len(existing.CheckRuns) > 0 && existing.CheckRuns[0].GetOutput().GetAnnotationsCount() == 0 {
+			id = existing.CheckRuns[0].GetID()
+
+			if _, _, err := r.client.Checks.UpdateCheckRun(ctx, r.owner, r.name, id, update); err != nil {
+				return err
+			}
+		}
+	}
+
+	if id == 0 {
+		create := gh.CreateCheckRunOptions{
+			Name:        update.Name,
+			HeadSHA:     check.Sha,
+			DetailsURL:  update.DetailsURL,
+			Status:      update.Status,
+			Conclusion:  update.Conclusion,
+			CompletedAt: update.CompletedAt,
+			Output:      output,
+		}
+
+		created, _, err := r.client.Checks.CreateCheckRun(ctx, r.owner, r.name, create)
+		if err != nil {
+			return err
+		}
+
+		id = created.GetID()
+	}
+
+	for _, batch := range batches[1:] {
+		more := gh.UpdateCheckRunOptions{Name: check.Name, Output: &gh.CheckRunOutput{Title: &check.Title, Summary: &check.Summary}}
+		more.Output.Annotations = batch
+
+		if _, _, err := r.client.Checks.UpdateCheckRun(ctx, r.owner, r.name, id, more); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CanWrite checks the permission of the GitHub account linked to the user on the repository, as for the commands given
+// on the pull requests.
+func (k *Kernel) CanWrite(ctx context.Context, repo *entities.Repo, user_id uuid.UUID) (bool, error) {
+	linked, err := db.Queries().GetGithubUserByUserID(ctx, user_id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	r, err := k.remote(ctx, repo)
+	if err != nil {
+		return false, err
+	}
+
+	level, _, err := r.client.Repositories.GetPermissionLevel(ctx, r.owner, r.name, linked.Login)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(command_permissions, level.GetPermission()), nil
+}
+
+// annotations converts the annotations into batches GitHub accepts. There is always at least one, possibly empty, batch.
+func (k *Kernel) annotations(annotations []*kernel.CheckAnnotation) [][]*gh.CheckRunAnnotation {
+	batches := [][]*gh.CheckRunAnnotation{{}}
+
+	for _, annotation := range annotations {
+		last := len(batches) - 1
+		if len(batches[last]) == max_annotations {
+			batches = append(batches, []*gh.CheckRunAnnotation{})
+			last++
+		}
+
+		batches[last] = append(batches[last], &gh.CheckRunAnnotation{
+			Path:            gh.String(annotation.Path),
+			StartLine:       gh.Int(annotation.StartLine),
+			EndLine:         gh.Int(annotation.EndLine),
+			AnnotationLevel: gh.String(string(annotation.Level)),
+			Title:           gh.String(annotation.Title),
+			Message:         gh.String(annotation.Message),
+		})
+	}
+
+	return batches
+}
+
+// remote returns the client for the installation of the repository.
+func (k *Kernel) remote(ctx context.Context, repo *entities.Repo) (*remote, error) {
+	ghrepo, err := db.Queries().GetGithubRepoByID(ctx, repo.HookID)
+	if err != nil {
+		return nil, err
+	}
+
+	install, err := db.Queries().GetGithubInstallation(ctx, ghrepo.InstallationID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := config.Instance().GetClientForInstallationID(install.InstallationID)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, name, _ := strings.Cut(ghrepo.FullName, "/")
+
+	return &remote{client: client, owner: owner, name: name}, nil
+}
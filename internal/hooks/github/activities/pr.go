@@ -23,3 +23,7 @@ func (p *PullRequest) SignalRepoWithGithubPR(ctx context.Context, hydrated *defs
 func (p *PullRequest) SignalRepoWithGithubMergeQueue(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.MergeQueue]) error {
 	return SignalRepo(ctx, hydrated)
 }
+
+func (p *PullRequest) SignalRepoWithGithubPRLabel(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.PullRequestLabel]) error {
+	return SignalRepo(ctx, hydrated)
+}
@@ -14,6 +14,7 @@ type (
 	PushActivity         = activities.Push
 	RefActivity          = activities.Ref
 	PullRequestActivity  = activities.PullRequest
+	CommandActivity      = activities.Command
 
 	KernelImpl = activities.Kernel
 
@@ -30,6 +31,7 @@ var (
 	RefWorkflow         = workflows.Ref
 	PushWorkflow        = workflows.Push
 	PullRequestWorkflow = workflows.PullRequest
+	CommandWorkflow     = workflows.PullRequestCommand
 	SyncReposWorkflow   = workflows.SyncRepos
 
 	NomadHandler = nomad.NewGithubServiceHandler
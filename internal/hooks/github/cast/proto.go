@@ -4,6 +4,7 @@ import (
 	"slices"
 	"time"
 
+	gh "github.com/google/go-github/v62/github"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.breu.io/quantm/internal/core/repos"
@@ -56,6 +57,21 @@ func PullRequestToProto(pr *defs.PR) eventsv1.PullRequest {
 		HeadBranch: pr.GetHeadBranch(),
 		BaseBranch: pr.GetBaseBranch(),
 		Timestamp:  timestamppb.New(pr.GetTimestamp()),
+		Merged:     pr.IsMerged(),
+	}
+}
+
+// GithubPullRequestToProto converts a pull request returned by the GitHub API.
+func GithubPullRequestToProto(pr *gh.PullRequest) *eventsv1.PullRequest {
+	return &eventsv1.PullRequest{
+		Number:     int64(pr.GetNumber()),
+		Title:      pr.GetTitle(),
+		Body:       pr.GetBody(),
+		Author:     pr.GetUser().GetLogin(),
+		HeadBranch: pr.GetHead().GetRef(),
+		BaseBranch: pr.GetBase().GetRef(),
+		Timestamp:  timestamppb.New(pr.GetUpdatedAt().Time),
+		Merged:     pr.GetMerged(),
 	}
 }
 
@@ -66,6 +82,7 @@ func PullRequestLabelToProto(pr *defs.PR) *eventsv1.MergeQueue {
 		proto := &eventsv1.MergeQueue{
 			Number:    pr.GetNumber(),
 			Branch:    pr.GetHeadBranch(),
+			Sha:       pr.GetHeadSha(),
 			Timestamp: timestamppb.New(pr.GetTimestamp()),
 		}
 
@@ -79,13 +96,28 @@ func PullRequestLabelToProto(pr *defs.PR) *eventsv1.MergeQueue {
 	return nil
 }
 
+// PullRequestLabelToBranchProto converts the label event to a branch label, if the label configures the branch.
+func PullRequestLabelToBranchProto(pr *defs.PR) *eventsv1.PullRequestLabel {
+	if pr.GetLabelName() != repos.LabelAutoUpdate {
+		return nil
+	}
+
+	return &eventsv1.PullRequestLabel{
+		Name:      pr.GetLabelName(),
+		Number:    pr.GetNumber(),
+		Branch:    pr.GetHeadBranch(),
+		Timestamp: timestamppb.New(pr.GetTimestamp()),
+	}
+}
+
 func PrReviewToProto(prr *defs.PrReview) eventsv1.PullRequestReview {
 	return eventsv1.PullRequestReview{
 		Id:                prr.GetPrReviewID(),
 		PullRequestNumber: prr.GetPrNumber(),
 		Branch:            prr.GetHeadBranch(),
 		State:             prr.GetState(),
-		AuthorEmail:       *prr.GetSenderEmail(),
+		AuthorEmail:       prr.GetReviewerEmail(),
+		AuthorLogin:       prr.GetReviewerLogin(),
 		SubmittedAt:       timestamppb.New(prr.GetSubmittedAt()),
 	}
 }
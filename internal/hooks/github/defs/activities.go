@@ -29,6 +29,38 @@ type (
 		ShouldFetchParent bool   `json:"should_fetch_parent"`
 	}
 
+	// CommandPayload identifies a command given in a comment on a pull request, and who gave it.
+	CommandPayload struct {
+		InstallationID int64     `json:"installation_id"`
+		RepoFullName   string    `json:"repo_full_name"`
+		Number         int64     `json:"number"`
+		CommentID      int64     `json:"comment_id"`
+		SenderID       int64     `json:"sender_id"`
+		Login          string    `json:"login"`
+		OrgID          uuid.UUID `json:"org_id"`
+	}
+
+	// CommandTarget is the pull request a command is given on, as it is when the command is given.
+	CommandTarget struct {
+		Branch string `json:"branch"`
+		Sha    string `json:"sha"`
+		Trunk  string `json:"trunk"` // head commit of the default branch.
+	}
+
+	// CommandAuthz is the outcome of authorising a command. Reason explains a denial to the user.
+	CommandAuthz struct {
+		Allowed bool      `json:"allowed"`
+		Reason  string    `json:"reason"`
+		UserID  uuid.UUID `json:"user_id"`
+	}
+
+	// CommandReplyPayload is the reply to a command, as a reaction on the comment and an optional comment.
+	CommandReplyPayload struct {
+		Command  *CommandPayload `json:"command"`
+		Reaction string          `json:"reaction"`
+		Body     string          `json:"body"`
+	}
+
 	// ChatLinks contains the possible chat_links channels for a HydratedRepoEvent.
 	ChatLinks struct {
 		Org  *entities.ChatLink `json:"org"`
@@ -44,6 +44,17 @@ type (
 		Sender       *User              `json:"sender"`
 	}
 
+	// IssueComment is the issue comment event. Comments on the conversation of a pull request are delivered as
+	// comments on the issue backing the pull request.
+	IssueComment struct {
+		Action       string         `json:"action"`
+		Issue        Issue          `json:"issue"`
+		Comment      Comment        `json:"comment"`
+		Repository   RepositoryPR   `json:"repository"`
+		Installation InstallationID `json:"installation"`
+		Sender       User           `json:"sender"`
+	}
+
 	PrReviewComment struct {
 		Action       string              `json:"action"`
 		Number       int64               `json:"number"`
@@ -117,6 +128,10 @@ func (pr *PR) GetHeadBranch() string {
 	return pr.PullRequest.Head.Ref
 }
 
+func (pr *PR) GetHeadSha() string {
+	return pr.PullRequest.Head.SHA
+}
+
 func (pr *PR) GetBaseBranch() string {
 	return pr.PullRequest.Base.Ref
 }
@@ -125,6 +140,10 @@ func (pr *PR) GetTimestamp() time.Time {
 	return pr.PullRequest.UpdatedAt
 }
 
+func (pr *PR) IsMerged() bool {
+	return pr.PullRequest.Merged
+}
+
 func (pr *PR) GetRepositoryID() int64 {
 	return pr.Repository.ID
 }
@@ -162,6 +181,24 @@ func (prr *PrReview) GetSenderEmail() *string {
 	return prr.Sender.Email
 }
 
+// GetReviewerLogin returns the login of the author of the review, who is not the sender when the review is dismissed.
+func (prr *PrReview) GetReviewerLogin() string {
+	if prr.Review == nil {
+		return ""
+	}
+
+	return prr.Review.User.Login
+}
+
+// GetReviewerEmail returns the public email of the author of the review, if any.
+func (prr *PrReview) GetReviewerEmail() string {
+	if prr.Review == nil || prr.Review.User.Email == nil {
+		return ""
+	}
+
+	return *prr.Review.User.Email
+}
+
 func (prr *PrReview) GetHeadBranch() string {
 	return prr.PullRequest.Head.Ref
 }
@@ -234,3 +271,48 @@ func (prrc *PrReviewComment) GetSenderEmail() *string {
 func (prrc *PrReviewComment) GetSubmittedAt() time.Time {
 	return prrc.Comment.CreatedAt
 }
+
+// ---------------------------------- Issue Comment Event ----------------------------------.
+func (ic *IssueComment) GetAction() string {
+	return ic.Action
+}
+
+func (ic *IssueComment) GetNumber() int64 {
+	return ic.Issue.Number
+}
+
+func (ic *IssueComment) IsPullRequest() bool {
+	return ic.Issue.PullRequest != nil
+}
+
+func (ic *IssueComment) GetCommentID() int64 {
+	return ic.Comment.ID
+}
+
+func (ic *IssueComment) GetBody() string {
+	return ic.Comment.Body
+}
+
+func (ic *IssueComment) GetRepositoryID() int64 {
+	return ic.Repository.ID
+}
+
+func (ic *IssueComment) GetRepositoryFullName() string {
+	return ic.Repository.FullName
+}
+
+func (ic *IssueComment) GetInstallationID() int64 {
+	return ic.Installation.ID
+}
+
+func (ic *IssueComment) GetSenderID() int64 {
+	return ic.Sender.ID
+}
+
+func (ic *IssueComment) GetSenderLogin() string {
+	return ic.Sender.Login
+}
+
+func (ic *IssueComment) GetTimestamp() time.Time {
+	return ic.Comment.CreatedAt
+}
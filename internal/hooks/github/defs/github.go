@@ -320,6 +320,29 @@ type (
 		MasterBranch     string    `json:"master_branch"`
 	}
 
+	// Issue is the issue an issue comment is posted on. PullRequest is set when the issue backs a pull request.
+	Issue struct {
+		ID          int64             `json:"id"`
+		Number      int64             `json:"number"`
+		Title       string            `json:"title"`
+		State       string            `json:"state"`
+		User        User              `json:"user"`
+		PullRequest *IssuePullRequest `json:"pull_request"`
+	}
+
+	IssuePullRequest struct {
+		URL     string `json:"url"`
+		HTMLURL string `json:"html_url"`
+	}
+
+	Comment struct {
+		ID        int64     `json:"id"`
+		Body      string    `json:"body"`
+		User      User      `json:"user"`
+		CreatedAt time.Time `json:"created_at"`
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
 	Label struct {
 		ID          int64  `json:"id"`
 		NodeID      string `json:"node_id"`
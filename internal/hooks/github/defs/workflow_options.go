@@ -128,3 +128,18 @@ func NewRefWorkflowOptions(repo_id int64, ref, scope, scope_id, action, event_id
 		durable.WithActionID(event_id),
 	)
 }
+
+// NewCommandWorkflowOptions standardize the workflow options for the PullRequestCommand Workflow.
+//
+//	io.ctrlplane.hooks.github.repo.${repo_id}.command.${pr_number}.${comment_id}.${event_id}
+func NewCommandWorkflowOptions(repo_id, number, comment_id int64, event_id string) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("github"),
+		durable.WithSubject("repo"),
+		durable.WithSubjectID(utils.Int64ToString(repo_id)),
+		durable.WithScope("command"),
+		durable.WithScopeID(utils.Int64ToString(number)),
+		durable.WithAction(utils.Int64ToString(comment_id)),
+		durable.WithActionID(event_id),
+	)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"go.breu.io/quantm/internal/core/repos"
 	"go.breu.io/quantm/internal/durable"
 	"go.breu.io/quantm/internal/erratic"
 	"go.breu.io/quantm/internal/hooks/github/config"
@@ -86,6 +87,7 @@ func (h *Webhook) on(event defs.WebhookEvent) (WebhookEventHandler, bool) {
 		defs.WebhookEventPullRequest:              h.pr,
 		defs.WebhookEventPullRequestReview:        h.pr_review,
 		defs.WebhookEventPullRequestReviewComment: h.pr_review_comment,
+		defs.WebhookEventIssueComment:             h.issue_comment,
 	}
 
 	fn, ok := handlers[event]
@@ -273,3 +275,33 @@ func (h *Webhook) pr_review_comment(ctx echo.Context, event defs.WebhookEvent, i
 
 	return ctx.NoContent(http.StatusNoContent)
 }
+
+// issue_comment handles the issue comment event. Only new comments on pull requests holding a quantm command are
+// processed, everything else is ignored.
+func (h *Webhook) issue_comment(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.IssueComment{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGithubModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	if payload.GetAction() != "created" || !payload.IsPullRequest() || payload.Sender.Type == "Bot" {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	if command, err := repos.ParseCommand(payload.GetBody()); command == nil && err == nil {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	opts := defs.NewCommandWorkflowOptions(payload.GetRepositoryID(), payload.GetNumber(), payload.GetCommentID(), id)
+
+	_, err := durable.
+		OnHooks().
+		ExecuteWorkflow(ctx.Request().Context(), opts, workflows.PullRequestCommand, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGithubModule).Wrap(err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
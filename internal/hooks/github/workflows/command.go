@@ -0,0 +1,127 @@
+package workflows
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/github/activities"
+	"go.breu.io/quantm/internal/hooks/github/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// reactions on the comment holding a command.
+const (
+	reaction_accepted = "+1"
+	reaction_denied   = "-1"
+	reaction_invalid  = "confused"
+)
+
+// PullRequestCommand processes a command given in a comment on a pull request. The command is authorised against the
+// permissions of the sender on the repo and the quantm user the sender is linked to, then handed over to the repo as a
+// QuantmEvent. The sender is replied to with a reaction on the comment and, where useful, a comment.
+func PullRequestCommand(ctx workflow.Context, comment *defs.IssueComment) error {
+	acts := &activities.Command{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	proto, parse_err := repos.ParseCommand(comment.GetBody())
+	if proto == nil && parse_err == nil {
+		return nil
+	}
+
+	payload := &defs.CommandPayload{
+		InstallationID: comment.GetInstallationID(),
+		RepoFullName:   comment.GetRepositoryFullName(),
+		Number:         comment.GetNumber(),
+		CommentID:      comment.GetCommentID(),
+		SenderID:       comment.GetSenderID(),
+		Login:          comment.GetSenderLogin(),
+	}
+
+	if parse_err != nil {
+		body := fmt.Sprintf("%s.\n\n%s", parse_err.Error(), repos.CommandUsage())
+		return reply(ctx, payload, reaction_invalid, body)
+	}
+
+	target := &defs.CommandTarget{}
+	if err := workflow.ExecuteActivity(ctx, acts.ResolveGithubCommand, payload).Get(ctx, target); err != nil {
+		return err
+	}
+
+	hydrated := &defs.HydratedRepoEvent{}
+	{
+		payload := &defs.HydratedRepoEventPayload{
+			RepoID:         comment.GetRepositoryID(),
+			InstallationID: comment.GetInstallationID(),
+			Branch:         target.Branch,
+		}
+		if err := workflow.ExecuteActivity(ctx, acts.HydrateGithubCommandEvent, payload).Get(ctx, hydrated); err != nil {
+			return err
+		}
+	}
+
+	payload.OrgID = hydrated.GetOrgID()
+
+	authz := &defs.CommandAuthz{}
+	if err := workflow.ExecuteActivity(ctx, acts.AuthorizeGithubCommand, payload).Get(ctx, authz); err != nil {
+		return err
+	}
+
+	if !authz.Allowed {
+		return reply(ctx, payload, reaction_denied, authz.Reason)
+	}
+
+	proto.Number = comment.GetNumber()
+	proto.Branch = target.Branch
+	proto.Sha = target.Sha
+	proto.Trunk = target.Trunk
+	proto.CommentId = comment.GetCommentID()
+	proto.Author = comment.GetSenderLogin()
+	proto.Timestamp = timestamppb.New(comment.GetTimestamp())
+
+	event := events.
+		New[eventsv1.RepoHook, eventsv1.Command]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
+		SetScope(events.ScopeCommand).
+		SetAction(events.ActionRequested).
+		SetSource(hydrated.GetRepoUrl()).
+		SetOrg(hydrated.GetOrgID()).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(hydrated.GetRepoID()).
+		SetUser(authz.UserID).
+		SetPayload(proto)
+
+	if hydrated.GetParentID() != uuid.Nil {
+		event.SetParents(hydrated.GetParentID())
+	}
+
+	if hydrated.GetTeam() != nil {
+		event.SetTeam(hydrated.GetTeamID())
+	}
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.Command]{Event: event, Meta: hydrated, Signal: repos.SignalCommand}
+
+	if err := workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubCommand, hevent).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	return reply(ctx, payload, reaction_accepted, repos.CommandReply(proto))
+}
+
+// reply reacts on the comment holding the command, and posts the body as a comment if it is not empty.
+func reply(ctx workflow.Context, payload *defs.CommandPayload, reaction, body string) error {
+	acts := &activities.Command{}
+	params := &defs.CommandReplyPayload{Command: payload, Reaction: reaction, Body: body}
+
+	return workflow.ExecuteActivity(ctx, acts.ReplyToGithubCommand, params).Get(ctx, nil)
+}
@@ -84,9 +84,14 @@ func handle_pr(ctx workflow.Context, pr *defs.PR, repo_evt *defs.HydratedRepoEve
 }
 
 // handle_label processes a pull request label event, creating a QuantmEvent and signaling the merge queue, if applicable.
+// Labels configuring the branch are handled by handle_branch_label.
 func handle_label(ctx workflow.Context, pr *defs.PR, repo_evt *defs.HydratedRepoEvent) error {
 	acts := &activities.PullRequest{}
 
+	if label := cast.PullRequestLabelToBranchProto(pr); label != nil {
+		return handle_branch_label(ctx, pr, label, repo_evt)
+	}
+
 	proto := cast.PullRequestLabelToProto(pr)
 	if proto == nil {
 		return nil
@@ -131,3 +136,50 @@ func handle_label(ctx workflow.Context, pr *defs.PR, repo_evt *defs.HydratedRepo
 
 	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubMergeQueue, hevent).Get(ctx, nil)
 }
+
+// handle_branch_label processes a pull request label event that configures the head branch, creating a QuantmEvent and
+// signaling the repository.
+func handle_branch_label(
+	ctx workflow.Context, pr *defs.PR, proto *eventsv1.PullRequestLabel, repo_evt *defs.HydratedRepoEvent,
+) error {
+	acts := &activities.PullRequest{}
+
+	event := events.
+		New[eventsv1.RepoHook, eventsv1.PullRequestLabel]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_GITHUB).
+		SetScope(events.ScopePrLabel).
+		SetSource(repo_evt.GetRepoUrl()).
+		SetOrg(repo_evt.GetOrgID()).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(repo_evt.GetRepoID()).
+		SetPayload(proto)
+
+	switch pr.GetAction() {
+	case "labeled":
+		event.SetAction(events.EventActionAdded)
+	case "unlabeled":
+		event.SetAction(events.EventActionRemoved)
+	default:
+		return nil
+	}
+
+	if repo_evt.GetParentID() != uuid.Nil {
+		event.SetParents(repo_evt.GetParentID())
+	}
+
+	if repo_evt.GetTeam() != nil {
+		event.SetTeam(repo_evt.GetTeamID())
+	}
+
+	if repo_evt.GetUser() != nil {
+		event.SetUser(repo_evt.GetUserID())
+	}
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.PullRequestLabel]{Event: event, Meta: repo_evt, Signal: repos.SignalPullRequestLabel}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGithubPRLabel, hevent).Get(ctx, nil)
+}
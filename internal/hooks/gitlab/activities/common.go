@@ -0,0 +1,218 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitlab/cast"
+	"go.breu.io/quantm/internal/hooks/gitlab/config"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/gitlab/errors"
+	"go.breu.io/quantm/internal/hooks/gitlab/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// HydrateRepoEvent enriches a repository event using database data. It fetches the repository for the GitLab project
+// of the installation the webhook was verified against, optionally adding user information if an email is provided.
+// For non-default branches, it retrieves the parent event ID from the core workflow.
+func HydrateRepoEvent(ctx context.Context, payload *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	params := entities.GetRepoForGitlabParams{InstallationID: payload.Installation, GitlabID: payload.ProjectID}
+
+	row, err := db.Queries().GetRepoForGitlab(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	hydrated := cast.RepoForGitlabToHydratedRepoEvent(row)
+
+	chat_link, err := db.Queries().GetChatLink(ctx, row.Repo.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Warn("unable to get chat link, notification will not work.") // TODO: user should know.
+		} else {
+			return nil, err
+		}
+	}
+
+	hydrated.ChatLinks.Repo = &chat_link
+
+	if payload.Email != "" {
+		user, _ := db.Queries().GetUserByEmail(ctx, payload.Email)
+		hydrated.User = &user
+	}
+
+	if (payload.Branch != "" && payload.Branch != hydrated.Repo.DefaultBranch) || payload.ShouldFetchParent {
+		parent, err := durable.
+			OnCore().
+			QueryWorkflow(ctx, hydrated.RepoWorkflowOptions(), repos.QueryRepoForEventParent, payload.Branch)
+
+		if err == nil {
+			_ = parent.Get(&hydrated.ParentID)
+		}
+	}
+
+	return hydrated, nil
+}
+
+// AddRepo adds a GitLab project or activates an existing one using a database transaction. If the project is not
+// known, it creates database entries for both the GitLab and core repositories. Finally, it adds the webhook to the
+// project, or updates the webhook added before so that it carries the current secret of the installation.
+func AddRepo(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	repo, err := db.Queries().GetGitlabRepoByInstallationIDAndGitlabID(ctx, entities.GetGitlabRepoByInstallationIDAndGitlabIDParams{
+		InstallationID: payload.InstallationID,
+		GitlabID:       payload.Project.ID,
+	})
+
+	switch {
+	case err == nil:
+		if err := activate_repo(ctx, &repo); err != nil {
+			return err
+		}
+	case errors.Is(err, pgx.ErrNoRows):
+		repo, err = create_repo(ctx, payload)
+		if err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	return add_webhook(ctx, &repo)
+}
+
+// SignalRepo signals a GitLab repository event to the core workflow.
+func SignalRepo[P events.Payload](ctx context.Context, hydrated *defs.HydratedQuantmEvent[P]) error {
+	_, err := durable.OnCore().SignalWithStartWorkflow(
+		ctx,
+		hydrated.Meta.RepoWorkflowOptions(),
+		hydrated.Signal,
+		hydrated.Event,
+		repos.RepoWorkflow,
+		repos.NewRepoWorkflowState(hydrated.Meta.GetRepo(), hydrated.Meta.GetRepoChatLink()),
+	)
+
+	return err
+}
+
+// client returns the api client for the installation.
+func client(ctx context.Context, id uuid.UUID) (*fns.Client, *entities.GitlabInstallation, error) {
+	install, err := db.Queries().GetGitlabInstallation(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	token, err := auth.Reveal(install.AccessToken, install.OrgID.String())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return fns.NewClient(install.Url, token), &install, nil
+}
+
+// activate_repo activates both the GitLab and the core repository.
+func activate_repo(ctx context.Context, repo *entities.GitlabRepo) error {
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if err := qtx.ActivateGitlabRepo(ctx, repo.ID); err != nil {
+		return err
+	}
+
+	if err := qtx.ActivateRepoByHookID(ctx, repo.ID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// create_repo creates both the GitLab and the core repository.
+func create_repo(ctx context.Context, payload *defs.SyncRepoPayload) (entities.GitlabRepo, error) {
+	tx, qtx, err := db.Transaction(ctx)
+	if err != nil {
+		return entities.GitlabRepo{}, err
+	}
+
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	create := entities.CreateGitlabRepoParams{
+		InstallationID: payload.InstallationID,
+		GitlabID:       payload.Project.ID,
+		Name:           payload.Project.Name,
+		FullName:       payload.Project.PathWithNamespace,
+		Url:            payload.Project.WebURL,
+	}
+
+	created, err := qtx.CreateGitlabRepo(ctx, create)
+	if err != nil {
+		return entities.GitlabRepo{}, err
+	}
+
+	reqst := entities.CreateRepoParams{
+		OrgID:  payload.OrgID,
+		Hook:   int32(eventsv1.RepoHook_REPO_HOOK_GITLAB),
+		HookID: created.ID,
+		Name:   payload.Project.Name,
+		Url:    payload.Project.WebURL,
+	}
+
+	if _, err := qtx.CreateRepo(ctx, reqst); err != nil {
+		return entities.GitlabRepo{}, err
+	}
+
+	return created, tx.Commit(ctx)
+}
+
+// add_webhook adds the quantm webhook to the project and records its id, or updates the webhook already added.
+func add_webhook(ctx context.Context, repo *entities.GitlabRepo) error {
+	api, install, err := client(ctx, repo.InstallationID)
+	if err != nil {
+		return err
+	}
+
+	secret, err := auth.Reveal(install.WebhookSecret, install.OrgID.String())
+	if err != nil {
+		return err
+	}
+
+	hook := &defs.ProjectHook{
+		URL:                   config.Instance().WebhookURL,
+		Token:                 fns.WebhookToken(install.ID, secret),
+		PushEvents:            true,
+		TagPushEvents:         true,
+		MergeRequestsEvents:   true,
+		NoteEvents:            true,
+		PipelineEvents:        true,
+		EnableSSLVerification: true,
+	}
+
+	if repo.WebhookID != 0 {
+		if _, err := api.EditProjectHook(ctx, repo.GitlabID, repo.WebhookID, hook); err == nil {
+			return nil
+		} else if !errors.Is(err, pkgerrors.ErrNotFound) {
+			slog.Warn("gitlab: unable to update webhook", "project", repo.FullName, "error", err.Error())
+			return err
+		}
+	}
+
+	created, err := api.AddProjectHook(ctx, repo.GitlabID, hook)
+	if err != nil {
+		slog.Warn("gitlab: unable to add webhook", "project", repo.FullName, "error", err.Error())
+		return err
+	}
+
+	return db.Queries().UpdateGitlabRepoWebhookID(ctx, entities.UpdateGitlabRepoWebhookIDParams{ID: repo.ID, WebhookID: created.ID})
+}
@@ -0,0 +1,119 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/gitlab/errors"
+	"go.breu.io/quantm/internal/hooks/gitlab/fns"
+)
+
+type (
+	Install struct{}
+)
+
+// GetGitlabGroup resolves the group of the install request using the access token of the request.
+func (a *Install) GetGitlabGroup(ctx context.Context, request *defs.RequestInstall) (*defs.Group, error) {
+	token, err := auth.Reveal(request.AccessToken, request.OrgID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return fns.NewClient(request.URL, token).GetGroup(ctx, request.Group)
+}
+
+// GetOrCreateGitlabInstallation retrieves a GitLab installation from the database by the instance url and the group id.
+// If the installation does not exist, it creates a new one with the secret of its webhooks, otherwise it updates the
+// access token. A group installed by another org is rejected.
+func (a *Install) GetOrCreateGitlabInstallation(
+	ctx context.Context, payload *defs.InstallationPayload,
+) (*entities.GitlabInstallation, error) {
+	params := entities.GetGitlabInstallationByURLAndGroupIDParams{Url: payload.Request.URL, GroupID: payload.Group.ID}
+
+	install, err := db.Queries().GetGitlabInstallationByURLAndGroupID(ctx, params)
+	if err == nil {
+		if install.OrgID != payload.Request.OrgID {
+			slog.Warn("gitlab: group installed by another org", "group", payload.Group.FullPath, "org", payload.Request.OrgID)
+			return nil, pkgerrors.ErrInstalledByOtherOrg
+		}
+
+		secret := install.WebhookSecret
+		if secret == "" {
+			if secret, err = webhook_secret(payload.Request.OrgID); err != nil {
+				return nil, err
+			}
+		}
+
+		update := entities.UpdateGitlabInstallationParams{
+			ID:            install.ID,
+			GroupPath:     payload.Group.FullPath,
+			AccessToken:   payload.Request.AccessToken,
+			WebhookSecret: secret,
+			IsActive:      true,
+		}
+
+		install, err = db.Queries().UpdateGitlabInstallation(ctx, update)
+		if err != nil {
+			return nil, err
+		}
+
+		return &install, nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		secret, err := webhook_secret(payload.Request.OrgID)
+		if err != nil {
+			return nil, err
+		}
+
+		create := entities.CreateGitlabInstallationParams{
+			OrgID:         payload.Request.OrgID,
+			Url:           payload.Request.URL,
+			GroupID:       payload.Group.ID,
+			GroupPath:     payload.Group.FullPath,
+			AccessToken:   payload.Request.AccessToken,
+			WebhookSecret: secret,
+		}
+
+		install, err = db.Queries().CreateGitlabInstallation(ctx, create)
+		if err != nil {
+			return nil, err
+		}
+
+		return &install, nil
+	}
+
+	return nil, err
+}
+
+// ListGitlabGroupProjects lists all the projects of the installed group, including the projects of the subgroups.
+func (a *Install) ListGitlabGroupProjects(ctx context.Context, install *entities.GitlabInstallation) ([]defs.Project, error) {
+	api, _, err := client(ctx, install.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.ListGroupProjects(ctx, install.GroupID)
+}
+
+func (a *Install) AddGitlabRepoToInstall(ctx context.Context, payload *defs.SyncRepoPayload) error {
+	return AddRepo(ctx, payload)
+}
+
+// webhook_secret generates the secret of the webhooks of an installation, concealed with the id of the org.
+func webhook_secret(org_id uuid.UUID) (string, error) {
+	secret, err := fns.NewWebhookSecret()
+	if err != nil {
+		return "", err
+	}
+
+	return auth.Conceal(secret, org_id.String())
+}
@@ -0,0 +1,40 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitlab/fns"
+)
+
+type (
+	// Kernel implements kernel.Repo for GitLab. Only cloning is supported so far, the other actions return
+	// kernel.ErrNotSupported.
+	Kernel struct {
+		kernel.BaseRepo
+	}
+)
+
+// TokenizedCloneUrl returns the clone url of the project, authenticating with the access token of the group
+// installation the project belongs to.
+func (k *Kernel) TokenizedCloneUrl(ctx context.Context, repo *entities.Repo) (string, error) {
+	glrepo, err := db.Queries().GetGitlabRepoByID(ctx, repo.HookID)
+	if err != nil {
+		return "", err
+	}
+
+	install, err := db.Queries().GetGitlabInstallation(ctx, glrepo.InstallationID)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := auth.Reveal(install.AccessToken, install.OrgID.String())
+	if err != nil {
+		return "", err
+	}
+
+	return fns.TokenizedCloneUrl(install.Url, glrepo.FullName, token)
+}
@@ -0,0 +1,31 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// MergeRequest groups all the activities required for the GitLab Merge Request.
+	MergeRequest struct{}
+)
+
+func (mr *MergeRequest) HydrateGitlabMREvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+func (mr *MergeRequest) SignalRepoWithGitlabMR(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.PullRequest]) error {
+	return SignalRepo(ctx, hydrated)
+}
+
+func (mr *MergeRequest) SignalRepoWithGitlabMergeQueue(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.MergeQueue]) error {
+	return SignalRepo(ctx, hydrated)
+}
+
+func (mr *MergeRequest) SignalRepoWithGitlabMRLabel(
+	ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.PullRequestLabel],
+) error {
+	return SignalRepo(ctx, hydrated)
+}
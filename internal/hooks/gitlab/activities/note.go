@@ -0,0 +1,23 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Note groups all the activities required for the GitLab Note.
+	Note struct{}
+)
+
+func (n *Note) HydrateGitlabNoteEvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+func (n *Note) SignalRepoWithGitlabNote(
+	ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.PullRequestReviewComment],
+) error {
+	return SignalRepo(ctx, hydrated)
+}
@@ -0,0 +1,16 @@
+package activities
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+)
+
+type (
+	// Pipeline groups all the activities required for the GitLab Pipeline.
+	Pipeline struct{}
+)
+
+func (p *Pipeline) HydrateGitlabPipelineEvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
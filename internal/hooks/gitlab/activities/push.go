@@ -0,0 +1,29 @@
+package activities
+
+import (
+	"context"
+	"time"
+
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Push groups all the activities required for the GitLab Push.
+	Push struct{}
+)
+
+func (p *Push) HydrateGitlabPushEvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	time.Sleep(2 * time.Second) // FIXME: this is a quick hack to get the parent id, same as github.
+
+	return HydrateRepoEvent(ctx, params)
+}
+
+func (p *Push) SignalRepoWithGitlabPush(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.Push]) error {
+	return SignalRepo(ctx, hydrated)
+}
+
+// SignalRepoWithGitlabRef signals the repository with the hydrated branch event.
+func (p *Push) SignalRepoWithGitlabRef(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.GitRef]) error {
+	return SignalRepo(ctx, hydrated)
+}
@@ -0,0 +1,38 @@
+package gitlab
+
+import (
+	"go.breu.io/quantm/internal/hooks/gitlab/activities"
+	"go.breu.io/quantm/internal/hooks/gitlab/config"
+	"go.breu.io/quantm/internal/hooks/gitlab/nomad"
+	"go.breu.io/quantm/internal/hooks/gitlab/web"
+	"go.breu.io/quantm/internal/hooks/gitlab/workflows"
+)
+
+type (
+	InstallActivity      = activities.Install
+	PushActivity         = activities.Push
+	MergeRequestActivity = activities.MergeRequest
+	NoteActivity         = activities.Note
+	PipelineActivity     = activities.Pipeline
+
+	KernelImpl = activities.Kernel
+
+	Config  = config.Config
+	Webhook = web.Webhook
+)
+
+var (
+	DefaultURL = config.DefaultURL
+
+	Configure  = config.Configure
+	WithConfig = config.WithConfig
+	Get        = config.Instance
+
+	InstallWorkflow      = workflows.GitlabInstall
+	PushWorkflow         = workflows.GitlabPush
+	MergeRequestWorkflow = workflows.GitlabMergeRequest
+	NoteWorkflow         = workflows.GitlabNote
+	PipelineWorkflow     = workflows.GitlabPipeline
+
+	NomadHandler = nomad.NewGitlabServiceHandler
+)
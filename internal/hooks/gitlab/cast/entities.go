@@ -0,0 +1,15 @@
+package cast
+
+import (
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+)
+
+// RepoForGitlabToHydratedRepoEvent converts a database row into a HydratedEvent.
+func RepoForGitlabToHydratedRepoEvent(row entities.GetRepoForGitlabRow) *defs.HydratedRepoEvent {
+	return &defs.HydratedRepoEvent{
+		Repo:      &row.Repo,
+		Org:       &row.Org,
+		ChatLinks: &defs.ChatLinks{},
+	}
+}
@@ -0,0 +1,213 @@
+package cast
+
+import (
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// LabelChange is a label added to or removed from a merge request.
+	LabelChange struct {
+		Name  string
+		Added bool
+	}
+)
+
+// PushToRefProto converts the push to a ref. GitLab has no separate webhooks for the creation and the deletion of
+// branches and tags, they are pushes from or to the null sha.
+func PushToRefProto(push *defs.Push) eventsv1.GitRef {
+	kind := "branch"
+	if push.IsTag() {
+		kind = "tag"
+	}
+
+	return eventsv1.GitRef{
+		Ref:  push.Ref,
+		Kind: kind,
+	}
+}
+
+func PushToProto(push *defs.Push) eventsv1.Push {
+	return eventsv1.Push{
+		Ref:        push.Ref,
+		Before:     push.Before,
+		After:      push.After,
+		Repository: push.GetRepositoryName(),
+		SenderId:   push.UserID,
+		Timestamp:  timestamppb.New(time.Now()),
+		Commits:    CommitsToProto(push.Commits),
+	}
+}
+
+func CommitsToProto(commits []defs.Commit) []*eventsv1.Commit {
+	result := make([]*eventsv1.Commit, len(commits))
+	for i, commit := range commits {
+		result[i] = &eventsv1.Commit{
+			Sha:       commit.ID,
+			Message:   commit.Message,
+			Url:       commit.URL,
+			Timestamp: timestamppb.New(commit.Timestamp.Time()),
+			Added:     commit.Added,
+			Removed:   commit.Removed,
+			Modified:  commit.Modified,
+		}
+	}
+
+	return result
+}
+
+func MergeRequestToProto(mr *defs.MergeRequest) eventsv1.PullRequest {
+	return eventsv1.PullRequest{
+		Number:     mr.GetNumber(),
+		Title:      mr.ObjectAttributes.Title,
+		Body:       mr.ObjectAttributes.Description,
+		Author:     mr.User.Username,
+		HeadBranch: mr.GetHeadBranch(),
+		BaseBranch: mr.GetBaseBranch(),
+		Timestamp:  timestamppb.New(mr.ObjectAttributes.UpdatedAt.Time()),
+		Merged:     mr.ObjectAttributes.State == "merged",
+	}
+}
+
+// MergeRequestAction maps the merge request action to the pull request action of the GitHub hook, so that the core
+// handles both alike. Returns false for actions that are not pull request events, e.g. approvals.
+func MergeRequestAction(action string) (events.Action, bool) {
+	switch action {
+	case "open":
+		return events.ActionOpened, true
+	case "reopen":
+		return events.ActionReopened, true
+	case "update":
+		return events.ActionUpdated, true
+	case "close", "merge":
+		return events.ActionClosed, true
+	default:
+		return "", false
+	}
+}
+
+// MergeRequestLabelChanges returns the labels added to and removed from the merge request.
+func MergeRequestLabelChanges(mr *defs.MergeRequest) []LabelChange {
+	changes := make([]LabelChange, 0)
+
+	if mr.Changes.Labels == nil {
+		return changes
+	}
+
+	titles := func(labels []defs.Label) []string {
+		result := make([]string, len(labels))
+		for i, label := range labels {
+			result[i] = label.Title
+		}
+
+		return result
+	}
+
+	previous := titles(mr.Changes.Labels.Previous)
+	current := titles(mr.Changes.Labels.Current)
+
+	for _, name := range current {
+		if !slices.Contains(previous, name) {
+			changes = append(changes, LabelChange{Name: name, Added: true})
+		}
+	}
+
+	for _, name := range previous {
+		if !slices.Contains(current, name) {
+			changes = append(changes, LabelChange{Name: name, Added: false})
+		}
+	}
+
+	return changes
+}
+
+// MergeRequestLabelToProto converts the label change to a merge queue event, if the label queues the merge request.
+func MergeRequestLabelToProto(mr *defs.MergeRequest, change LabelChange) *eventsv1.MergeQueue {
+	if change.Name != repos.LabelMerge && change.Name != repos.LabelPriority {
+		return nil
+	}
+
+	return &eventsv1.MergeQueue{
+		Number:     mr.GetNumber(),
+		Branch:     mr.GetHeadBranch(),
+		Sha:        mr.GetHeadSha(),
+		IsPriority: change.Name == repos.LabelPriority,
+		Timestamp:  timestamppb.New(mr.ObjectAttributes.UpdatedAt.Time()),
+	}
+}
+
+// MergeRequestLabelToBranchProto converts the label change to a branch label, if the label configures the branch.
+func MergeRequestLabelToBranchProto(mr *defs.MergeRequest, change LabelChange) *eventsv1.PullRequestLabel {
+	if change.Name != repos.LabelAutoUpdate {
+		return nil
+	}
+
+	return &eventsv1.PullRequestLabel{
+		Name:      change.Name,
+		Number:    mr.GetNumber(),
+		Branch:    mr.GetHeadBranch(),
+		Timestamp: timestamppb.New(mr.ObjectAttributes.UpdatedAt.Time()),
+	}
+}
+
+// NoteToProto converts a note on a merge request to a pull request review comment.
+func NoteToProto(note *defs.Note) *eventsv1.PullRequestReviewComment {
+	proto := &eventsv1.PullRequestReviewComment{
+		Id:                note.ObjectAttributes.ID,
+		PullRequestNumber: note.MergeRequest.IID,
+		Branch:            note.GetHeadBranch(),
+		State:             note.ObjectAttributes.Note, // TODO - need to decide, same as github.
+		CommitSha:         note.ObjectAttributes.CommitID,
+		AuthorEmail:       note.User.Email,
+		SubmittedAt:       timestamppb.New(note.ObjectAttributes.CreatedAt.Time()),
+	}
+
+	if note.ObjectAttributes.Position != nil {
+		proto.Path = note.ObjectAttributes.Position.NewPath
+		proto.Position = note.ObjectAttributes.Position.NewLine
+	}
+
+	return proto
+}
+
+// PipelineToProto converts the pipeline webhook to a pipeline event.
+func PipelineToProto(pipeline *defs.Pipeline) *eventsv1.Pipeline {
+	proto := &eventsv1.Pipeline{
+		Id:        pipeline.ObjectAttributes.ID,
+		Ref:       pipeline.ObjectAttributes.Ref,
+		Sha:       pipeline.ObjectAttributes.SHA,
+		Status:    pipeline.ObjectAttributes.Status,
+		Source:    pipeline.ObjectAttributes.Source,
+		Url:       pipeline.ObjectAttributes.URL,
+		Timestamp: timestamppb.New(pipeline.ObjectAttributes.CreatedAt.Time()),
+	}
+
+	if pipeline.MergeRequest != nil {
+		proto.PullRequestNumber = pipeline.MergeRequest.IID
+	}
+
+	return proto
+}
+
+// PipelineAction maps the status of the pipeline to the event action.
+func PipelineAction(status string) events.Action {
+	switch status {
+	case "success":
+		return events.ActionCompleted
+	case "failed":
+		return events.ActionFailure
+	case "canceled", "skipped":
+		return events.ActionDismissed
+	case "created", "pending", "waiting_for_resource", "preparing", "scheduled", "manual":
+		return events.ActionRequested
+	default:
+		return events.ActionStarted
+	}
+}
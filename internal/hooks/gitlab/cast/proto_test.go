@@ -0,0 +1,159 @@
+package cast_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitlab/cast"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+)
+
+func fixture[T any](t *testing.T, name string) *T {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	payload := new(T)
+	require.NoError(t, json.Unmarshal(data, payload))
+
+	return payload
+}
+
+func TestPushToProto(t *testing.T) {
+	t.Parallel()
+
+	push := fixture[defs.Push](t, "push.json")
+
+	assert.False(t, push.IsTag())
+	assert.False(t, push.IsCreated())
+	assert.False(t, push.IsDeleted())
+	assert.Equal(t, int64(15), push.GetProjectID())
+	assert.Equal(t, "feature/login", repos.BranchNameFromRef(push.Ref))
+
+	pb := cast.PushToProto(push)
+
+	assert.Equal(t, "refs/heads/feature/login", pb.GetRef())
+	assert.Equal(t, push.Before, pb.GetBefore())
+	assert.Equal(t, push.After, pb.GetAfter())
+	assert.Equal(t, "Diaspora", pb.GetRepository())
+	assert.Equal(t, int64(4), pb.GetSenderId())
+	require.Len(t, pb.GetCommits(), 2)
+	assert.Equal(t, "b6568db1bc1dcd7f8b4d5a946b0b91f9dacd7327", pb.GetCommits()[0].GetSha())
+	assert.Equal(t, []string{"CHANGELOG"}, pb.GetCommits()[0].GetAdded())
+	assert.Equal(t, []string{"app/controller/application.rb"}, pb.GetCommits()[0].GetModified())
+	assert.True(t, pb.GetCommits()[0].GetTimestamp().AsTime().Equal(time.Date(2011, 12, 12, 12, 27, 31, 0, time.UTC)))
+}
+
+func TestTagPushToRefProto(t *testing.T) {
+	t.Parallel()
+
+	push := fixture[defs.Push](t, "tag_push.json")
+
+	assert.True(t, push.IsTag())
+	assert.True(t, push.IsCreated())
+	assert.False(t, push.IsDeleted())
+
+	pb := cast.PushToRefProto(push)
+
+	assert.Equal(t, "refs/tags/v1.0.0", pb.GetRef())
+	assert.Equal(t, "tag", pb.GetKind())
+}
+
+func TestMergeRequestToProto(t *testing.T) {
+	t.Parallel()
+
+	mr := fixture[defs.MergeRequest](t, "merge_request.json")
+
+	pb := cast.MergeRequestToProto(mr)
+
+	assert.Equal(t, int64(1), pb.GetNumber())
+	assert.Equal(t, "MS-Viewport", pb.GetTitle())
+	assert.Equal(t, "root", pb.GetAuthor())
+	assert.Equal(t, "ms-viewport", pb.GetHeadBranch())
+	assert.Equal(t, "main", pb.GetBaseBranch())
+	assert.True(t, pb.GetTimestamp().AsTime().Equal(time.Date(2013, 12, 3, 17, 23, 34, 0, time.UTC)))
+
+	action, ok := cast.MergeRequestAction(mr.GetAction())
+
+	assert.True(t, ok)
+	assert.Equal(t, events.ActionUpdated, action)
+
+	_, ok = cast.MergeRequestAction("approved")
+	assert.False(t, ok)
+}
+
+func TestMergeRequestLabels(t *testing.T) {
+	t.Parallel()
+
+	mr := fixture[defs.MergeRequest](t, "merge_request.json")
+
+	changes := cast.MergeRequestLabelChanges(mr)
+
+	assert.Equal(t, []cast.LabelChange{
+		{Name: repos.LabelMerge, Added: true},
+		{Name: repos.LabelAutoUpdate, Added: true},
+		{Name: "bug", Added: false},
+	}, changes)
+
+	queue := cast.MergeRequestLabelToProto(mr, changes[0])
+	require.NotNil(t, queue)
+	assert.Equal(t, int64(1), queue.GetNumber())
+	assert.Equal(t, "ms-viewport", queue.GetBranch())
+	assert.False(t, queue.GetIsPriority())
+	assert.Nil(t, cast.MergeRequestLabelToBranchProto(mr, changes[0]))
+
+	label := cast.MergeRequestLabelToBranchProto(mr, changes[1])
+	require.NotNil(t, label)
+	assert.Equal(t, repos.LabelAutoUpdate, label.GetName())
+	assert.Equal(t, "ms-viewport", label.GetBranch())
+	assert.Nil(t, cast.MergeRequestLabelToProto(mr, changes[1]))
+
+	assert.Nil(t, cast.MergeRequestLabelToProto(mr, changes[2]))
+	assert.Nil(t, cast.MergeRequestLabelToBranchProto(mr, changes[2]))
+}
+
+func TestNoteToProto(t *testing.T) {
+	t.Parallel()
+
+	note := fixture[defs.Note](t, "note.json")
+
+	require.True(t, note.IsMergeRequestNote())
+
+	pb := cast.NoteToProto(note)
+
+	assert.Equal(t, int64(1244), pb.GetId())
+	assert.Equal(t, int64(1), pb.GetPullRequestNumber())
+	assert.Equal(t, "tutorial", pb.GetBranch())
+	assert.Equal(t, "cfe32cf61b73a0d5e9f13e774abde7ff789b1660", pb.GetCommitSha())
+	assert.Equal(t, "README.md", pb.GetPath())
+	assert.Equal(t, int64(12), pb.GetPosition())
+	assert.Equal(t, "admin@example.com", pb.GetAuthorEmail())
+	assert.True(t, pb.GetSubmittedAt().AsTime().Equal(time.Date(2015, 5, 17, 18, 21, 36, 0, time.UTC)))
+}
+
+func TestPipelineToProto(t *testing.T) {
+	t.Parallel()
+
+	pipeline := fixture[defs.Pipeline](t, "pipeline.json")
+
+	pb := cast.PipelineToProto(pipeline)
+
+	assert.Equal(t, int64(31), pb.GetId())
+	assert.Equal(t, "feature/login", pb.GetRef())
+	assert.Equal(t, "bcbb5ec396a2c0f828686f14fac9b80b780504f2", pb.GetSha())
+	assert.Equal(t, "failed", pb.GetStatus())
+	assert.Equal(t, "merge_request_event", pb.GetSource())
+	assert.Equal(t, int64(1), pb.GetPullRequestNumber())
+	assert.Equal(t, events.ActionFailure, cast.PipelineAction(pb.GetStatus()))
+	assert.Equal(t, events.ActionCompleted, cast.PipelineAction("success"))
+	assert.Equal(t, events.ActionStarted, cast.PipelineAction("running"))
+}
@@ -0,0 +1,53 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type (
+	// Config holds configuration settings for the GitLab integration.
+	Config struct {
+		URL        string `koanf:"URL" validate:"required,url"`         // Base URL of the default GitLab instance.
+		WebhookURL string `koanf:"WEBHOOK_URL" validate:"required,url"` // Public URL the project webhooks deliver to.
+	}
+
+	// ConfigOption is a function that modifies a Config.
+	ConfigOption func(*Config)
+)
+
+const (
+	DefaultURL = "https://gitlab.com"
+)
+
+func (cfg *Config) Validate() error {
+	validate := validator.New()
+	return validate.Struct(cfg)
+}
+
+// Enabled reports whether the GitLab integration is configured. GitLab is optional, unlike GitHub.
+func (cfg *Config) Enabled() bool {
+	return cfg.WebhookURL != ""
+}
+
+// Start is a no-op function that satisfies the graceful Service interface.
+func (cfg *Config) Start(ctx context.Context) error {
+	slog.Info("hooks/gitlab: configured", "url", cfg.URL)
+
+	return nil
+}
+
+// Stop is a no-op function that satisfies the graceful Service interface.
+func (cfg *Config) Stop(ctx context.Context) error { return nil }
+
+// InstanceURL returns the normalized base URL of the GitLab instance. An empty url resolves to the configured instance.
+func (cfg *Config) InstanceURL(url string) string {
+	if url == "" {
+		url = cfg.URL
+	}
+
+	return strings.TrimSuffix(url, "/")
+}
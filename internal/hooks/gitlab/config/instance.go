@@ -0,0 +1,62 @@
+package config
+
+import (
+	"log/slog"
+	"sync"
+)
+
+var (
+	_c    *Config   // Global connection instance.
+	_once sync.Once // Ensures connection initialization occurs only once.
+)
+
+// WithURL sets the URL field of the Config.
+func WithURL(url string) ConfigOption {
+	return func(config *Config) {
+		config.URL = url
+	}
+}
+
+// WithWebhookURL sets the WebhookURL field of the Config.
+func WithWebhookURL(url string) ConfigOption {
+	return func(config *Config) {
+		config.WebhookURL = url
+	}
+}
+
+// WithConfig copies the values from the given Config into the target Config.
+func WithConfig(cfg *Config) ConfigOption {
+	return func(config *Config) {
+		config.URL = cfg.URL
+		config.WebhookURL = cfg.WebhookURL
+	}
+}
+
+// Configure returns the singleton instance of the GitLab configuration.
+//
+// The function uses a `sync.Once` to ensure that the configuration is initialized only once.
+func Configure(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		_c = &Config{URL: DefaultURL}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
+
+func Instance(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		slog.Warn("gitlab: instance not initialized, this should not happen. Make sure that the configuration is loaded before calling this function.") // nolint
+
+		_c = &Config{URL: DefaultURL}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
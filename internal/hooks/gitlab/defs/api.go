@@ -0,0 +1,24 @@
+package defs
+
+type (
+	// Group is the group as returned by the GitLab REST API.
+	Group struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		FullPath string `json:"full_path"`
+		WebURL   string `json:"web_url"`
+	}
+
+	// ProjectHook is the project webhook as sent to and returned by the GitLab REST API.
+	ProjectHook struct {
+		ID                    int64  `json:"id,omitempty"`
+		URL                   string `json:"url"`
+		Token                 string `json:"token,omitempty"`
+		PushEvents            bool   `json:"push_events"`
+		TagPushEvents         bool   `json:"tag_push_events"`
+		MergeRequestsEvents   bool   `json:"merge_requests_events"`
+		NoteEvents            bool   `json:"note_events"`
+		PipelineEvents        bool   `json:"pipeline_events"`
+		EnableSSLVerification bool   `json:"enable_ssl_verification"`
+	}
+)
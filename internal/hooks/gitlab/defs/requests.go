@@ -0,0 +1,15 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+)
+
+type (
+	// RequestInstall is the request to install quantm on a GitLab group.
+	RequestInstall struct {
+		OrgID       uuid.UUID `json:"org_id"`
+		URL         string    `json:"url"`          // base url of the instance.
+		Group       string    `json:"group"`        // full path or id of the group.
+		AccessToken string    `json:"access_token"` // concealed group access token, see auth.Conceal.
+	}
+)
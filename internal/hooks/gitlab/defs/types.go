@@ -0,0 +1,49 @@
+package defs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type (
+	Timestamp time.Time // Timestamp is a hack around gitlab's use of different time formats across webhooks.
+)
+
+var (
+	// formats are the time formats used by the gitlab webhooks, newer versions use RFC3339.
+	formats = []string{time.RFC3339, "2006-01-02 15:04:05 MST", "2006-01-02 15:04:05 -0700"}
+)
+
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	if raw == "" {
+		return nil
+	}
+
+	var err error
+
+	for _, format := range formats {
+		var t_ time.Time
+
+		if t_, err = time.Parse(format, raw); err == nil {
+			*t = Timestamp(t_)
+
+			return nil
+		}
+	}
+
+	return err
+}
+
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	t_ := time.Time(t)
+	return json.Marshal(t_.Format(time.RFC3339))
+}
+
+func (t Timestamp) Time() time.Time {
+	return time.Time(t)
+}
@@ -0,0 +1,23 @@
+package defs
+
+type (
+	// WebhookEvent is the value of the X-Gitlab-Event header.
+	WebhookEvent string
+)
+
+const (
+	WebhookEventUnspecified  WebhookEvent = ""
+	WebhookEventPush         WebhookEvent = "Push Hook"
+	WebhookEventTagPush      WebhookEvent = "Tag Push Hook"
+	WebhookEventMergeRequest WebhookEvent = "Merge Request Hook"
+	WebhookEventNote         WebhookEvent = "Note Hook"
+	WebhookEventPipeline     WebhookEvent = "Pipeline Hook"
+)
+
+const (
+	NoCommit = "0000000000000000000000000000000000000000" // sha of before on create, and of after on delete.
+)
+
+func (e WebhookEvent) String() string {
+	return string(e)
+}
@@ -0,0 +1,238 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+)
+
+type (
+	// Project is the project as sent with the webhooks.
+	Project struct {
+		ID                int64  `json:"id"`
+		Name              string `json:"name"`
+		WebURL            string `json:"web_url"`
+		PathWithNamespace string `json:"path_with_namespace"`
+		DefaultBranch     string `json:"default_branch"`
+	}
+
+	// User is the user as sent with the merge request, note and pipeline webhooks.
+	User struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		Username string `json:"username"`
+		Email    string `json:"email"`
+	}
+
+	Author struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	Commit struct {
+		ID        string    `json:"id"`
+		Message   string    `json:"message"`
+		Title     string    `json:"title"`
+		Timestamp Timestamp `json:"timestamp"`
+		URL       string    `json:"url"`
+		Author    Author    `json:"author"`
+		Added     []string  `json:"added"`
+		Modified  []string  `json:"modified"`
+		Removed   []string  `json:"removed"`
+	}
+
+	Label struct {
+		ID    int64  `json:"id"`
+		Title string `json:"title"`
+	}
+
+	LabelChanges struct {
+		Previous []Label `json:"previous"`
+		Current  []Label `json:"current"`
+	}
+
+	MergeRequestChanges struct {
+		Labels *LabelChanges `json:"labels"`
+	}
+
+	MergeRequestAttributes struct {
+		ID           int64     `json:"id"`
+		IID          int64     `json:"iid"`
+		Title        string    `json:"title"`
+		Description  string    `json:"description"`
+		SourceBranch string    `json:"source_branch"`
+		TargetBranch string    `json:"target_branch"`
+		State        string    `json:"state"`
+		Action       string    `json:"action"`
+		URL          string    `json:"url"`
+		UpdatedAt    Timestamp `json:"updated_at"`
+		LastCommit   *Commit   `json:"last_commit"`
+	}
+
+	// NoteMergeRequest is the merge request a note is attached to.
+	NoteMergeRequest struct {
+		ID           int64  `json:"id"`
+		IID          int64  `json:"iid"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+	}
+
+	NotePosition struct {
+		NewPath string `json:"new_path"`
+		NewLine int64  `json:"new_line"`
+	}
+
+	NoteAttributes struct {
+		ID           int64         `json:"id"`
+		Note         string        `json:"note"`
+		NoteableType string        `json:"noteable_type"`
+		CommitID     string        `json:"commit_id"`
+		DiscussionID string        `json:"discussion_id"`
+		Type         string        `json:"type"`
+		Action       string        `json:"action"`
+		URL          string        `json:"url"`
+		Position     *NotePosition `json:"position"`
+		CreatedAt    Timestamp     `json:"created_at"`
+	}
+
+	PipelineAttributes struct {
+		ID         int64     `json:"id"`
+		IID        int64     `json:"iid"`
+		Ref        string    `json:"ref"`
+		Tag        bool      `json:"tag"`
+		SHA        string    `json:"sha"`
+		Source     string    `json:"source"`
+		Status     string    `json:"status"`
+		URL        string    `json:"url"`
+		CreatedAt  Timestamp `json:"created_at"`
+		FinishedAt Timestamp `json:"finished_at"`
+	}
+
+	// Push is the payload of both the push and the tag push webhooks.
+	Push struct {
+		ObjectKind        string   `json:"object_kind"`
+		Before            string   `json:"before"`
+		After             string   `json:"after"`
+		Ref               string   `json:"ref"`
+		CheckoutSHA       string   `json:"checkout_sha"`
+		UserID            int64    `json:"user_id"`
+		UserName          string   `json:"user_name"`
+		UserUsername      string   `json:"user_username"`
+		UserEmail         string   `json:"user_email"`
+		ProjectID         int64    `json:"project_id"`
+		Project           Project  `json:"project"`
+		Commits           []Commit `json:"commits"`
+		TotalCommitsCount int64    `json:"total_commits_count"`
+
+		Installation uuid.UUID `json:"installation"` // installation the webhook is verified against, not part of the webhook.
+	}
+
+	MergeRequest struct {
+		ObjectKind       string                 `json:"object_kind"`
+		User             User                   `json:"user"`
+		Project          Project                `json:"project"`
+		ObjectAttributes MergeRequestAttributes `json:"object_attributes"`
+		Labels           []Label                `json:"labels"`
+		Changes          MergeRequestChanges    `json:"changes"`
+
+		Installation uuid.UUID `json:"installation"` // installation the webhook is verified against, not part of the webhook.
+	}
+
+	Note struct {
+		ObjectKind       string            `json:"object_kind"`
+		User             User              `json:"user"`
+		ProjectID        int64             `json:"project_id"`
+		Project          Project           `json:"project"`
+		ObjectAttributes NoteAttributes    `json:"object_attributes"`
+		MergeRequest     *NoteMergeRequest `json:"merge_request"`
+
+		Installation uuid.UUID `json:"installation"` // installation the webhook is verified against, not part of the webhook.
+	}
+
+	Pipeline struct {
+		ObjectKind       string             `json:"object_kind"`
+		User             User               `json:"user"`
+		Project          Project            `json:"project"`
+		ObjectAttributes PipelineAttributes `json:"object_attributes"`
+		MergeRequest     *NoteMergeRequest  `json:"merge_request"`
+
+		Installation uuid.UUID `json:"installation"` // installation the webhook is verified against, not part of the webhook.
+	}
+)
+
+// ---------------------------------- Push Event ----------------------------------.
+
+func (p *Push) IsTag() bool {
+	return p.ObjectKind == "tag_push"
+}
+
+func (p *Push) IsCreated() bool {
+	return p.Before == NoCommit
+}
+
+func (p *Push) IsDeleted() bool {
+	return p.After == NoCommit
+}
+
+func (p *Push) GetProjectID() int64 {
+	return p.Project.ID
+}
+
+func (p *Push) GetRepositoryName() string {
+	return p.Project.Name
+}
+
+// ---------------------------------- Merge Request Event ----------------------------------.
+
+func (mr *MergeRequest) GetNumber() int64 {
+	return mr.ObjectAttributes.IID
+}
+
+func (mr *MergeRequest) GetAction() string {
+	return mr.ObjectAttributes.Action
+}
+
+func (mr *MergeRequest) GetHeadBranch() string {
+	return mr.ObjectAttributes.SourceBranch
+}
+
+// GetHeadSha returns the sha of the last commit of the merge request, empty if unknown.
+func (mr *MergeRequest) GetHeadSha() string {
+	if mr.ObjectAttributes.LastCommit == nil {
+		return ""
+	}
+
+	return mr.ObjectAttributes.LastCommit.ID
+}
+
+func (mr *MergeRequest) GetBaseBranch() string {
+	return mr.ObjectAttributes.TargetBranch
+}
+
+func (mr *MergeRequest) GetProjectID() int64 {
+	return mr.Project.ID
+}
+
+// ---------------------------------- Note Event ----------------------------------.
+
+// IsMergeRequestNote reports whether the note is a comment on a merge request. Notes on commits, issues and
+// snippets are ignored.
+func (n *Note) IsMergeRequestNote() bool {
+	return n.ObjectAttributes.NoteableType == "MergeRequest" && n.MergeRequest != nil
+}
+
+func (n *Note) GetHeadBranch() string {
+	if n.MergeRequest == nil {
+		return ""
+	}
+
+	return n.MergeRequest.SourceBranch
+}
+
+func (n *Note) GetProjectID() int64 {
+	return n.Project.ID
+}
+
+// ---------------------------------- Pipeline Event ----------------------------------.
+
+func (p *Pipeline) GetProjectID() int64 {
+	return p.Project.ID
+}
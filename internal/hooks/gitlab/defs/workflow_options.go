@@ -0,0 +1,48 @@
+package defs
+
+import (
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/utils"
+)
+
+// NewInstallWorkflowOptions standardize the workflow options for Install Workflow.
+//
+//	io.ctrlplane.hooks.gitlab.install.${org_id}.${group}
+//
+// for exmple, for the group "acme/platform" of the org 0193..., the resulting workflow options would be:
+//
+//	io.ctrlplane.hooks.gitlab.install.0193....acme/platform
+func NewInstallWorkflowOptions(org_id, group string) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("gitlab"),
+		durable.WithSubject("install"),
+		durable.WithSubjectID(org_id),
+		durable.WithScope(group),
+	)
+}
+
+// NewRefWorkflowOptions generates a workflow ID for the GitLab project events based on a Git ref, a specified scope,
+// and action. It mirrors the workflow IDs of the GitHub hook.
+//
+//	io.ctrlplane.hooks.gitlab.repo.${project_id}.${ref}.${scope}.${scope_id}.${action}.${event_id}
+//
+// Where:
+//
+//   - project_id is the GitLab project ID.
+//   - ref is the full Git ref or the branch involved in the event (e.g., "refs/heads/main").
+//   - scope represents the broader context of the event (e.g., "push", "merge_request").
+//   - scope_id is the specific ID associated with the scope (e.g., `after` SHA for push, merge request iid).
+//   - action is the action performed within the event (e.g., "created", "update", "deleted").
+//   - event_id is the unique UUID sent by GitLab in the X-Gitlab-Event-UUID header.
+func NewRefWorkflowOptions(project_id int64, ref, scope, scope_id, action, event_id string) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("gitlab"),
+		durable.WithSubject("repo"),
+		durable.WithSubjectID(utils.Int64ToString(project_id)),
+		durable.WithKind(ref),
+		durable.WithScope(scope),
+		durable.WithScopeID(scope_id),
+		durable.WithAction(action),
+		durable.WithActionID(event_id),
+	)
+}
@@ -0,0 +1,14 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	ErrMissingHeaderGitlabEvent = errors.New("missing X-Gitlab-Event Header")
+	ErrMissingHeaderGitlabToken = errors.New("missing X-Gitlab-Token Header")
+	ErrVerifyToken              = errors.New("webhook token verification failed")
+	ErrUnexpectedStatus         = errors.New("unexpected status from gitlab")
+	ErrInstalledByOtherOrg      = errors.New("group is installed by another org")
+	ErrNotFound                 = errors.New("not found on gitlab")
+)
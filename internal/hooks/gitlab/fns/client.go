@@ -0,0 +1,166 @@
+package fns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	"go.breu.io/quantm/internal/hooks/gitlab/errors"
+)
+
+type (
+	// Client is a minimal client for the GitLab REST API, covering only what the hook needs.
+	Client struct {
+		base  string
+		token string
+		http  *http.Client
+	}
+)
+
+const (
+	per_page = 100
+)
+
+// GetGroup fetches the group by its full path or id.
+func (c *Client) GetGroup(ctx context.Context, group string) (*defs.Group, error) {
+	result := &defs.Group{}
+
+	if _, err := c.do(ctx, http.MethodGet, "groups/"+url.PathEscape(group), nil, nil, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListGroupProjects lists all the projects of the group, including the projects of its subgroups. Archived projects
+// are skipped.
+func (c *Client) ListGroupProjects(ctx context.Context, id int64) ([]defs.Project, error) {
+	projects := make([]defs.Project, 0)
+	page := "1"
+
+	for page != "" {
+		query := url.Values{}
+		query.Set("include_subgroups", "true")
+		query.Set("archived", "false")
+		query.Set("per_page", strconv.Itoa(per_page))
+		query.Set("page", page)
+
+		batch := make([]defs.Project, 0)
+
+		header, err := c.do(ctx, http.MethodGet, fmt.Sprintf("groups/%d/projects", id), query, nil, &batch)
+		if err != nil {
+			return nil, err
+		}
+
+		projects = append(projects, batch...)
+		page = header.Get("X-Next-Page")
+	}
+
+	return projects, nil
+}
+
+// AddProjectHook adds the webhook to the project.
+func (c *Client) AddProjectHook(ctx context.Context, id int64, hook *defs.ProjectHook) (*defs.ProjectHook, error) {
+	result := &defs.ProjectHook{}
+
+	if _, err := c.do(ctx, http.MethodPost, fmt.Sprintf("projects/%d/hooks", id), nil, hook, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// EditProjectHook updates the webhook of the project. Returns errors.ErrNotFound if the webhook has been removed.
+func (c *Client) EditProjectHook(ctx context.Context, id, hook_id int64, hook *defs.ProjectHook) (*defs.ProjectHook, error) {
+	result := &defs.ProjectHook{}
+
+	if _, err := c.do(ctx, http.MethodPut, fmt.Sprintf("projects/%d/hooks/%d", id, hook_id), nil, hook, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// do sends the request to the api and decodes the response into result. It returns the response headers, e.g. to
+// follow the pagination.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, result any) (http.Header, error) {
+	endpoint, err := url.JoinPath(c.base, "api", "v4")
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint += "/" + path
+
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		reader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("PRIVATE-TOKEN", c.token)
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s %s", errors.ErrNotFound, method, path)
+	}
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%w: %s %s: %s", errors.ErrUnexpectedStatus, method, path, res.Status)
+	}
+
+	if result != nil {
+		if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+			return nil, err
+		}
+	}
+
+	return res.Header, nil
+}
+
+// NewClient creates a client for the GitLab instance at base, authenticating with the given access token.
+func NewClient(base, token string) *Client {
+	return &Client{base: base, token: token, http: http.DefaultClient}
+}
+
+// TokenizedCloneUrl returns the clone url of the project, authenticating with the given access token.
+func TokenizedCloneUrl(base, path, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", err
+	}
+
+	u = u.JoinPath(path + ".git")
+	u.User = url.UserPassword("oauth2", token)
+
+	return u.String(), nil
+}
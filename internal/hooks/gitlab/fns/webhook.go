@@ -0,0 +1,43 @@
+package fns
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/hooks/gitlab/errors"
+)
+
+// NewWebhookSecret generates the random secret of the project hooks of an installation.
+func NewWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// WebhookToken returns the token the project hooks of the installation send in the X-Gitlab-Token header. GitLab sends
+// the token as is, so it carries the id of the installation along with its secret to tell which installation the
+// webhook belongs to.
+func WebhookToken(installation uuid.UUID, secret string) string {
+	return installation.String() + "." + secret
+}
+
+// ParseWebhookToken splits the token of a webhook into the id of the installation and the secret.
+func ParseWebhookToken(token string) (uuid.UUID, string, error) {
+	id, secret, ok := strings.Cut(token, ".")
+	if !ok || secret == "" {
+		return uuid.Nil, "", errors.ErrVerifyToken
+	}
+
+	installation, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.Nil, "", errors.ErrVerifyToken
+	}
+
+	return installation, secret, nil
+}
@@ -0,0 +1,60 @@
+package nomad
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/gitlab/config"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	"go.breu.io/quantm/internal/hooks/gitlab/workflows"
+	gitlabv1 "go.breu.io/quantm/internal/proto/hooks/gitlab/v1"
+	"go.breu.io/quantm/internal/proto/hooks/gitlab/v1/gitlabv1connect"
+)
+
+type (
+	GitlabService struct {
+		gitlabv1connect.UnimplementedGitlabServiceHandler
+	}
+)
+
+// Install installs quantm on a GitLab group. The access token is concealed before it leaves the request, so that it
+// is never recorded in the workflow history in plain text.
+func (s *GitlabService) Install(
+	ctx context.Context, req *connect.Request[gitlabv1.InstallRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	org_id, err := uuid.Parse(req.Msg.OrgId)
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksGitlabModule).WithReason("invalid org id").Wrap(err)
+	}
+
+	token, err := auth.Conceal(req.Msg.AccessToken, org_id.String())
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksGitlabModule).WithReason("unable to conceal token").Wrap(err)
+	}
+
+	args := &defs.RequestInstall{
+		OrgID:       org_id,
+		URL:         config.Instance().InstanceURL(req.Msg.Url),
+		Group:       req.Msg.Group,
+		AccessToken: token,
+	}
+
+	opts := defs.NewInstallWorkflowOptions(org_id.String(), req.Msg.Group)
+
+	if _, err := durable.OnHooks().ExecuteWorkflow(ctx, opts, workflows.GitlabInstall, args); err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksGitlabModule).WithReason("unable to start install").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func NewGitlabServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return gitlabv1connect.NewGitlabServiceHandler(&GitlabService{}, opts...)
+}
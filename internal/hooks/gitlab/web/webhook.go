@@ -0,0 +1,233 @@
+package web
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/gitlab/errors"
+	"go.breu.io/quantm/internal/hooks/gitlab/fns"
+	"go.breu.io/quantm/internal/hooks/gitlab/workflows"
+	"go.breu.io/quantm/internal/utils"
+)
+
+type (
+	// Webhook is a GitLab Webhook event receiver responsible for scheduling transient workflows.
+	//
+	// Transient workflows gather the necessary context to formulate QuantmEvents, package them,
+	// and then dispatch them to the appropriate workflow within the Quantm core for processing.
+	Webhook struct{}
+
+	// WebhookEventHandler is a function that handles GitLab Webhook events.
+	WebhookEventHandler func(ctx echo.Context, event defs.WebhookEvent, id string) error
+
+	// WebhookEventHandlers is a map of GitLab Webhook event names to their handlers.
+	WebhookEventHandlers map[defs.WebhookEvent]WebhookEventHandler
+)
+
+const (
+	installation_key = "gitlab_installation" // key of the verified installation in the echo context.
+)
+
+// Handler handles GitLab Webhook events.
+func (h *Webhook) Handler(ctx echo.Context) error {
+	// Get the token from the request header. If the token is missing, return a failed precondition error.
+	token := ctx.Request().Header.Get("X-Gitlab-Token")
+	if token == "" {
+		return erratic.NewFailedPreconditionError(erratic.HooksGitlabModule).WithReason("missing X-Gitlab-Token header")
+	}
+
+	// Verify the token against the installation it names. Return an unauthorized error if the token is invalid.
+	installation, err := h.verify(ctx.Request().Context(), token)
+	if err != nil {
+		return erratic.NewAuthzError(erratic.HooksGitlabModule).WithReason("invalid webhook token").Wrap(err)
+	}
+
+	// Get the event type from the request header.
+	event := defs.WebhookEvent(ctx.Request().Header.Get("X-Gitlab-Event"))
+	if event == defs.WebhookEventUnspecified {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	// Get the event handler for the event type. If the event handler is not found, ignore the event.
+	fn, found := h.on(event)
+	if !found {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	id := ctx.Request().Header.Get("X-Gitlab-Event-UUID")
+
+	ctx.Set(installation_key, installation)
+
+	// Execute the event handler.
+	if err := fn(ctx, event, id); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// on returns the event handler for the given event type.
+func (h *Webhook) on(event defs.WebhookEvent) (WebhookEventHandler, bool) {
+	handlers := WebhookEventHandlers{
+		defs.WebhookEventPush:         h.push,
+		defs.WebhookEventTagPush:      h.push,
+		defs.WebhookEventMergeRequest: h.mr,
+		defs.WebhookEventNote:         h.note,
+		defs.WebhookEventPipeline:     h.pipeline,
+	}
+
+	fn, ok := handlers[event]
+
+	return fn, ok
+}
+
+// push handles the push and the tag push events.
+func (h *Webhook) push(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.Push{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGitlabModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	payload.Installation = h.installation(ctx)
+
+	action := "created"
+	if payload.IsDeleted() {
+		action = "deleted"
+	}
+
+	scope := "push"
+	if payload.IsTag() {
+		scope = "tag"
+	}
+
+	opts := defs.NewRefWorkflowOptions(payload.GetProjectID(), payload.Ref, scope, payload.After, action, id)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GitlabPush, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGitlabModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// mr handles the merge request event.
+func (h *Webhook) mr(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.MergeRequest{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGitlabModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	payload.Installation = h.installation(ctx)
+
+	opts := defs.NewRefWorkflowOptions(
+		payload.GetProjectID(), payload.GetHeadBranch(), "merge_request", utils.Int64ToString(payload.GetNumber()), payload.GetAction(), id,
+	)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GitlabMergeRequest, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGitlabModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// note handles the note event. Only notes on merge requests are processed.
+func (h *Webhook) note(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.Note{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGitlabModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	if !payload.IsMergeRequestNote() {
+		return nil
+	}
+
+	payload.Installation = h.installation(ctx)
+
+	opts := defs.NewRefWorkflowOptions(
+		payload.GetProjectID(), payload.GetHeadBranch(), "note",
+		utils.Int64ToString(payload.ObjectAttributes.ID), payload.ObjectAttributes.Action, id,
+	)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GitlabNote, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGitlabModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// pipeline handles the pipeline event.
+func (h *Webhook) pipeline(ctx echo.Context, _ defs.WebhookEvent, id string) error {
+	payload := &defs.Pipeline{}
+	if err := ctx.Bind(payload); err != nil {
+		slog.Error("failed to bind payload", "error", err.Error())
+		return erratic.NewBadRequestError(erratic.HooksGitlabModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	payload.Installation = h.installation(ctx)
+
+	opts := defs.NewRefWorkflowOptions(
+		payload.GetProjectID(), payload.ObjectAttributes.Ref, "pipeline",
+		utils.Int64ToString(payload.ObjectAttributes.ID), payload.ObjectAttributes.Status, id,
+	)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.GitlabPipeline, payload)
+	if err != nil {
+		slog.Error("failed to signal workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksGitlabModule).Wrap(err)
+	}
+
+	return nil
+}
+
+// verify verifies the token of a webhook request, returning the id of the installation it belongs to.
+//
+// Unlike GitHub, GitLab does not sign the payload. It sends the token configured on the webhook as is in the
+// X-Gitlab-Token header, i.e. the id of the installation and its secret, so the secret is compared in constant time
+// against the secret of the installation.
+func (h *Webhook) verify(ctx context.Context, token string) (uuid.UUID, error) {
+	id, secret, err := fns.ParseWebhookToken(token)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	install, err := db.Queries().GetGitlabInstallation(ctx, id)
+	if err != nil || !install.IsActive || install.WebhookSecret == "" {
+		return uuid.Nil, pkgerrors.ErrVerifyToken
+	}
+
+	expected, err := auth.Reveal(install.WebhookSecret, install.OrgID.String())
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(secret), []byte(expected)) != 1 {
+		return uuid.Nil, pkgerrors.ErrVerifyToken
+	}
+
+	return install.ID, nil
+}
+
+// installation returns the id of the installation the webhook was verified against.
+func (h *Webhook) installation(ctx echo.Context) uuid.UUID {
+	id, _ := ctx.Get(installation_key).(uuid.UUID)
+
+	return id
+}
@@ -0,0 +1,48 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/gitlab/activities"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+)
+
+// GitlabInstall installs quantm on a GitLab group. Unlike GitHub, there is no app installation webhook to wait for. The
+// group is resolved with the access token of the request, the installation is recorded, and every project of the group,
+// including the projects of subgroups, is added as a repo along with its webhook.
+func GitlabInstall(ctx workflow.Context, request *defs.RequestInstall) error {
+	acts := &activities.Install{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	group := &defs.Group{}
+	if err := workflow.ExecuteActivity(ctx, acts.GetGitlabGroup, request).Get(ctx, group); err != nil {
+		return err
+	}
+
+	install := &entities.GitlabInstallation{}
+	payload := &defs.InstallationPayload{Request: request, Group: group}
+
+	if err := workflow.ExecuteActivity(ctx, acts.GetOrCreateGitlabInstallation, payload).Get(ctx, install); err != nil {
+		return err
+	}
+
+	projects := make([]defs.Project, 0)
+	if err := workflow.ExecuteActivity(ctx, acts.ListGitlabGroupProjects, install).Get(ctx, &projects); err != nil {
+		return err
+	}
+
+	selector := workflow.NewSelector(ctx)
+
+	for _, project := range projects {
+		payload := &defs.SyncRepoPayload{InstallationID: install.ID, OrgID: install.OrgID, Project: project}
+		selector.AddFuture(workflow.ExecuteActivity(ctx, acts.AddGitlabRepoToInstall, payload), func(f workflow.Future) {})
+	}
+
+	for range projects {
+		selector.Select(ctx)
+	}
+
+	return nil
+}
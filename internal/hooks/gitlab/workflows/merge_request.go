@@ -0,0 +1,101 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitlab/activities"
+	"go.breu.io/quantm/internal/hooks/gitlab/cast"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The GitlabMergeRequest workflow processes GitLab merge request webhooks. It hydrates the event with repository, user, and
+// team metadata, then converts the defs.MergeRequest payload into a pull request QuantmEvent. GitLab reports label
+// changes as part of the update action, so each added or removed label is handled in addition to the pull request.
+func GitlabMergeRequest(ctx workflow.Context, mr *defs.MergeRequest) error {
+	acts := &activities.MergeRequest{}
+	hydrated := &defs.HydratedRepoEvent{}
+
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	payload := &defs.HydratedRepoEventPayload{
+		Installation: mr.Installation,
+		ProjectID:    mr.GetProjectID(),
+		Email:        mr.User.Email,
+		Branch:       mr.GetHeadBranch(),
+	}
+
+	if err := workflow.ExecuteActivity(ctx, acts.HydrateGitlabMREvent, payload).Get(ctx, hydrated); err != nil {
+		return err
+	}
+
+	for _, change := range cast.MergeRequestLabelChanges(mr) {
+		if err := handle_label(ctx, mr, change, hydrated); err != nil {
+			return err
+		}
+	}
+
+	return handle_mr(ctx, mr, hydrated)
+}
+
+// handle_mr processes a merge request event, creating a QuantmEvent and signaling the repository.
+func handle_mr(ctx workflow.Context, mr *defs.MergeRequest, repo_evt *defs.HydratedRepoEvent) error {
+	acts := &activities.MergeRequest{}
+
+	action, ok := cast.MergeRequestAction(mr.GetAction())
+	if !ok {
+		return nil
+	}
+
+	proto := cast.MergeRequestToProto(mr)
+	event := new_event(repo_evt, events.ScopePr, action, &proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.PullRequest]{Event: event, Meta: repo_evt, Signal: repos.SignalPullRequest}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGitlabMR, hevent).Get(ctx, nil)
+}
+
+// handle_label processes a label change, signaling the merge queue or configuring the branch, if applicable.
+func handle_label(ctx workflow.Context, mr *defs.MergeRequest, change cast.LabelChange, repo_evt *defs.HydratedRepoEvent) error {
+	acts := &activities.MergeRequest{}
+
+	action := events.EventActionRemoved
+	if change.Added {
+		action = events.EventActionAdded
+	}
+
+	if proto := cast.MergeRequestLabelToBranchProto(mr, change); proto != nil {
+		event := new_event(repo_evt, events.ScopePrLabel, action, proto)
+
+		if err := pulse.Persist(ctx, event); err != nil {
+			return err
+		}
+
+		hevent := &defs.HydratedQuantmEvent[eventsv1.PullRequestLabel]{Event: event, Meta: repo_evt, Signal: repos.SignalPullRequestLabel}
+
+		return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGitlabMRLabel, hevent).Get(ctx, nil)
+	}
+
+	proto := cast.MergeRequestLabelToProto(mr, change)
+	if proto == nil {
+		return nil
+	}
+
+	event := new_event(repo_evt, events.ScopeMergeQueue, action, proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.MergeQueue]{Event: event, Meta: repo_evt, Signal: repos.SignalMergeQueue}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGitlabMergeQueue, hevent).Get(ctx, nil)
+}
@@ -0,0 +1,62 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitlab/activities"
+	"go.breu.io/quantm/internal/hooks/gitlab/cast"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The GitlabNote workflow processes GitLab note webhooks on merge requests, converting them to pull request review comments.
+// Notes on commits, issues and snippets are ignored.
+func GitlabNote(ctx workflow.Context, note *defs.Note) error {
+	acts := &activities.Note{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	if !note.IsMergeRequestNote() {
+		return nil
+	}
+
+	action := events.ActionCreated
+
+	switch note.ObjectAttributes.Action {
+	case "", "create":
+	case "update":
+		action = events.ActionUpdated
+	default:
+		return nil
+	}
+
+	proto := cast.NoteToProto(note)
+	hydrated := &defs.HydratedRepoEvent{}
+
+	{
+		payload := &defs.HydratedRepoEventPayload{
+			Installation: note.Installation,
+			ProjectID:    note.GetProjectID(),
+			Email:        note.User.Email,
+			Branch:       note.GetHeadBranch(),
+		}
+		if err := workflow.ExecuteActivity(ctx, acts.HydrateGitlabNoteEvent, payload).Get(ctx, hydrated); err != nil {
+			return err
+		}
+	}
+
+	event := new_event(hydrated, events.ScopePr, action, proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.PullRequestReviewComment]{
+		Event: event, Meta: hydrated, Signal: repos.SignalPullRequestReviewComment,
+	}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGitlabNote, hevent).Get(ctx, nil)
+}
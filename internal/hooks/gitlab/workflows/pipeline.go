@@ -0,0 +1,37 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitlab/activities"
+	"go.breu.io/quantm/internal/hooks/gitlab/cast"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The GitlabPipeline workflow processes GitLab pipeline webhooks. The pipeline is persisted with the action derived from
+// its status. The core has no use for pipelines yet, so the repository is not signaled.
+func GitlabPipeline(ctx workflow.Context, pipeline *defs.Pipeline) error {
+	acts := &activities.Pipeline{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	proto := cast.PipelineToProto(pipeline)
+	hydrated := &defs.HydratedRepoEvent{}
+
+	{
+		payload := &defs.HydratedRepoEventPayload{
+			Installation: pipeline.Installation,
+			ProjectID:    pipeline.GetProjectID(),
+			Email:        pipeline.User.Email,
+		}
+		if err := workflow.ExecuteActivity(ctx, acts.HydrateGitlabPipelineEvent, payload).Get(ctx, hydrated); err != nil {
+			return err
+		}
+	}
+
+	event := new_event(hydrated, events.ScopePipeline, cast.PipelineAction(pipeline.ObjectAttributes.Status), proto)
+
+	return pulse.Persist(ctx, event)
+}
@@ -0,0 +1,92 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/gitlab/activities"
+	"go.breu.io/quantm/internal/hooks/gitlab/cast"
+	"go.breu.io/quantm/internal/hooks/gitlab/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The GitlabPush workflow processes GitLab push and tag push webhooks. GitLab has no separate webhooks for the creation and
+// the deletion of refs, so pushes from or to the null sha are converted to ref events, same as the create and delete
+// webhooks of GitHub. Tags are only persisted. Pushes to branches are converted to push events after hydrating the
+// event with repository, user, and team metadata. Finally, the repository is signaled.
+func GitlabPush(ctx workflow.Context, push *defs.Push) error {
+	acts := &activities.Push{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	hre := &defs.HydratedRepoEvent{} // hre -> hydrated repo event
+
+	{
+		payload := &defs.HydratedRepoEventPayload{
+			Installation: push.Installation,
+			ProjectID:    push.GetProjectID(),
+			Email:        push.UserEmail,
+		}
+
+		if !push.IsTag() {
+			payload.Branch = repos.BranchNameFromRef(push.Ref)
+		}
+
+		if err := workflow.ExecuteActivity(ctx, acts.HydrateGitlabPushEvent, payload).Get(ctx, hre); err != nil {
+			return err
+		}
+	}
+
+	if push.IsTag() || push.IsCreated() || push.IsDeleted() {
+		if err := ref(ctx, push, hre); err != nil {
+			return err
+		}
+	}
+
+	if push.IsTag() || push.IsDeleted() {
+		return nil
+	}
+
+	proto := cast.PushToProto(push)
+	event := new_event(hre, events.ScopePush, events.ActionCreated, &proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.Push]{Event: event, Meta: hre, Signal: repos.SignalPush}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGitlabPush, hevent).Get(ctx, nil)
+}
+
+// ref persists the creation or the deletion of a ref. Only branches are signaled to the repository.
+func ref(ctx workflow.Context, push *defs.Push, hre *defs.HydratedRepoEvent) error {
+	acts := &activities.Push{}
+	proto := cast.PushToRefProto(push)
+
+	action := events.ActionCreated
+	if push.IsDeleted() {
+		action = events.ActionDeleted
+	}
+
+	scope := events.ScopeBranch
+	if push.IsTag() {
+		scope = events.ScopeTag
+	}
+
+	event := new_event(hre, scope, action, &proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	if push.IsTag() {
+		return nil
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.GitRef]{Event: event, Meta: hre, Signal: repos.SignalRef}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithGitlabRef, hevent).Get(ctx, nil)
+}
@@ -0,0 +1,111 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/local/cast"
+	"go.breu.io/quantm/internal/hooks/local/config"
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	"go.breu.io/quantm/internal/hooks/local/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// HydrateRepoEvent enriches a repository event using database data. Local repositories need no installation, so the
+// repository is created for the configured org on its first push. It optionally adds user information if an email is
+// provided. For non-default branches, it retrieves the parent event ID from the core workflow.
+func HydrateRepoEvent(ctx context.Context, payload *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	path, err := config.Instance().Resolve(payload.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fns.RepoUrl(path)
+	params := entities.GetRepoForLocalParams{Hook: int32(eventsv1.RepoHook_REPO_HOOK_LOCAL), HookID: fns.HookID(url)}
+
+	row, err := db.Queries().GetRepoForLocal(ctx, params)
+	if errors.Is(err, pgx.ErrNoRows) {
+		if err := create_repo(ctx, path, url); err != nil {
+			return nil, err
+		}
+
+		row, err = db.Queries().GetRepoForLocal(ctx, params)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	hydrated := cast.RepoForLocalToHydratedRepoEvent(row)
+
+	chat_link, err := db.Queries().GetChatLink(ctx, row.Repo.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Warn("unable to get chat link, notification will not work.") // TODO: user should know.
+		} else {
+			return nil, err
+		}
+	}
+
+	hydrated.ChatLinks.Repo = &chat_link
+
+	if payload.Email != "" {
+		user, _ := db.Queries().GetUserByEmail(ctx, payload.Email)
+		hydrated.User = &user
+	}
+
+	if (payload.Branch != "" && payload.Branch != hydrated.Repo.DefaultBranch) || payload.ShouldFetchParent {
+		parent, err := durable.
+			OnCore().
+			QueryWorkflow(ctx, hydrated.RepoWorkflowOptions(), repos.QueryRepoForEventParent, payload.Branch)
+
+		if err == nil {
+			_ = parent.Get(&hydrated.ParentID)
+		}
+	}
+
+	return hydrated, nil
+}
+
+// SignalRepo signals a local repository event to the core workflow.
+func SignalRepo[P events.Payload](ctx context.Context, hydrated *defs.HydratedQuantmEvent[P]) error {
+	_, err := durable.OnCore().SignalWithStartWorkflow(
+		ctx,
+		hydrated.Meta.RepoWorkflowOptions(),
+		hydrated.Signal,
+		hydrated.Event,
+		repos.RepoWorkflow,
+		repos.NewRepoWorkflowState(hydrated.Meta.GetRepo(), hydrated.Meta.GetRepoChatLink()),
+	)
+
+	return err
+}
+
+// create_repo creates the core repository for the bare repository at the given path, owned by the configured org.
+func create_repo(ctx context.Context, path, url string) error {
+	org_id, err := uuid.Parse(config.Instance().OrgID)
+	if err != nil {
+		return err
+	}
+
+	params := entities.CreateRepoParams{
+		OrgID:  org_id,
+		Hook:   int32(eventsv1.RepoHook_REPO_HOOK_LOCAL),
+		HookID: fns.HookID(url),
+		Name:   fns.RepoName(path),
+		Url:    url,
+	}
+
+	_, err = db.Queries().CreateRepo(ctx, params)
+
+	return err
+}
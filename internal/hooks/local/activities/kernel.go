@@ -0,0 +1,141 @@
+package activities
+
+import (
+	"context"
+	"net/url"
+
+	git "github.com/jeffwelling/git2go/v37"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/local/config"
+	pkgerrors "go.breu.io/quantm/internal/hooks/local/errors"
+)
+
+type (
+	// Kernel implements kernel.Repo for the bare repositories. Refs are written to the repository directly, without
+	// going through the post-receive hook. Pull requests, labels, comments and statuses have no local equivalent.
+	Kernel struct {
+		kernel.BaseRepo
+	}
+)
+
+// TokenizedCloneUrl returns the clone url of the repository. Local repositories are cloned straight from the
+// filesystem, so the url is the file:// url the repository was created with and carries no token.
+func (k *Kernel) TokenizedCloneUrl(_ context.Context, repo *entities.Repo) (string, error) {
+	return repo.Url, nil
+}
+
+func (k *Kernel) CreateRef(_ context.Context, repo *entities.Repo, ref, sha string) error {
+	return k.write_ref(repo, ref, sha, false, false)
+}
+
+func (k *Kernel) UpdateRef(_ context.Context, repo *entities.Repo, ref, sha string, force bool) error {
+	return k.write_ref(repo, ref, sha, true, force)
+}
+
+func (k *Kernel) DeleteRef(_ context.Context, repo *entities.Repo, ref string) error {
+	gitrepo, err := k.open(repo)
+	if err != nil {
+		return err
+	}
+
+	defer gitrepo.Free()
+
+	reference, err := gitrepo.References.Lookup(ref)
+	if err != nil {
+		return err
+	}
+
+	defer reference.Free()
+
+	return reference.Delete()
+}
+
+func (k *Kernel) ListBranches(_ context.Context, repo *entities.Repo) ([]string, error) {
+	gitrepo, err := k.open(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	defer gitrepo.Free()
+
+	iter, err := gitrepo.NewBranchIterator(git.BranchLocal)
+	if err != nil {
+		return nil, err
+	}
+
+	defer iter.Free()
+
+	branches := make([]string, 0)
+
+	err = iter.ForEach(func(branch *git.Branch, _ git.BranchType) error {
+		name, err := branch.Name()
+		if err != nil {
+			return err
+		}
+
+		branches = append(branches, name)
+
+		return nil
+	})
+
+	return branches, err
+}
+
+// write_ref creates or updates the ref. Unless forced, an update must be a fast-forward.
+func (k *Kernel) write_ref(repo *entities.Repo, ref, sha string, update, force bool) error {
+	gitrepo, err := k.open(repo)
+	if err != nil {
+		return err
+	}
+
+	defer gitrepo.Free()
+
+	target, err := git.NewOid(sha)
+	if err != nil {
+		return err
+	}
+
+	if update && !force {
+		current, err := gitrepo.References.Lookup(ref)
+		if err != nil {
+			return err
+		}
+
+		defer current.Free()
+
+		ok, err := gitrepo.DescendantOf(target, current.Target())
+		if err != nil {
+			return err
+		}
+
+		if !ok && !target.Equal(current.Target()) {
+			return pkgerrors.ErrNotFastForward
+		}
+	}
+
+	created, err := gitrepo.References.Create(ref, target, update, "quantm: update "+ref)
+	if err != nil {
+		return err
+	}
+
+	created.Free()
+
+	return nil
+}
+
+// open opens the bare repository the url of the repo points to, making sure it is inside the configured root.
+func (k *Kernel) open(repo *entities.Repo) (*git.Repository, error) {
+	parsed, err := url.Parse(repo.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := config.Instance().Resolve(parsed.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return git.OpenRepository(path)
+}
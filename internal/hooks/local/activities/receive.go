@@ -0,0 +1,194 @@
+package activities
+
+import (
+	"context"
+
+	git "github.com/jeffwelling/git2go/v37"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/hooks/local/config"
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Receive groups all the activities required for the local post-receive.
+	Receive struct{}
+)
+
+const (
+	// max_commits mirrors the limit of commits GitHub sends with a push event.
+	max_commits = 20
+)
+
+// HydrateLocalEvent hydrates the ref or push event with the given parameters.
+func (r *Receive) HydrateLocalEvent(ctx context.Context, params *defs.HydratedRepoEventPayload) (*defs.HydratedRepoEvent, error) {
+	return HydrateRepoEvent(ctx, params)
+}
+
+// ReadLocalPush reads the commits of the push from the bare repository, oldest first. The post-receive hook only knows
+// the shas before and after the push, so unlike the git providers, the commits must be walked. For a newly created
+// branch, only the head commit is reported.
+func (r *Receive) ReadLocalPush(ctx context.Context, payload *defs.ReadPushPayload) ([]*eventsv1.Commit, error) {
+	path, err := config.Instance().Resolve(payload.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := git.OpenRepository(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer repo.Free()
+
+	after, err := git.NewOid(payload.After)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.Before == defs.NoCommit {
+		commit, err := repo.LookupCommit(after)
+		if err != nil {
+			return nil, err
+		}
+
+		defer commit.Free()
+
+		result, err := r.commit_to_proto(repo, commit)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*eventsv1.Commit{result}, nil
+	}
+
+	before, err := git.NewOid(payload.Before)
+	if err != nil {
+		return nil, err
+	}
+
+	walk, err := repo.Walk()
+	if err != nil {
+		return nil, err
+	}
+
+	defer walk.Free()
+
+	walk.Sorting(git.SortTopological | git.SortReverse)
+
+	if err := walk.Push(after); err != nil {
+		return nil, err
+	}
+
+	if err := walk.Hide(before); err != nil {
+		return nil, err
+	}
+
+	commits := make([]*eventsv1.Commit, 0)
+
+	var failed error // Iterate stops on false, but does not surface the error of the callback.
+
+	err = walk.Iterate(func(commit *git.Commit) bool {
+		result, err := r.commit_to_proto(repo, commit)
+		if err != nil {
+			failed = err
+			return false
+		}
+
+		commits = append(commits, result)
+
+		return true
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if failed != nil {
+		return nil, failed
+	}
+
+	if len(commits) > max_commits {
+		commits = commits[len(commits)-max_commits:]
+	}
+
+	return commits, nil
+}
+
+// SignalRepoWithLocalPush signals the repository with the hydrated push event.
+func (r *Receive) SignalRepoWithLocalPush(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.Push]) error {
+	return SignalRepo(ctx, hydrated)
+}
+
+// SignalRepoWithLocalRef signals the repository with the hydrated branch event.
+func (r *Receive) SignalRepoWithLocalRef(ctx context.Context, hydrated *defs.HydratedQuantmEvent[eventsv1.GitRef]) error {
+	return SignalRepo(ctx, hydrated)
+}
+
+// commit_to_proto converts the commit, diffing it against its first parent to get the changed files.
+func (r *Receive) commit_to_proto(repo *git.Repository, commit *git.Commit) (*eventsv1.Commit, error) {
+	result := &eventsv1.Commit{
+		Sha:       commit.Id().String(),
+		Message:   commit.Message(),
+		Author:    &eventsv1.Author{Name: commit.Author().Name, Email: commit.Author().Email},
+		Committer: &eventsv1.Author{Name: commit.Committer().Name, Email: commit.Committer().Email},
+		Timestamp: timestamppb.New(commit.Author().When),
+		Added:     make([]string, 0),
+		Removed:   make([]string, 0),
+		Modified:  make([]string, 0),
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tree.Free()
+
+	var parent *git.Tree // nil for the root commit, diffing against the empty tree.
+
+	if commit.ParentCount() > 0 {
+		first := commit.Parent(0)
+		defer first.Free()
+
+		parent, err = first.Tree()
+		if err != nil {
+			return nil, err
+		}
+
+		defer parent.Free()
+	}
+
+	opts, _ := git.DefaultDiffOptions()
+
+	diff, err := repo.DiffTreeToTree(parent, tree, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = diff.Free() }()
+
+	deltas, err := diff.NumDeltas()
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := 0; idx < deltas; idx++ {
+		delta, err := diff.Delta(idx)
+		if err != nil {
+			return nil, err
+		}
+
+		switch delta.Status { // nolint:exhaustive
+		case git.DeltaAdded:
+			result.Added = append(result.Added, delta.NewFile.Path)
+		case git.DeltaDeleted:
+			result.Removed = append(result.Removed, delta.OldFile.Path)
+		default:
+			result.Modified = append(result.Modified, delta.NewFile.Path)
+		}
+	}
+
+	return result, nil
+}
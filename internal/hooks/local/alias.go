@@ -0,0 +1,25 @@
+package local
+
+import (
+	"go.breu.io/quantm/internal/hooks/local/activities"
+	"go.breu.io/quantm/internal/hooks/local/config"
+	"go.breu.io/quantm/internal/hooks/local/web"
+	"go.breu.io/quantm/internal/hooks/local/workflows"
+)
+
+type (
+	ReceiveActivity = activities.Receive
+
+	KernelImpl = activities.Kernel
+
+	Config  = config.Config
+	Webhook = web.Webhook
+)
+
+var (
+	Configure  = config.Configure
+	WithConfig = config.WithConfig
+	Get        = config.Instance
+
+	ReceiveWorkflow = workflows.LocalReceive
+)
@@ -0,0 +1,15 @@
+package cast
+
+import (
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/local/defs"
+)
+
+// RepoForLocalToHydratedRepoEvent converts a database row into a HydratedEvent.
+func RepoForLocalToHydratedRepoEvent(row entities.GetRepoForLocalRow) *defs.HydratedRepoEvent {
+	return &defs.HydratedRepoEvent{
+		Repo:      &row.Repo,
+		Org:       &row.Org,
+		ChatLinks: &defs.ChatLinks{},
+	}
+}
@@ -0,0 +1,30 @@
+package cast
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func RefToProto(receive *defs.Receive) eventsv1.GitRef {
+	return eventsv1.GitRef{
+		Ref:  receive.Ref,
+		Kind: "branch",
+	}
+}
+
+// PushToProto converts the ref update into a push. The commits are not part of the post-receive input, they are read
+// from the repository by the ReadLocalPush activity.
+func PushToProto(receive *defs.Receive, name string, commits []*eventsv1.Commit) eventsv1.Push {
+	return eventsv1.Push{
+		Ref:        receive.Ref,
+		Before:     receive.Before,
+		After:      receive.After,
+		Repository: name,
+		Timestamp:  timestamppb.New(time.Now()),
+		Commits:    commits,
+	}
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	pkgerrors "go.breu.io/quantm/internal/hooks/local/errors"
+)
+
+type (
+	// Config holds configuration settings for the local hook. The local hook serves bare git repositories from the
+	// filesystem, so that the core workflows can be exercised without a git provider.
+	Config struct {
+		Root  string `koanf:"ROOT" validate:"required,dir"`    // Directory containing the bare repositories.
+		OrgID string `koanf:"ORG_ID" validate:"required,uuid"` // Org owning the repositories, created on first push.
+	}
+
+	// ConfigOption is a function that modifies a Config.
+	ConfigOption func(*Config)
+)
+
+func (cfg *Config) Validate() error {
+	validate := validator.New()
+	return validate.Struct(cfg)
+}
+
+// Enabled reports whether the local hook is configured.
+func (cfg *Config) Enabled() bool {
+	return cfg.Root != ""
+}
+
+// Start is a no-op function that satisfies the graceful Service interface.
+func (cfg *Config) Start(ctx context.Context) error {
+	slog.Info("hooks/local: configured", "root", cfg.Root, "org_id", cfg.OrgID)
+
+	return nil
+}
+
+// Stop is a no-op function that satisfies the graceful Service interface.
+func (cfg *Config) Stop(ctx context.Context) error { return nil }
+
+// Resolve returns the absolute path of the repository, making sure that it is inside the configured root. Relative
+// paths are resolved against the root.
+func (cfg *Config) Resolve(path string) (string, error) {
+	root, err := filepath.Abs(cfg.Root)
+	if err != nil {
+		return "", err
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+
+	path = filepath.Clean(path)
+
+	if path != root && !strings.HasPrefix(path, root+string(filepath.Separator)) {
+		return "", pkgerrors.ErrOutsideRoot
+	}
+
+	return path, nil
+}
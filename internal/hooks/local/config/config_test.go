@@ -0,0 +1,32 @@
+package config_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/hooks/local/config"
+	pkgerrors "go.breu.io/quantm/internal/hooks/local/errors"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	cfg := &config.Config{Root: root}
+
+	path, err := cfg.Resolve("example.git")
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "example.git"), path)
+
+	path, err = cfg.Resolve(filepath.Join(root, "nested", "..", "example.git"))
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(root, "example.git"), path)
+
+	_, err = cfg.Resolve("../outside.git")
+	assert.ErrorIs(t, err, pkgerrors.ErrOutsideRoot)
+
+	_, err = cfg.Resolve(root + "-sibling/example.git")
+	assert.ErrorIs(t, err, pkgerrors.ErrOutsideRoot)
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"log/slog"
+	"sync"
+)
+
+var (
+	_c    *Config   // Global connection instance.
+	_once sync.Once // Ensures connection initialization occurs only once.
+)
+
+// WithRoot sets the Root field of the Config.
+func WithRoot(root string) ConfigOption {
+	return func(config *Config) {
+		config.Root = root
+	}
+}
+
+// WithOrgID sets the OrgID field of the Config.
+func WithOrgID(id string) ConfigOption {
+	return func(config *Config) {
+		config.OrgID = id
+	}
+}
+
+// WithConfig copies the values from the given Config into the target Config.
+func WithConfig(cfg *Config) ConfigOption {
+	return func(config *Config) {
+		config.Root = cfg.Root
+		config.OrgID = cfg.OrgID
+	}
+}
+
+// Configure returns the singleton instance of the local hook configuration.
+//
+// The function uses a `sync.Once` to ensure that the configuration is initialized only once.
+func Configure(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		_c = &Config{}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
+
+func Instance(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		slog.Warn("local: instance not initialized, this should not happen. Make sure that the configuration is loaded before calling this function.") // nolint
+
+		_c = &Config{}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
@@ -0,0 +1,37 @@
+package defs
+
+import (
+	"strings"
+)
+
+const (
+	// NoCommit is the sha git uses for the missing side of a ref update, i.e. before for created and after for deleted
+	// refs.
+	NoCommit = "0000000000000000000000000000000000000000"
+)
+
+type (
+	// Receive is a single ref update of a push to a bare repository, as reported to the post-receive hook.
+	Receive struct {
+		Path   string `json:"path"`   // Path of the bare repository, absolute or relative to the root.
+		Ref    string `json:"ref"`    // Full name of the updated ref, e.g. refs/heads/main.
+		Before string `json:"before"` // Sha of the ref before the update.
+		After  string `json:"after"`  // Sha of the ref after the update.
+		Pusher string `json:"pusher"` // Email of the pusher, optional.
+	}
+)
+
+// IsBranch reports whether the updated ref is a branch.
+func (r *Receive) IsBranch() bool {
+	return strings.HasPrefix(r.Ref, "refs/heads/")
+}
+
+// IsCreated reports whether the ref was created by the push.
+func (r *Receive) IsCreated() bool {
+	return r.Before == NoCommit
+}
+
+// IsDeleted reports whether the ref was deleted by the push.
+func (r *Receive) IsDeleted() bool {
+	return r.After == NoCommit
+}
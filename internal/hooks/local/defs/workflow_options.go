@@ -0,0 +1,23 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/durable"
+)
+
+// NewReceiveWorkflowOptions generates a workflow ID for a ref update of a local repository. The repository is identified
+// by its hook id, and the update by the sha after the push, or before it when the ref was deleted.
+//
+//	io.ctrlplane.hooks.local.repo.${hook_id}.${ref}.receive.${sha}.${action}
+func NewReceiveWorkflowOptions(hook_id uuid.UUID, ref, sha, action string) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("local"),
+		durable.WithSubject("repo"),
+		durable.WithSubjectID(hook_id.String()),
+		durable.WithKind(ref),
+		durable.WithScope("receive"),
+		durable.WithScopeID(sha),
+		durable.WithAction(action),
+	)
+}
@@ -0,0 +1,11 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidReceiveLine = errors.New("invalid post-receive line, expected '<old> <new> <ref>'")
+	ErrOutsideRoot        = errors.New("repository is outside of the configured root")
+	ErrNotFastForward     = errors.New("ref update is not a fast-forward")
+)
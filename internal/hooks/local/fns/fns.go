@@ -0,0 +1,38 @@
+package fns
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/local/errors"
+)
+
+// RepoUrl returns the file:// url of the bare repository at the given absolute path. The url doubles as the clone url.
+func RepoUrl(path string) string {
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(path)}).String()
+}
+
+// HookID derives a stable hook id from the url of the repository. Unlike the git providers, a bare repository has no
+// id of its own, so the same path always maps to the same repo.
+func HookID(repo_url string) uuid.UUID {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte(repo_url))
+}
+
+// RepoName returns the name of the repository, i.e. the directory name without the ".git" suffix.
+func RepoName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), ".git")
+}
+
+// ParseReceiveLine parses a line written by git to the stdin of the post-receive hook, i.e. "<old> <new> <ref>".
+func ParseReceiveLine(path, line string) (*defs.Receive, error) {
+	parts := strings.Fields(line)
+	if len(parts) != 3 {
+		return nil, pkgerrors.ErrInvalidReceiveLine
+	}
+
+	return &defs.Receive{Path: path, Before: parts[0], After: parts[1], Ref: parts[2]}, nil
+}
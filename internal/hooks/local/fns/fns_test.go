@@ -0,0 +1,44 @@
+package fns_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/local/errors"
+	"go.breu.io/quantm/internal/hooks/local/fns"
+)
+
+func TestRepoUrl(t *testing.T) {
+	t.Parallel()
+
+	url := fns.RepoUrl("/srv/git/example.git")
+
+	assert.Equal(t, "file:///srv/git/example.git", url)
+	assert.Equal(t, fns.HookID(url), fns.HookID("file:///srv/git/example.git"))
+	assert.NotEqual(t, fns.HookID(url), fns.HookID("file:///srv/git/other.git"))
+	assert.Equal(t, "example", fns.RepoName("/srv/git/example.git"))
+}
+
+func TestParseReceiveLine(t *testing.T) {
+	t.Parallel()
+
+	sha := "9fceb02d0ae598e95dc970b74767f19372d61af8"
+
+	receive, err := fns.ParseReceiveLine("/srv/git/example.git", defs.NoCommit+" "+sha+" refs/heads/feature\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "/srv/git/example.git", receive.Path)
+	assert.Equal(t, "refs/heads/feature", receive.Ref)
+	assert.True(t, receive.IsBranch())
+	assert.True(t, receive.IsCreated())
+	assert.False(t, receive.IsDeleted())
+
+	receive, err = fns.ParseReceiveLine("/srv/git/example.git", sha+" "+defs.NoCommit+" refs/tags/v1.0.0")
+	assert.NoError(t, err)
+	assert.False(t, receive.IsBranch())
+	assert.True(t, receive.IsDeleted())
+
+	_, err = fns.ParseReceiveLine("/srv/git/example.git", sha+" refs/heads/main")
+	assert.ErrorIs(t, err, pkgerrors.ErrInvalidReceiveLine)
+}
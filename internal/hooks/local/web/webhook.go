@@ -0,0 +1,70 @@
+package web
+
+import (
+	"bufio"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/local/config"
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	"go.breu.io/quantm/internal/hooks/local/fns"
+	"go.breu.io/quantm/internal/hooks/local/workflows"
+)
+
+type (
+	// Webhook receives the ref updates of the post-receive hook of local bare repositories, and schedules a transient
+	// workflow for each of them, the same way the webhooks of the git providers do.
+	//
+	// The post-receive hook pipes its stdin as is, i.e. one "<old> <new> <ref>" line per updated ref, and passes the
+	// path of the repository and, optionally, the email of the pusher as query parameters:
+	//
+	//	curl --data-binary @- "http://localhost:8000/webhooks/local?path=$(pwd)&pusher=$(git config user.email)"
+	Webhook struct{}
+)
+
+// Handler handles the post-receive of a local bare repository.
+func (h *Webhook) Handler(ctx echo.Context) error {
+	path, err := config.Instance().Resolve(ctx.QueryParam("path"))
+	if err != nil {
+		return erratic.NewBadRequestError(erratic.HooksLocalModule).WithReason("invalid repository path").Wrap(err)
+	}
+
+	hook_id := fns.HookID(fns.RepoUrl(path))
+	scanner := bufio.NewScanner(ctx.Request().Body)
+
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == "" {
+			continue
+		}
+
+		receive, err := fns.ParseReceiveLine(path, scanner.Text())
+		if err != nil {
+			return erratic.NewBadRequestError(erratic.HooksLocalModule).WithReason("invalid payload").Wrap(err)
+		}
+
+		receive.Pusher = ctx.QueryParam("pusher")
+
+		sha, action := receive.After, "pushed"
+		if receive.IsDeleted() {
+			sha, action = receive.Before, "deleted"
+		}
+
+		opts := defs.NewReceiveWorkflowOptions(hook_id, receive.Ref, sha, action)
+
+		if _, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.LocalReceive, receive); err != nil {
+			slog.Error("failed to signal workflow", "error", err.Error())
+			return erratic.NewSystemError(erratic.HooksLocalModule).Wrap(err)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return erratic.NewSystemError(erratic.HooksLocalModule).WithReason("failed to read request body").Wrap(err)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,39 @@
+package workflows
+
+import (
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// new_event creates a new QuantmEvent for the hydrated repository, setting the parent, team and user, if known.
+func new_event[P events.Payload](
+	meta *defs.HydratedRepoEvent, scope events.Scope, action events.Action, payload *P,
+) *events.Event[eventsv1.RepoHook, P] {
+	event := events.
+		New[eventsv1.RepoHook, P]().
+		SetHook(eventsv1.RepoHook_REPO_HOOK_LOCAL).
+		SetScope(scope).
+		SetAction(action).
+		SetSource(meta.GetRepoUrl()).
+		SetOrg(meta.GetOrgID()).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(meta.GetRepoID()).
+		SetPayload(payload)
+
+	if meta.GetParentID() != uuid.Nil {
+		event.SetParents(meta.GetParentID())
+	}
+
+	if meta.GetTeam() != nil {
+		event.SetTeam(meta.GetTeamID())
+	}
+
+	if meta.GetUser() != nil {
+		event.SetUser(meta.GetUserID())
+	}
+
+	return event
+}
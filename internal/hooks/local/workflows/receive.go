@@ -0,0 +1,91 @@
+package workflows
+
+import (
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/local/activities"
+	"go.breu.io/quantm/internal/hooks/local/cast"
+	"go.breu.io/quantm/internal/hooks/local/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// The LocalReceive workflow processes a single ref update of a push to a local bare repository, as reported by the
+// post-receive hook. Branch creation and deletion are converted into a GitRef event, and every update that leaves the
+// branch behind is converted into a Push event, the same way the GitHub hook reports them with separate webhooks. Each
+// event is hydrated, persisted and finally signaled to the repository.
+func LocalReceive(ctx workflow.Context, receive *defs.Receive) error {
+	acts := &activities.Receive{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+	logger := workflow.GetLogger(ctx)
+
+	if !receive.IsBranch() {
+		logger.Warn("receive: unhandled ref", "ref", receive.Ref)
+		return nil
+	}
+
+	hre := &defs.HydratedRepoEvent{} // hre -> hydrated repo event
+
+	{
+		payload := &defs.HydratedRepoEventPayload{
+			Path:   receive.Path,
+			Email:  receive.Pusher,
+			Branch: repos.BranchNameFromRef(receive.Ref),
+		}
+		if err := workflow.ExecuteActivity(ctx, acts.HydrateLocalEvent, payload).Get(ctx, hre); err != nil {
+			return err
+		}
+	}
+
+	if receive.IsCreated() || receive.IsDeleted() {
+		if err := ref(ctx, acts, receive, hre); err != nil {
+			return err
+		}
+	}
+
+	if receive.IsDeleted() {
+		return nil
+	}
+
+	commits := make([]*eventsv1.Commit, 0)
+
+	{
+		payload := &defs.ReadPushPayload{Path: receive.Path, Ref: receive.Ref, Before: receive.Before, After: receive.After}
+		if err := workflow.ExecuteActivity(ctx, acts.ReadLocalPush, payload).Get(ctx, &commits); err != nil {
+			return err
+		}
+	}
+
+	proto := cast.PushToProto(receive, hre.Repo.Name, commits)
+	event := new_event(hre, events.ScopePush, events.ActionCreated, &proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.Push]{Event: event, Meta: hre, Signal: repos.SignalPush}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithLocalPush, hevent).Get(ctx, nil)
+}
+
+// ref persists and signals the creation or deletion of the branch.
+func ref(ctx workflow.Context, acts *activities.Receive, receive *defs.Receive, hre *defs.HydratedRepoEvent) error {
+	action := events.ActionCreated
+	if receive.IsDeleted() {
+		action = events.ActionDeleted
+	}
+
+	proto := cast.RefToProto(receive)
+	event := new_event(hre, events.ScopeBranch, action, &proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	hevent := &defs.HydratedQuantmEvent[eventsv1.GitRef]{Event: event, Meta: hre, Signal: repos.SignalRef}
+
+	return workflow.ExecuteActivity(ctx, acts.SignalRepoWithLocalRef, hevent).Get(ctx, nil)
+}
@@ -0,0 +1,164 @@
+package activities
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/slack-go/slack"
+	"go.temporal.io/api/serviceerror"
+
+	"go.breu.io/quantm/internal/core/kernel"
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/slack/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Command groups the activities required for the commands given on Slack, with the buttons on the messages or with
+	// the slash command.
+	Command struct{}
+)
+
+// AuthorizeSlackUser maps the Slack user to the quantm user it is linked to with chat_links, and checks that the user
+// belongs to the organization owning the repo. Except for the status, the commands act on the repo, so the user must
+// also have write access to the repo on its provider, as for the commands given on the pull requests. A denial is not
+// an error, the reason is responded to the user instead.
+func (c *Command) AuthorizeSlackUser(ctx context.Context, request *defs.Request) (*defs.Authz, error) {
+	link, err := db.Queries().GetChatLinkByProviderUser(ctx, entities.GetChatLinkByProviderUserParams{
+		Hook:   int32(eventsv1.ChatHook_CHAT_HOOK_SLACK),
+		TeamID: request.TeamID,
+		UserID: request.UserID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &defs.Authz{Reason: "Your Slack account is not linked to a quantm user, link it from the quantm settings."}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := db.Queries().GetUserByID(ctx, link.LinkTo)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := c.repo(ctx, request, user.OrgID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &defs.Authz{Reason: "The repo is not found, or is not connected to quantm."}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if repo.OrgID != user.OrgID {
+		return &defs.Authz{Reason: "You are not a member of the organization owning the repo."}, nil
+	}
+
+	if request.Command != defs.CommandStatus {
+		reason, err := c.can_write(ctx, &repo, user.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if reason != "" {
+			return &defs.Authz{Reason: reason}, nil
+		}
+	}
+
+	return &defs.Authz{Allowed: true, UserID: user.ID, Repo: &repo}, nil
+}
+
+// QuerySlackMergeQueue returns the merge queue of the repo. A repo that has never queued a pull request has no trunk
+// workflow, and its queue is empty.
+func (c *Command) QuerySlackMergeQueue(ctx context.Context, repo *entities.Repo) ([]repos.QueuePosition, error) {
+	queue := make([]repos.QueuePosition, 0)
+
+	result, err := durable.OnCore().QueryWorkflow(ctx, repos.TrunkWorkflowOptions(repo), repos.QueryMergeQueue)
+	if err != nil {
+		var notfound *serviceerror.NotFound
+		if errors.As(err, &notfound) {
+			return queue, nil
+		}
+
+		return nil, err
+	}
+
+	if err := result.Get(&queue); err != nil {
+		return nil, err
+	}
+
+	return queue, nil
+}
+
+// SignalRepoWithSlackCommand hands the command over to the repo.
+func (c *Command) SignalRepoWithSlackCommand(
+	ctx context.Context, repo *entities.Repo, event *events.Event[eventsv1.RepoHook, eventsv1.Command],
+) error {
+	var chat *entities.ChatLink
+
+	link, err := db.Queries().GetChatLink(ctx, repo.ID)
+	if err == nil {
+		chat = &link
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	_, err = durable.OnCore().SignalWithStartWorkflow(
+		ctx,
+		repos.RepoWorkflowOptions(repo),
+		repos.SignalCommand,
+		event,
+		repos.RepoWorkflow,
+		repos.NewRepoWorkflowState(repo, chat),
+	)
+
+	return err
+}
+
+// RespondToSlack responds to the interaction or the slash command, visible only to the user who gave it.
+func (c *Command) RespondToSlack(ctx context.Context, payload *defs.ResponsePayload) error {
+	msg := &slack.WebhookMessage{Text: payload.Text, ResponseType: slack.ResponseTypeEphemeral}
+
+	return slack.PostWebhookContext(ctx, payload.URL, msg)
+}
+
+// can_write checks the write access of the user to the repo through the hook of the repo, returning the reason of a
+// denial. A hook unable to check the access denies the commands.
+func (c *Command) can_write(ctx context.Context, repo *entities.Repo, user_id uuid.UUID) (string, error) {
+	hook := kernel.Get().RepoHook(eventsv1.RepoHook(repo.Hook))
+	if hook == nil {
+		return "The provider of the repo is not available.", nil
+	}
+
+	allowed, err := hook.CanWrite(ctx, repo, user_id)
+	if errors.Is(err, kernel.ErrNotSupported) {
+		return "Commands from Slack are not supported for the provider of the repo.", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if !allowed {
+		return "You need write access to the repo, with the account on its provider linked to your quantm user.", nil
+	}
+
+	return "", nil
+}
+
+// repo looks up the repo of the request, by id for the buttons and by name within the organization of the user for
+// the slash command.
+func (c *Command) repo(ctx context.Context, request *defs.Request, org_id uuid.UUID) (entities.Repo, error) {
+	if request.RepoName != "" {
+		return db.Queries().GetOrgRepoByName(ctx, entities.GetOrgRepoByNameParams{OrgID: org_id, Name: request.RepoName})
+	}
+
+	return db.Queries().GetRepo(ctx, request.RepoID)
+}
@@ -2,16 +2,17 @@ package activities
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"strconv"
-	"time"
+	"errors"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"github.com/slack-go/slack"
 
+	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/slack/blocks"
 	"go.breu.io/quantm/internal/hooks/slack/cast"
 	"go.breu.io/quantm/internal/hooks/slack/config"
 	"go.breu.io/quantm/internal/hooks/slack/fns"
@@ -19,94 +20,177 @@ import (
 )
 
 type (
-	// Activities groups all the activities for the slack provider.
+	// Kernel groups the notifications sent to Slack. The first notification of a branch starts a thread, the ones after
+	// it are replies in that thread. The thread is closed once the pull request of the branch is merged.
 	Kernel struct{}
-)
 
-const (
-	footer = "Powered by quantm.io"
+	// destination is where the notifications of the subject are sent.
+	destination struct {
+		client *slack.Client
+		target string
+	}
 )
 
-var (
-	ts = json.Number(strconv.FormatInt(time.Now().Unix(), 10))
-)
+func (k *Kernel) NotifyLinesExceed(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error {
+	return k.notify(ctx, event.Subject, event.Payload.GetBranch(), cast.DiffEventToMessage(event))
+}
 
-func (k *Kernel) NotifyLinesExceed(
-	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff],
+func (k *Kernel) NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error {
+	return k.notify(ctx, event.Subject, event.Payload.GetHeadBranch(), cast.MergeEventToMessage(event))
+}
+
+func (k *Kernel) NotifyBranchDrift(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) error {
+	return k.notify(ctx, event.Subject, event.Payload.GetBranch(), cast.DriftEventToMessage(event))
+}
+
+// NotifyConflictResolved replies in the thread of the branch. Without a thread, there is no conflict to follow up on.
+func (k *Kernel) NotifyConflictResolved(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge],
 ) error {
-	var err error
+	dest, err := k.destination(ctx, event.Subject)
+	if err != nil {
+		return err
+	}
+
+	thread, err := k.thread(ctx, event.Subject, event.Payload.GetHeadBranch(), dest)
+	if err != nil || thread == nil {
+		return err
+	}
 
-	token := ""
-	target := ""
+	_, _, err = fns.PostMessage(dest.client, thread.Channel, cast.ConflictResolvedEventToMessage(event), thread.Ts)
 
-	if event.Subject.UserID != uuid.Nil {
-		token, target, err = k.to_user(ctx, event.Subject.UserID)
-		if err != nil {
-			return err
-		}
-	} else {
-		token, target, err = k.to_repo(ctx, event.Subject.ID)
-		if err != nil {
-			return err
-		}
+	return err
+}
+
+// NotifyPullRequestMerged updates the first message of the thread of the branch, replies in the thread and closes it,
+// so that the next notifications on a branch with the same name start a new thread.
+func (k *Kernel) NotifyPullRequestMerged(
+	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest],
+) error {
+	dest, err := k.destination(ctx, event.Subject)
+	if err != nil {
+		return err
 	}
 
-	attachment := slack.Attachment{
-		Color:      "warning",
-		Pretext:    "The number of lines in this pull request exceeds the allowed threshold. Please review and adjust accordingly.",
-		Fallback:   "Line Exceed Detected",
-		MarkdownIn: []string{"fields"},
-		Footer:     footer,
-		Fields:     fields_lines_exceeded(event),
-		Ts:         ts,
+	thread, err := k.thread(ctx, event.Subject, event.Payload.GetHeadBranch(), dest)
+	if err != nil || thread == nil {
+		return err
 	}
 
-	client, err := config.GetSlackClient(token)
+	if err := fns.UpdateMessage(dest.client, thread.Channel, thread.Ts, cast.PullRequestMergedEventToUpdate(event)); err != nil {
+		return err
+	}
+
+	if _, _, err := fns.PostMessage(dest.client, thread.Channel, cast.PullRequestMergedEventToMessage(event), thread.Ts); err != nil {
+		return err
+	}
+
+	return db.Queries().DeleteChatThread(ctx, thread.ID)
+}
+
+// NotifyDigest sends the digest on its own, outside of the threads of the branches.
+func (k *Kernel) NotifyDigest(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) error {
+	dest, err := k.destination(ctx, event.Subject)
 	if err != nil {
 		return err
 	}
 
-	return fns.SendMessage(client, target, attachment)
+	_, _, err = fns.PostMessage(dest.client, dest.target, cast.DigestEventToMessage(event), "")
+
+	return err
 }
 
-func (k *Kernel) NotifyMergeConflict(
-	ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge],
-) error {
-	var err error
+// NotifyReport sends the scheduled report on its own, outside of the threads of the branches.
+func (k *Kernel) NotifyReport(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Report]) error {
+	dest, err := k.destination(ctx, event.Subject)
+	if err != nil {
+		return err
+	}
 
-	token := ""
-	target := ""
+	_, _, err = fns.PostMessage(dest.client, dest.target, cast.ReportEventToMessage(event), "")
+
+	return err
+}
+
+// notify sends the message as a reply in the thread of the branch, or starts the thread if there is none yet.
+func (k *Kernel) notify(ctx context.Context, subject events.Subject, branch string, msg *blocks.Message) error {
+	dest, err := k.destination(ctx, subject)
+	if err != nil {
+		return err
+	}
 
-	if event.Subject.UserID != uuid.Nil {
-		token, target, err = k.to_user(ctx, event.Subject.UserID)
-		if err != nil {
-			return err
-		}
+	thread, err := k.thread(ctx, subject, branch, dest)
+	if err != nil {
+		return err
+	}
+
+	if thread != nil {
+		_, _, err = fns.PostMessage(dest.client, thread.Channel, msg, thread.Ts)
+		return err
+	}
+
+	channel, ts, err := fns.PostMessage(dest.client, dest.target, msg, "")
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Queries().UpsertChatThread(ctx, entities.UpsertChatThreadParams{
+		RepoID:  subject.ID,
+		Branch:  branch,
+		Hook:    int32(eventsv1.ChatHook_CHAT_HOOK_SLACK),
+		Target:  dest.target,
+		Channel: channel,
+		Ts:      ts,
+	})
+
+	return err
+}
+
+// destination resolves the client and the target of the notifications, the user if the subject has one, else the
+// channel of the repo.
+func (k *Kernel) destination(ctx context.Context, subject events.Subject) (*destination, error) {
+	var (
+		token, target string
+		err           error
+	)
+
+	if subject.UserID != uuid.Nil {
+		token, target, err = k.to_user(ctx, subject.UserID)
 	} else {
-		token, target, err = k.to_repo(ctx, event.Subject.ID)
-		if err != nil {
-			return err
-		}
+		token, target, err = k.to_repo(ctx, subject.ID)
+	}
+
+	if err != nil {
+		return nil, err
 	}
 
 	client, err := config.GetSlackClient(token)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return &destination{client: client, target: target}, nil
+}
+
+// thread returns the thread of the branch at the destination, or nil if there is none.
+func (k *Kernel) thread(
+	ctx context.Context, subject events.Subject, branch string, dest *destination,
+) (*entities.ChatThread, error) {
+	thread, err := db.Queries().GetChatThread(ctx, entities.GetChatThreadParams{
+		RepoID: subject.ID,
+		Branch: branch,
+		Hook:   int32(eventsv1.ChatHook_CHAT_HOOK_SLACK),
+		Target: dest.target,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
 	}
 
-	attachment := slack.Attachment{
-		Color: "warning",
-		Pretext: fmt.Sprintf(`We've detected a merge conflict in your feature branch, <%s/tree/%s|%s>.
-    This means there are changes in your branch that clash with recent updates on the main branch (trunk).`,
-			event.Context.Source, event.Payload.HeadBranch, event.Payload.HeadBranch),
-		Fallback:   "Merge Conflict Detected",
-		MarkdownIn: []string{"fields"},
-		Footer:     footer,
-		Fields:     fields_merge_conflict(event),
-		Ts:         ts,
+	if err != nil {
+		return nil, err
 	}
 
-	return fns.SendMessage(client, target, attachment)
+	return &thread, nil
 }
 
 func (k *Kernel) to_user(ctx context.Context, link_to uuid.UUID) (string, string, error) {
@@ -120,7 +204,7 @@ func (k *Kernel) to_user(ctx context.Context, link_to uuid.UUID) (string, string
 		return "", "", err
 	}
 
-	token, err := fns.Reveal(d.BotToken, d.ProviderTeamID)
+	token, err := auth.Reveal(d.BotToken, d.ProviderTeamID)
 	if err != nil {
 		return "", "", err
 	}
@@ -139,7 +223,7 @@ func (k *Kernel) to_repo(ctx context.Context, link_to uuid.UUID) (string, string
 		return "", "", err
 	}
 
-	token, err := fns.Reveal(d.BotToken, d.WorkspaceID)
+	token, err := auth.Reveal(d.BotToken, d.WorkspaceID)
 	if err != nil {
 		return "", "", err
 	}
@@ -3,18 +3,28 @@ package slack
 import (
 	"go.breu.io/quantm/internal/hooks/slack/activities"
 	"go.breu.io/quantm/internal/hooks/slack/config"
+	"go.breu.io/quantm/internal/hooks/slack/fns"
 	"go.breu.io/quantm/internal/hooks/slack/nomad"
+	"go.breu.io/quantm/internal/hooks/slack/web"
+	"go.breu.io/quantm/internal/hooks/slack/workflows"
 )
 
 type (
 	Config = config.Config
 
-	KernelImpl = activities.Kernel
+	KernelImpl      = activities.Kernel
+	CommandActivity = activities.Command
+
+	Webhook = web.Webhook
 )
 
 var (
 	WithConfig = config.WithConfig
 	Configure  = config.Instance
 
+	CommandWorkflow = workflows.SlackCommand
+
 	NomadHandler = nomad.NewSlackServiceHandler
+
+	MigrateTokens = fns.MigrateTokens
 )
@@ -0,0 +1,166 @@
+// Package blocks is a small template layer over Slack Block Kit. The notifications are composed of the same few
+// building blocks, so that they look alike and stay within the limits of Slack.
+package blocks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"go.breu.io/quantm/internal/hooks/slack/defs"
+)
+
+const (
+	footer = "Powered by quantm.io"
+
+	// max_fields is the maximum number of fields Slack accepts on a section.
+	max_fields = 10
+
+	// max_files caps the files listed on a message, the text of a section is limited to 3000 characters.
+	max_files = 10
+)
+
+var (
+	// action_labels are the labels of the buttons, by action id.
+	action_labels = map[string]string{
+		defs.ActionRebase: "Rebase now",
+		defs.ActionMerge:  "Add to merge queue",
+		defs.ActionSnooze: "Snooze stale reminder",
+		defs.ActionOpenPR: "Open PR",
+	}
+)
+
+type (
+	// Message is a notification built from blocks. Text is shown where the blocks can not be, e.g. in the push
+	// notifications.
+	Message struct {
+		Text   string
+		Blocks []slack.Block
+	}
+
+	// Field is a labelled value on a section.
+	Field struct {
+		Title string
+		Value string
+	}
+)
+
+// New creates a message with the given fallback text. Nil blocks are skipped, so that optional blocks can be given
+// inline.
+func New(text string, blocks ...slack.Block) *Message {
+	msg := &Message{Text: text, Blocks: make([]slack.Block, 0, len(blocks))}
+
+	return msg.Append(blocks...)
+}
+
+// Append adds the blocks to the message, skipping nil blocks.
+func (m *Message) Append(blocks ...slack.Block) *Message {
+	for _, block := range blocks {
+		if block == nil || isnil(block) {
+			continue
+		}
+
+		m.Blocks = append(m.Blocks, block)
+	}
+
+	return m
+}
+
+// Header creates a header block.
+func Header(text string) *slack.HeaderBlock {
+	return slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, text, true, false))
+}
+
+// Text creates a section block with the markdown text.
+func Text(markdown string) *slack.SectionBlock {
+	return slack.NewSectionBlock(slack.NewTextBlockObject(slack.MarkdownType, markdown, false, false), nil, nil)
+}
+
+// Fields creates a section block with the fields side by side. Fields without a value are skipped, and fields beyond
+// the limit of Slack are dropped.
+func Fields(fields ...Field) *slack.SectionBlock {
+	objects := make([]*slack.TextBlockObject, 0, len(fields))
+
+	for _, field := range fields {
+		if field.Value == "" || len(objects) == max_fields {
+			continue
+		}
+
+		text := fmt.Sprintf("*%s*\n%s", field.Title, field.Value)
+		objects = append(objects, slack.NewTextBlockObject(slack.MarkdownType, text, false, false))
+	}
+
+	return slack.NewSectionBlock(nil, objects, nil)
+}
+
+// Files creates a section block listing the files under the title. Returns nil if there are no files.
+func Files(title string, files []string) *slack.SectionBlock {
+	if len(files) == 0 {
+		return nil
+	}
+
+	lines := make([]string, 0, max_files+1)
+
+	for idx, file := range files {
+		if idx == max_files {
+			lines = append(lines, fmt.Sprintf("… and %d more", len(files)-max_files))
+			break
+		}
+
+		lines = append(lines, fmt.Sprintf("• `%s`", file))
+	}
+
+	return Text(fmt.Sprintf("*%s*\n%s", title, strings.Join(lines, "\n")))
+}
+
+// Footer creates the context block closing every message.
+func Footer() *slack.ContextBlock {
+	return slack.NewContextBlock("", slack.NewTextBlockObject(slack.MarkdownType, footer, false, false))
+}
+
+// Actions creates the buttons taking the given actions on the branch. The buttons carry the repo and the branch, so
+// that the interaction can be handed over to the repo as a command. Open PR links to the pull requests of the branch.
+func Actions(value *defs.ActionValue, source string, actions ...string) *slack.ActionBlock {
+	encoded, _ := json.Marshal(value)
+	elements := make([]slack.BlockElement, 0, len(actions))
+
+	for _, action := range actions {
+		text := slack.NewTextBlockObject(slack.PlainTextType, action_labels[action], false, false)
+		button := slack.NewButtonBlockElement(action, string(encoded), text)
+
+		switch action {
+		case defs.ActionOpenPR:
+			button.URL = fmt.Sprintf("%s/pulls?q=%s", source, url.QueryEscape("is:pr head:"+value.Branch))
+		case defs.ActionMerge:
+			button.Style = slack.StylePrimary
+		}
+
+		elements = append(elements, button)
+	}
+
+	return slack.NewActionBlock("quantm.actions", elements...)
+}
+
+// Link formats a link with the given text, as understood by Slack.
+func Link(href, text string) string {
+	return fmt.Sprintf("<%s|%s>", href, text)
+}
+
+// isnil reports whether the block is a typed nil, e.g. a nil *slack.SectionBlock returned by Files.
+func isnil(block slack.Block) bool {
+	switch b := block.(type) {
+	case *slack.SectionBlock:
+		return b == nil
+	case *slack.ActionBlock:
+		return b == nil
+	case *slack.HeaderBlock:
+		return b == nil
+	case *slack.ContextBlock:
+		return b == nil
+	default:
+		return false
+	}
+}
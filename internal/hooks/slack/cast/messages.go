@@ -0,0 +1,238 @@
+package cast
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"go.breu.io/quantm/internal/core/reports"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/slack/blocks"
+	"go.breu.io/quantm/internal/hooks/slack/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+// DiffEventToMessage renders the notification for a change exceeding the line threshold of the repo.
+func DiffEventToMessage(event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) *blocks.Message {
+	lines := event.Payload.GetLines()
+	files := event.Payload.GetFiles()
+	branch := event.Payload.GetBranch()
+
+	renamed := make([]string, len(files.GetRenamed()))
+	for i, file := range files.GetRenamed() {
+		renamed[i] = file.GetOld() + " → " + file.GetNew()
+	}
+
+	value := &defs.ActionValue{RepoID: event.Subject.ID, Branch: branch}
+
+	return blocks.New(
+		fmt.Sprintf("%s exceeds the line threshold of %d lines", branch, event.Payload.GetThreshold()),
+		blocks.Header(":straight_ruler: Line threshold exceeded"),
+		blocks.Text(fmt.Sprintf(
+			"The changes on %s exceed the line threshold of the repo. Splitting them keeps the review small.",
+			tree(event.Context.Source, branch),
+		)),
+		blocks.Fields(
+			blocks.Field{Title: "Repository", Value: repo(event.Context.Source)},
+			blocks.Field{Title: "Branch", Value: tree(event.Context.Source, branch)},
+			blocks.Field{Title: "Threshold", Value: fmt.Sprintf("%d", event.Payload.GetThreshold())},
+			blocks.Field{Title: "Total Lines", Value: fmt.Sprintf("%d", lines.GetAdded()+lines.GetRemoved())},
+			blocks.Field{Title: "Lines Added", Value: fmt.Sprintf("%d", lines.GetAdded())},
+			blocks.Field{Title: "Lines Deleted", Value: fmt.Sprintf("%d", lines.GetRemoved())},
+		),
+		blocks.Files("Added Files", files.GetAdded()),
+		blocks.Files("Deleted Files", files.GetDeleted()),
+		blocks.Files("Modified Files", files.GetModified()),
+		blocks.Files("Renamed Files", renamed),
+		blocks.Actions(value, event.Context.Source, defs.ActionOpenPR),
+		blocks.Footer(),
+	)
+}
+
+// MergeEventToMessage renders the notification for a branch conflicting with the default branch.
+func MergeEventToMessage(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) *blocks.Message {
+	head := event.Payload.GetHeadBranch()
+	base := event.Payload.GetBaseBranch()
+	value := &defs.ActionValue{RepoID: event.Subject.ID, Branch: head}
+
+	return blocks.New(
+		fmt.Sprintf("%s conflicts with %s", head, base),
+		blocks.Header(":warning: Merge conflict"),
+		blocks.Text(fmt.Sprintf(
+			"%s has changes that clash with recent updates on %s. Rebasing now keeps the conflict small.",
+			tree(event.Context.Source, head), tree(event.Context.Source, base),
+		)),
+		blocks.Fields(
+			blocks.Field{Title: "Repository", Value: repo(event.Context.Source)},
+			blocks.Field{Title: "Branch", Value: tree(event.Context.Source, head)},
+			blocks.Field{Title: "Branch Head", Value: commit(event.Context.Source, event.Payload.GetHeadCommit().GetSha())},
+			blocks.Field{Title: "Conflicts With", Value: commit(event.Context.Source, event.Payload.GetBaseCommit().GetSha())},
+		),
+		blocks.Files("Conflicting Files", event.Payload.GetFiles()),
+		blocks.Actions(value, event.Context.Source, defs.ActionRebase, defs.ActionMerge, defs.ActionOpenPR),
+		blocks.Footer(),
+	)
+}
+
+// DriftEventToMessage renders the notification for a branch drifting too far behind the default branch. The days
+// since the last sync are counted up to the time of the event, so that a retried activity renders the same message.
+func DriftEventToMessage(event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) *blocks.Message {
+	branch := event.Payload.GetBranch()
+	trunk := event.Payload.GetTrunk()
+	value := &defs.ActionValue{RepoID: event.Subject.ID, Branch: branch}
+
+	days := ""
+	if event.Payload.GetSyncedAt() != nil {
+		days = fmt.Sprintf("%d", int(event.Timestamp.Sub(event.Payload.GetSyncedAt().AsTime()).Hours()/24))
+	}
+
+	return blocks.New(
+		fmt.Sprintf("%s has drifted %d commits behind %s", branch, event.Payload.GetBehind(), trunk),
+		blocks.Header(":hourglass_flowing_sand: Branch drift"),
+		blocks.Text(fmt.Sprintf(
+			"%s has drifted far behind %s. Bringing it up to date now keeps the eventual merge small and predictable.",
+			tree(event.Context.Source, branch), tree(event.Context.Source, trunk),
+		)),
+		blocks.Fields(
+			blocks.Field{Title: "Repository", Value: repo(event.Context.Source)},
+			blocks.Field{Title: "Branch", Value: tree(event.Context.Source, branch)},
+			blocks.Field{Title: "Commits Behind", Value: fmt.Sprintf("%d", event.Payload.GetBehind())},
+			blocks.Field{Title: "Commits Ahead", Value: fmt.Sprintf("%d", event.Payload.GetAhead())},
+			blocks.Field{Title: "Days Since Sync", Value: days},
+		),
+		blocks.Files("Churned Files", event.Payload.GetChurn()),
+		blocks.Actions(value, event.Context.Source, defs.ActionRebase, defs.ActionSnooze, defs.ActionOpenPR),
+		blocks.Footer(),
+	)
+}
+
+// ConflictResolvedEventToMessage renders the reply to a merge conflict, once the branch rebases cleanly again.
+func ConflictResolvedEventToMessage(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) *blocks.Message {
+	head := event.Payload.GetHeadBranch()
+	base := event.Payload.GetBaseBranch()
+
+	return blocks.New(
+		fmt.Sprintf("%s no longer conflicts with %s", head, base),
+		blocks.Text(fmt.Sprintf(
+			":white_check_mark: The conflict is resolved, %s rebases cleanly onto %s again.",
+			tree(event.Context.Source, head), tree(event.Context.Source, base),
+		)),
+	)
+}
+
+// PullRequestMergedEventToMessage renders the reply to the notifications of a branch, once its pull request is merged.
+func PullRequestMergedEventToMessage(event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest]) *blocks.Message {
+	return blocks.New(
+		fmt.Sprintf("#%d is merged into %s", event.Payload.GetNumber(), event.Payload.GetBaseBranch()),
+		blocks.Text(fmt.Sprintf(
+			":tada: %s is merged into %s.",
+			pull(event.Context.Source, event.Payload.GetNumber()), tree(event.Context.Source, event.Payload.GetBaseBranch()),
+		)),
+	)
+}
+
+// PullRequestMergedEventToUpdate renders the update of the first notification of a branch, once its pull request is
+// merged. The buttons are dropped, as there is nothing left to act upon.
+func PullRequestMergedEventToUpdate(event *events.Event[eventsv1.ChatHook, eventsv1.PullRequest]) *blocks.Message {
+	head := event.Payload.GetHeadBranch()
+
+	return blocks.New(
+		fmt.Sprintf("%s is merged", head),
+		blocks.Header(":tada: Merged"),
+		blocks.Text(fmt.Sprintf(
+			"%s is merged into %s with %s, see the thread for the earlier notifications.",
+			tree(event.Context.Source, head),
+			tree(event.Context.Source, event.Payload.GetBaseBranch()),
+			pull(event.Context.Source, event.Payload.GetNumber()),
+		)),
+		blocks.Fields(
+			blocks.Field{Title: "Repository", Value: repo(event.Context.Source)},
+			blocks.Field{Title: "Title", Value: event.Payload.GetTitle()},
+			blocks.Field{Title: "Author", Value: event.Payload.GetAuthor()},
+		),
+		blocks.Footer(),
+	)
+}
+
+// DigestEventToMessage renders the notifications deferred by the notification preferences, grouped by repo.
+func DigestEventToMessage(event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) *blocks.Message {
+	repos := make([]string, 0)
+	lines := make(map[string][]string)
+	sources := make(map[string]string)
+
+	for _, item := range event.Payload.GetItems() {
+		if _, ok := lines[item.GetRepo()]; !ok {
+			repos = append(repos, item.GetRepo())
+			sources[item.GetRepo()] = item.GetSource()
+		}
+
+		line := fmt.Sprintf("• %s: %s", tree(item.GetSource(), item.GetBranch()), item.GetSummary())
+		lines[item.GetRepo()] = append(lines[item.GetRepo()], line)
+	}
+
+	msg := blocks.New(
+		fmt.Sprintf("Your quantm digest of %d notifications", len(event.Payload.GetItems())),
+		blocks.Header(":newspaper: Your quantm digest"),
+	)
+
+	for _, name := range repos {
+		msg.Append(blocks.Text(fmt.Sprintf("*%s*\n%s", blocks.Link(sources[name], name), strings.Join(lines[name], "\n"))))
+	}
+
+	return msg.Append(blocks.Footer())
+}
+
+// ReportEventToMessage renders the scheduled report of a repo or a team.
+func ReportEventToMessage(event *events.Event[eventsv1.ChatHook, eventsv1.Report]) *blocks.Message {
+	metrics := reports.Metrics(event.Payload)
+	fields := make([]blocks.Field, len(metrics))
+
+	for i, metric := range metrics {
+		fields[i] = blocks.Field{Title: metric.Title, Value: metric.Value}
+	}
+
+	title := reports.Title(event.Payload)
+	span := reports.Span(event.Payload)
+
+	period := span
+	if source := event.Payload.GetSource(); source != "" {
+		period = fmt.Sprintf("%s, %s", repo(source), span)
+	}
+
+	msg := blocks.New(
+		fmt.Sprintf("%s, %s", title, span),
+		blocks.Header(":bar_chart: "+title),
+		blocks.Text(period),
+		blocks.Fields(fields...),
+	)
+
+	if largest := reports.Largest(event.Payload); len(largest) > 0 {
+		msg.Append(blocks.Text("*Largest pull requests*\n• " + strings.Join(largest, "\n• ")))
+	}
+
+	return msg.Append(blocks.Footer())
+}
+
+func repo(source string) string {
+	return blocks.Link(source, path.Base(source))
+}
+
+func tree(source, branch string) string {
+	return blocks.Link(fmt.Sprintf("%s/tree/%s", source, branch), branch)
+}
+
+func commit(source, sha string) string {
+	if sha == "" {
+		return ""
+	}
+
+	return blocks.Link(fmt.Sprintf("%s/commit/%s", source, sha), "`"+short(sha)+"`")
+}
+
+func pull(source string, number int64) string {
+	return blocks.Link(fmt.Sprintf("%s/pull/%d", source, number), fmt.Sprintf("#%d", number))
+}
+
+func short(sha string) string {
+	return sha[:min(len(sha), 7)]
+}
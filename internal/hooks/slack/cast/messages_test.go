@@ -0,0 +1,76 @@
+package cast_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/slack-go/slack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/slack/cast"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func TestDiffEventToMessage(t *testing.T) {
+	added := make([]string, 12)
+	for i := range added {
+		added[i] = fmt.Sprintf("file_%d.go", i)
+	}
+
+	event := &events.Event[eventsv1.ChatHook, eventsv1.Diff]{
+		Context: events.Context[eventsv1.ChatHook]{Source: "https://github.com/breuhq/quantm"},
+		Payload: &eventsv1.Diff{
+			Branch:    "feature",
+			Threshold: 500,
+			Lines:     &eventsv1.DiffLines{Added: 700, Removed: 20},
+			Files:     &eventsv1.DiffFiles{Added: added},
+		},
+	}
+
+	msg := cast.DiffEventToMessage(event)
+	assert.Equal(t, "feature exceeds the line threshold of 500 lines", msg.Text)
+
+	fields, ok := msg.Blocks[2].(*slack.SectionBlock)
+	require.True(t, ok)
+	assert.Equal(t, "*Branch*\n<https://github.com/breuhq/quantm/tree/feature|feature>", fields.Fields[1].Text)
+	assert.Equal(t, "*Threshold*\n500", fields.Fields[2].Text)
+
+	files, ok := msg.Blocks[3].(*slack.SectionBlock)
+	require.True(t, ok)
+	assert.Contains(t, files.Text.Text, "… and 2 more")
+	assert.NotContains(t, files.Text.Text, "file_10.go")
+
+	// the empty file lists are skipped, leaving the buttons and the footer.
+	require.Len(t, msg.Blocks, 6)
+
+	encoded, err := json.Marshal(msg.Blocks)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"action_id":"quantm.open_pr"`)
+}
+
+func TestMergeEventToMessage(t *testing.T) {
+	event := &events.Event[eventsv1.ChatHook, eventsv1.Merge]{
+		Context: events.Context[eventsv1.ChatHook]{Source: "https://github.com/breuhq/quantm"},
+		Payload: &eventsv1.Merge{
+			HeadBranch: "feature",
+			HeadCommit: &eventsv1.Commit{Sha: "0123456789abcdef"},
+			BaseBranch: "main",
+			BaseCommit: &eventsv1.Commit{Sha: "fedcba9876543210"},
+			Files:      []string{"a.go"},
+		},
+	}
+
+	msg := cast.MergeEventToMessage(event)
+
+	fields, ok := msg.Blocks[2].(*slack.SectionBlock)
+	require.True(t, ok)
+	require.Len(t, fields.Fields, 4)
+	assert.Equal(t, "*Conflicts With*\n<https://github.com/breuhq/quantm/commit/fedcba9876543210|`fedcba9`>", fields.Fields[3].Text)
+
+	actions, ok := msg.Blocks[4].(*slack.ActionBlock)
+	require.True(t, ok)
+	assert.Len(t, actions.Elements.ElementSet, 3)
+}
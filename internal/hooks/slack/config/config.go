@@ -16,10 +16,11 @@ var (
 // Config holds the configuration for the Slack client.
 type (
 	Config struct {
-		ClientID     string `koanf:"CLIENT_ID" validate:"required"`
-		ClientSecret string `koanf:"CLIENT_SECRET" validate:"required"`
-		RedirectURL  string `koanf:"REDIRECT_URL" validate:"required"`
-		Debug        bool   `koanf:"DEBUG"`
+		ClientID      string `koanf:"CLIENT_ID" validate:"required"`
+		ClientSecret  string `koanf:"CLIENT_SECRET" validate:"required"`
+		RedirectURL   string `koanf:"REDIRECT_URL" validate:"required"`
+		SigningSecret string `koanf:"SIGNING_SECRET"` // verifies the interactions and slash commands, they are rejected if empty.
+		Debug         bool   `koanf:"DEBUG"`
 	}
 
 	ConfigOption func(*Config)
@@ -30,6 +31,12 @@ func (c *Config) Validate() error {
 	return validate.Struct(c)
 }
 
+// Enabled reports whether the Slack integration is configured. Slack is optional, teams may be notified on Microsoft
+// Teams instead.
+func (c *Config) Enabled() bool {
+	return c.ClientID != ""
+}
+
 // GetSlackClient creates a new Slack client using the token.
 func GetSlackClient(token string) (*slack.Client, error) {
 	lgr := &logger{slog.Default().WithGroup("slack")}
@@ -54,11 +61,16 @@ func ClientRedirectURL() string {
 	return _c.RedirectURL
 }
 
+func SigningSecret() string {
+	return _c.SigningSecret
+}
+
 func WithConfig(cfg *Config) ConfigOption {
 	return func(config *Config) {
 		config.ClientID = cfg.ClientID
 		config.ClientSecret = cfg.ClientSecret
 		config.RedirectURL = cfg.RedirectURL
+		config.SigningSecret = cfg.SigningSecret
 	}
 }
 
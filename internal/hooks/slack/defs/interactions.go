@@ -0,0 +1,60 @@
+package defs
+
+import (
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db/entities"
+)
+
+// action ids of the buttons on the messages.
+const (
+	ActionRebase = "quantm.rebase"
+	ActionMerge  = "quantm.merge"
+	ActionSnooze = "quantm.snooze"
+	ActionOpenPR = "quantm.open_pr" // url button, the interaction is ignored.
+)
+
+// commands only given with the slash command, the rest are handed over to the repo.
+const (
+	CommandHelp   = "help"
+	CommandStatus = "status"
+)
+
+type (
+	// ActionValue is the value of the buttons on the messages, identifying the branch the action is taken on.
+	ActionValue struct {
+		RepoID uuid.UUID `json:"repo_id"`
+		Branch string    `json:"branch"`
+	}
+
+	// Request is a command given on Slack, either with a button on a message or with the slash command. The repo is
+	// given by id for buttons, and by name for the slash command.
+	Request struct {
+		ID          string    `json:"id"` // trigger id of the interaction.
+		TeamID      string    `json:"team_id"`
+		UserID      string    `json:"user_id"`
+		UserName    string    `json:"user_name"`
+		ResponseURL string    `json:"response_url"`
+		Command     string    `json:"command"`
+		RepoID      uuid.UUID `json:"repo_id"`
+		RepoName    string    `json:"repo_name"`
+		Branch      string    `json:"branch"`
+		Number      int64     `json:"number"`
+		Flags       []string  `json:"flags"`
+	}
+
+	// Authz is the outcome of authorizing the Slack user giving a command. A denial is not an error, the reason is
+	// responded to the user instead.
+	Authz struct {
+		Allowed bool           `json:"allowed"`
+		Reason  string         `json:"reason"`
+		UserID  uuid.UUID      `json:"user_id"`
+		Repo    *entities.Repo `json:"repo"`
+	}
+
+	// ResponsePayload is the payload for the RespondToSlack activity.
+	ResponsePayload struct {
+		URL  string `json:"url"`
+		Text string `json:"text"`
+	}
+)
@@ -0,0 +1,19 @@
+package defs
+
+import (
+	"go.breu.io/quantm/internal/durable"
+)
+
+// NewCommandWorkflowOptions standardize the workflow options for the SlackCommand Workflow.
+//
+//	io.ctrlplane.hooks.slack.team.${team_id}.command.${command}.${trigger_id}
+func NewCommandWorkflowOptions(request *Request) *durable.WorkflowOptions {
+	return durable.NewWorkflowOptions(
+		durable.WithHook("slack"),
+		durable.WithSubject("team"),
+		durable.WithSubjectID(request.TeamID),
+		durable.WithScope("command"),
+		durable.WithAction(request.Command),
+		durable.WithActionID(request.ID),
+	)
+}
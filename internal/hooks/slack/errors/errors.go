@@ -8,4 +8,7 @@ var (
 	ErrCodeEmpty   = errors.New("code is empty")
 	ErrCipherText  = errors.New("ciphertext too short")
 	ErrRecordExist = errors.New("record exist already")
+
+	ErrNoSigningSecret = errors.New("signing secret is not configured")
+	ErrUsage           = errors.New("invalid usage")
 )
@@ -0,0 +1,138 @@
+package fns
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/hooks/slack/defs"
+	"go.breu.io/quantm/internal/hooks/slack/errors"
+)
+
+// Verify checks the signature of a request sent by Slack against the signing secret of the app.
+func Verify(secret string, header http.Header, body []byte) error {
+	if secret == "" {
+		return errors.ErrNoSigningSecret
+	}
+
+	verifier, err := slack.NewSecretsVerifier(header, secret)
+	if err != nil {
+		return err
+	}
+
+	if _, err := verifier.Write(body); err != nil {
+		return err
+	}
+
+	return verifier.Ensure()
+}
+
+// ParseSlashCommand parses the text given to the slash command, e.g. "merge api 42 --priority". Merge and dequeue take
+// the number of the pull request, the other commands on a branch take its name.
+func ParseSlashCommand(text string) (*defs.Request, error) {
+	args := make([]string, 0)
+	flags := make([]string, 0)
+
+	for _, field := range strings.Fields(text) {
+		if flag, found := strings.CutPrefix(field, "--"); found {
+			flags = append(flags, flag)
+		} else {
+			args = append(args, field)
+		}
+	}
+
+	if len(args) == 0 {
+		return &defs.Request{Command: defs.CommandHelp}, nil
+	}
+
+	request := &defs.Request{Command: strings.ToLower(args[0]), Flags: flags}
+
+	switch request.Command {
+	case defs.CommandHelp:
+		return request, nil
+	case defs.CommandStatus:
+		if len(args) != 2 || len(flags) > 0 {
+			return nil, fmt.Errorf("%w: status <repo>", errors.ErrUsage)
+		}
+
+		request.RepoName = args[1]
+	case repos.CommandMerge, repos.CommandDequeue:
+		if len(args) != 3 {
+			return nil, fmt.Errorf("%w: %s <repo> <pull request>", errors.ErrUsage, request.Command)
+		}
+
+		number, err := strconv.ParseInt(strings.TrimPrefix(args[2], "#"), 10, 64)
+		if err != nil || number <= 0 {
+			return nil, fmt.Errorf("%w: %s is not a pull request number", errors.ErrUsage, args[2])
+		}
+
+		request.RepoName = args[1]
+		request.Number = number
+	case repos.CommandRebase, repos.CommandRecheck, repos.CommandSnooze:
+		if len(args) != 3 {
+			return nil, fmt.Errorf("%w: %s <repo> <branch>", errors.ErrUsage, request.Command)
+		}
+
+		request.RepoName = args[1]
+		request.Branch = args[2]
+	default:
+		return nil, fmt.Errorf("%w: unknown command %s", errors.ErrUsage, args[0])
+	}
+
+	for _, flag := range flags {
+		if request.Command != repos.CommandMerge || flag != repos.FlagPriority {
+			return nil, fmt.Errorf("%w: unknown flag --%s", errors.ErrUsage, flag)
+		}
+	}
+
+	return request, nil
+}
+
+// SlashUsage renders the list of the commands available with the slash command.
+func SlashUsage() string {
+	return strings.Join([]string{
+		"Available commands:",
+		"",
+		fmt.Sprintf("• `%s status <repo>` shows the merge queue of the repo.", repos.CommandPrefix),
+		fmt.Sprintf("• `%s merge <repo> <pull request> [--priority]` adds the pull request to the merge queue.", repos.CommandPrefix),
+		fmt.Sprintf("• `%s dequeue <repo> <pull request>` removes the pull request from the merge queue.", repos.CommandPrefix),
+		fmt.Sprintf("• `%s rebase <repo> <branch>` rebases the branch onto the default branch.", repos.CommandPrefix),
+		fmt.Sprintf("• `%s recheck <repo> <branch>` analyzes the branch again.", repos.CommandPrefix),
+		fmt.Sprintf("• `%s snooze <repo> <branch>` silences the drift reminders of the branch for a day.", repos.CommandPrefix),
+	}, "\n")
+}
+
+// RenderQueue renders the merge queue of the repo as the response to the status command.
+func RenderQueue(repo string, queue []repos.QueuePosition) string {
+	if len(queue) == 0 {
+		return fmt.Sprintf("The merge queue of *%s* is empty.", repo)
+	}
+
+	lines := make([]string, 0, len(queue)+1)
+	lines = append(lines, fmt.Sprintf("The merge queue of *%s* has %d pull requests:", repo, len(queue)))
+
+	for _, position := range queue {
+		lines = append(lines, fmt.Sprintf("%d. #%d, ETA %s", position.Position, position.Number, repos.FormatETA(position.ETA)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ActionCommand returns the command taken by the button with the given action id, or an empty string if the button
+// is not handled by quantm.
+func ActionCommand(id string) string {
+	switch id {
+	case defs.ActionRebase:
+		return repos.CommandRebase
+	case defs.ActionMerge:
+		return repos.CommandMerge
+	case defs.ActionSnooze:
+		return repos.CommandSnooze
+	default:
+		return ""
+	}
+}
@@ -1,88 +1,113 @@
 package fns
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
-	"crypto/rand"
 	"crypto/sha512"
 	"encoding/base64"
-	"io"
+	"encoding/json"
 	"log/slog"
 
 	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/hooks/slack/defs"
 	"go.breu.io/quantm/internal/hooks/slack/errors"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
-// encrypt encrypts the given plaintext using the provided key and AES-256 in Cipher Feedback mode.
-// It returns the encrypted ciphertext, or an error if encryption fails.
-func Encrypt(plainText []byte, key []byte) ([]byte, error) {
-	block, err := aes.NewCipher(key)
+// MigrateTokens conceals the slack tokens stored with the former unauthenticated AES-256-CFB scheme again with
+// auth.Conceal, salted with the slack team as before. Tokens that already reveal with auth.Reveal are left as is, so
+// the migration can run more than once. It must run with the secret of the services.
+func MigrateTokens(ctx context.Context) error {
+	links, err := db.Queries().ListChatLinksByHook(ctx, int32(eventsv1.ChatHook_CHAT_HOOK_SLACK))
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	ciphertext := make([]byte, aes.BlockSize+len(plainText))
-	iv := ciphertext[:aes.BlockSize]
+	migrated := 0
 
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
-		return nil, err
-	}
+	for _, link := range links {
+		data := make(map[string]any)
+		if err := json.Unmarshal(link.Data, &data); err != nil {
+			return err
+		}
 
-	stream := cipher.NewCFBEncrypter(block, iv)
-	stream.XORKeyStream(ciphertext[aes.BlockSize:], plainText)
+		salt := "workspace_id"
+		if link.Kind == defs.KindUser {
+			salt = "provider_team_id"
+		}
 
-	return ciphertext, nil
-}
+		team, _ := data[salt].(string)
+		changed := false
 
-// decrypt decrypts the given ciphertext using the provided key and AES-256 in Cipher Feedback mode.
-// It returns the decrypted plaintext, or an error if decryption fails.
-func Decrypt(ciphertext []byte, key []byte) ([]byte, error) {
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		return nil, err
-	}
+		for _, field := range []string{"bot_token", "user_token"} {
+			token, ok := data[field].(string)
+			if !ok || token == "" {
+				continue
+			}
 
-	if len(ciphertext) < aes.BlockSize {
-		return nil, errors.ErrCipherText
-	}
+			if _, err := auth.Reveal(token, team); err == nil {
+				continue
+			}
 
-	iv := ciphertext[:aes.BlockSize]
-	ciphertext = ciphertext[aes.BlockSize:]
+			plain, err := reveal_cfb(token, team)
+			if err != nil {
+				return err
+			}
 
-	stream := cipher.NewCFBDecrypter(block, iv)
-	stream.XORKeyStream(ciphertext, ciphertext)
+			if data[field], err = auth.Conceal(plain, team); err != nil {
+				return err
+			}
 
-	return ciphertext, nil
-}
+			changed = true
+		}
 
-// generate creates a 32-byte key for AES-256 encryption.
-// It uses a SHA-512 hash of the provided workspaceID,
-// and then takes the first 32 bytes of the hash as the key.
-func Generate(workspaceID string) []byte {
-	h := sha512.New()
-	h.Write([]byte(auth.Secret() + workspaceID)) // TODO - verify
+		if !changed {
+			continue
+		}
+
+		updated, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+
+		if err := db.Queries().UpdateChatLinkData(ctx, entities.UpdateChatLinkDataParams{ID: link.ID, Data: updated}); err != nil {
+			return err
+		}
 
-	return h.Sum(nil)[:32]
+		migrated++
+	}
+
+	slog.Info("slack: tokens migrated", "links", len(links), "migrated", migrated)
+
+	return nil
 }
 
-// Reveal decodes a base64-encoded encrypted token and decrypts it using a generated key.
-func Reveal(botToken, workspaceID string) (string, error) {
-	// Decode the base64-encoded encrypted token.
-	decoded, err := base64.StdEncoding.DecodeString(botToken)
+// reveal_cfb decrypts a token stored with the former scheme, i.e. AES-256-CFB keyed with the first 32 bytes of the
+// SHA-512 hash of the application secret and the slack team. It is only kept to migrate the stored tokens.
+func reveal_cfb(token, team string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(token)
 	if err != nil {
-		slog.Error("Failed to decode the token", slog.Any("e", err))
 		return "", err
 	}
 
-	// Generate the same key used for encryption.
-	key := Generate(workspaceID)
+	h := sha512.New()
+	h.Write([]byte(auth.Secret() + team))
 
-	// Decrypt the token.
-	decrypted, err := Decrypt(decoded, key)
+	block, err := aes.NewCipher(h.Sum(nil)[:32])
 	if err != nil {
-		slog.Error("Failed to decrypt the token", slog.Any("e", err))
 		return "", err
 	}
 
-	return string(decrypted), nil
+	if len(decoded) < aes.BlockSize {
+		return "", errors.ErrCipherText
+	}
+
+	iv, sealed := decoded[:aes.BlockSize], decoded[aes.BlockSize:]
+
+	cipher.NewCFBDecrypter(block, iv).XORKeyStream(sealed, sealed) // nolint: staticcheck
+
+	return string(sealed), nil
 }
@@ -0,0 +1,72 @@
+package fns_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/hooks/slack/defs"
+	"go.breu.io/quantm/internal/hooks/slack/errors"
+	"go.breu.io/quantm/internal/hooks/slack/fns"
+)
+
+func sign(secret, timestamp string, body []byte) http.Header {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("v0:" + timestamp + ":"))
+	mac.Write(body)
+
+	header := http.Header{}
+	header.Set("X-Slack-Request-Timestamp", timestamp)
+	header.Set("X-Slack-Signature", "v0="+hex.EncodeToString(mac.Sum(nil)))
+
+	return header
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	body := []byte("command=%2Fquantm&text=status+api")
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+
+	assert.NoError(t, fns.Verify("s3cr3t", sign("s3cr3t", now, body), body))
+	assert.Error(t, fns.Verify("s3cr3t", sign("other", now, body), body))
+	assert.Error(t, fns.Verify("s3cr3t", sign("s3cr3t", now, body), []byte("text=merge+api+1")))
+	assert.ErrorIs(t, fns.Verify("", sign("s3cr3t", now, body), body), errors.ErrNoSigningSecret)
+
+	stale := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	assert.Error(t, fns.Verify("s3cr3t", sign("s3cr3t", stale, body), body))
+}
+
+func TestParseSlashCommand(t *testing.T) {
+	t.Parallel()
+
+	request, err := fns.ParseSlashCommand("merge api #42 --priority")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "merge", request.Command)
+		assert.Equal(t, "api", request.RepoName)
+		assert.Equal(t, int64(42), request.Number)
+		assert.Equal(t, []string{"priority"}, request.Flags)
+	}
+
+	request, err = fns.ParseSlashCommand("  Snooze api feature/login ")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "snooze", request.Command)
+		assert.Equal(t, "feature/login", request.Branch)
+	}
+
+	request, err = fns.ParseSlashCommand("")
+	if assert.NoError(t, err) {
+		assert.Equal(t, defs.CommandHelp, request.Command)
+	}
+
+	for _, text := range []string{"deploy api", "status", "merge api feature", "dequeue api 1 --priority", "rebase api"} {
+		_, err = fns.ParseSlashCommand(text)
+		assert.ErrorIs(t, err, errors.ErrUsage, text)
+	}
+}
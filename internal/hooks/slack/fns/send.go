@@ -4,18 +4,37 @@ import (
 	"log/slog"
 
 	"github.com/slack-go/slack"
+
+	"go.breu.io/quantm/internal/hooks/slack/blocks"
 )
 
-func SendMessage(client *slack.Client, channelID string, attachment slack.Attachment) error {
-	// Send message
-	_, _, err := client.PostMessage(
-		channelID,
-		slack.MsgOptionAttachments(attachment),
+// PostMessage posts the message to the channel, as a reply in the thread if thread is set. Returns the id of the
+// channel and the timestamp of the message, the channel differs from the one given when posting to a user.
+func PostMessage(client *slack.Client, channel string, msg *blocks.Message, thread string) (string, string, error) {
+	opts := []slack.MsgOption{
+		slack.MsgOptionText(msg.Text, false),
+		slack.MsgOptionBlocks(msg.Blocks...),
 		slack.MsgOptionAsUser(true),
-	)
+	}
+
+	if thread != "" {
+		opts = append(opts, slack.MsgOptionTS(thread))
+	}
+
+	posted, ts, err := client.PostMessage(channel, opts...)
+	if err != nil {
+		slog.Error("slack: failed to post message", "channel", channel, "error", err.Error())
+		return "", "", err
+	}
+
+	return posted, ts, nil
+}
 
+// UpdateMessage replaces the message at the timestamp with the given one.
+func UpdateMessage(client *slack.Client, channel, ts string, msg *blocks.Message) error {
+	_, _, _, err := client.UpdateMessage(channel, ts, slack.MsgOptionText(msg.Text, false), slack.MsgOptionBlocks(msg.Blocks...))
 	if err != nil {
-		slog.Error("Error sending message to channel ", ": ", slog.Any("e", err))
+		slog.Error("slack: failed to update message", "channel", channel, "ts", ts, "error", err.Error())
 		return err
 	}
 
@@ -2,16 +2,15 @@ package nomad
 
 import (
 	"context"
-	"encoding/base64"
 
 	"connectrpc.com/connect"
 	"github.com/slack-go/slack"
 
+	"go.breu.io/quantm/internal/auth"
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/db/entities"
 	"go.breu.io/quantm/internal/hooks/slack/config"
 	"go.breu.io/quantm/internal/hooks/slack/defs"
-	"go.breu.io/quantm/internal/hooks/slack/fns"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 	slackv1 "go.breu.io/quantm/internal/proto/hooks/slack/v1"
 	"go.breu.io/quantm/internal/utils"
@@ -27,24 +26,20 @@ func _user(
 		return err
 	}
 
-	// Generate a key for AES-256.
-	key := fns.Generate(response.Team.ID)
-
-	// Encrypt the user access token.
-	user_token, err := fns.Encrypt([]byte(response.AuthedUser.AccessToken), key)
+	// Conceal the user and bot access tokens, salted with the team.
+	user_token, err := auth.Conceal(response.AuthedUser.AccessToken, response.Team.ID)
 	if err != nil {
 		return err
 	}
 
-	// Encrypt the bot access token.
-	bot_token, err := fns.Encrypt([]byte(response.AccessToken), key)
+	bot_token, err := auth.Conceal(response.AccessToken, response.Team.ID)
 	if err != nil {
 		return err
 	}
 
 	slack_user := &defs.MessageProviderSlackUserInfo{
-		BotToken:       base64.StdEncoding.EncodeToString(bot_token),
-		UserToken:      base64.StdEncoding.EncodeToString(user_token),
+		BotToken:       bot_token,
+		UserToken:      user_token,
 		ProviderUserID: identity.User.ID,
 		ProviderTeamID: identity.Team.ID,
 	}
@@ -79,11 +74,8 @@ func _user(
 func _bot(
 	ctx context.Context, reqst *connect.Request[slackv1.OauthRequest], response *slack.OAuthV2Response,
 ) error {
-	// Generate a key for AES-256.
-	key := fns.Generate(response.Team.ID)
-
-	// Encrypt the bot access token.
-	bot_token, err := fns.Encrypt([]byte(response.AccessToken), key)
+	// Conceal the bot access token, salted with the team.
+	bot_token, err := auth.Conceal(response.AccessToken, response.Team.ID)
 	if err != nil {
 		return err
 	}
@@ -93,7 +85,7 @@ func _bot(
 		ChannelName:   response.IncomingWebhook.Channel,
 		WorkspaceName: response.Team.Name,
 		WorkspaceID:   response.Team.ID,
-		BotToken:      base64.StdEncoding.EncodeToString(bot_token), // Store the base64-encoded encrypted token
+		BotToken:      bot_token,
 	}
 
 	data, err := slack_bot.Marshal()
@@ -0,0 +1,130 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/slack-go/slack"
+
+	"go.breu.io/quantm/internal/durable"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/slack/config"
+	"go.breu.io/quantm/internal/hooks/slack/defs"
+	"go.breu.io/quantm/internal/hooks/slack/fns"
+	"go.breu.io/quantm/internal/hooks/slack/workflows"
+)
+
+type (
+	// Webhook receives the interactions with the buttons on the messages and the slash commands. Slack expects an
+	// answer within three seconds, so each command is handed over to a workflow that responds to the user on its own.
+	Webhook struct{}
+)
+
+// Interactions handles the interactions with the buttons on the messages.
+func (h *Webhook) Interactions(ctx echo.Context) error {
+	if err := h.verify(ctx); err != nil {
+		return err
+	}
+
+	callback := &slack.InteractionCallback{}
+	if err := json.Unmarshal([]byte(ctx.FormValue("payload")), callback); err != nil {
+		return erratic.NewBadRequestError(erratic.HooksSlackModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	if callback.Type != slack.InteractionTypeBlockActions {
+		return ctx.NoContent(http.StatusOK)
+	}
+
+	for _, action := range callback.ActionCallback.BlockActions {
+		command := fns.ActionCommand(action.ActionID)
+		if command == "" {
+			continue
+		}
+
+		value := &defs.ActionValue{}
+		if err := json.Unmarshal([]byte(action.Value), value); err != nil {
+			slog.Warn("slack: invalid action value", "action", action.ActionID, "error", err.Error())
+			continue
+		}
+
+		request := &defs.Request{
+			ID:          callback.TriggerID,
+			TeamID:      callback.Team.ID,
+			UserID:      callback.User.ID,
+			UserName:    callback.User.Name,
+			ResponseURL: callback.ResponseURL,
+			Command:     command,
+			RepoID:      value.RepoID,
+			Branch:      value.Branch,
+		}
+
+		if err := h.execute(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// Commands handles the slash command. Invalid usage is answered right away.
+func (h *Webhook) Commands(ctx echo.Context) error {
+	if err := h.verify(ctx); err != nil {
+		return err
+	}
+
+	cmd, err := slack.SlashCommandParse(ctx.Request())
+	if err != nil {
+		return erratic.NewBadRequestError(erratic.HooksSlackModule).WithReason("invalid payload").Wrap(err)
+	}
+
+	request, err := fns.ParseSlashCommand(cmd.Text)
+	if err != nil {
+		msg := &slack.Msg{ResponseType: slack.ResponseTypeEphemeral, Text: err.Error() + "\n\n" + fns.SlashUsage()}
+		return ctx.JSON(http.StatusOK, msg)
+	}
+
+	request.ID = cmd.TriggerID
+	request.TeamID = cmd.TeamID
+	request.UserID = cmd.UserID
+	request.UserName = cmd.UserName
+	request.ResponseURL = cmd.ResponseURL
+
+	if err := h.execute(ctx, request); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusOK)
+}
+
+// verify checks the signature of the request. The body is read for the verification and then reset for the parsing.
+func (h *Webhook) verify(ctx echo.Context) error {
+	body, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return erratic.NewSystemError(erratic.HooksSlackModule).WithReason("failed to read request body").Wrap(err)
+	}
+
+	ctx.Request().Body = io.NopCloser(bytes.NewBuffer(body))
+
+	if err := fns.Verify(config.SigningSecret(), ctx.Request().Header, body); err != nil {
+		return erratic.NewAuthzError(erratic.HooksSlackModule).WithReason("invalid request signature").Wrap(err)
+	}
+
+	return nil
+}
+
+// execute starts the workflow processing the command.
+func (h *Webhook) execute(ctx echo.Context, request *defs.Request) error {
+	opts := defs.NewCommandWorkflowOptions(request)
+
+	_, err := durable.OnHooks().ExecuteWorkflow(ctx.Request().Context(), opts, workflows.SlackCommand, request)
+	if err != nil {
+		slog.Error("slack: failed to execute workflow", "error", err.Error())
+		return erratic.NewSystemError(erratic.HooksSlackModule).Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package workflows
+
+import (
+	"fmt"
+
+	"go.breu.io/durex/dispatch"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/slack/activities"
+	"go.breu.io/quantm/internal/hooks/slack/defs"
+	"go.breu.io/quantm/internal/hooks/slack/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// SlackCommand processes a command given on Slack, either with a button on a message or with the slash command. The
+// Slack user is mapped to the quantm user it is linked to and authorized against the organization owning the repo.
+// The status command is answered from the merge queue of the repo, the rest is handed over to the repo as a
+// QuantmEvent. The user is responded to with an ephemeral message.
+func SlackCommand(ctx workflow.Context, request *defs.Request) error {
+	acts := &activities.Command{}
+	ctx = dispatch.WithDefaultActivityContext(ctx)
+
+	if request.Command == defs.CommandHelp {
+		return respond(ctx, request, fns.SlashUsage())
+	}
+
+	authz := &defs.Authz{}
+	if err := workflow.ExecuteActivity(ctx, acts.AuthorizeSlackUser, request).Get(ctx, authz); err != nil {
+		return err
+	}
+
+	if !authz.Allowed {
+		return respond(ctx, request, authz.Reason)
+	}
+
+	if request.Command == defs.CommandStatus {
+		queue := make([]repos.QueuePosition, 0)
+		if err := workflow.ExecuteActivity(ctx, acts.QuerySlackMergeQueue, authz.Repo).Get(ctx, &queue); err != nil {
+			return err
+		}
+
+		return respond(ctx, request, fns.RenderQueue(authz.Repo.Name, queue))
+	}
+
+	proto := &eventsv1.Command{
+		Name:      request.Command,
+		Flags:     request.Flags,
+		Number:    request.Number,
+		Branch:    request.Branch,
+		Author:    request.UserName,
+		Timestamp: timestamppb.New(workflow.Now(ctx)),
+	}
+
+	event := events.
+		New[eventsv1.RepoHook, eventsv1.Command]().
+		SetHook(eventsv1.RepoHook(authz.Repo.Hook)).
+		SetScope(events.ScopeCommand).
+		SetAction(events.ActionRequested).
+		SetSource(authz.Repo.Url).
+		SetOrg(authz.Repo.OrgID).
+		SetSubjectName(events.SubjectNameRepos).
+		SetSubjectID(authz.Repo.ID).
+		SetUser(authz.UserID).
+		SetPayload(proto)
+
+	if err := pulse.Persist(ctx, event); err != nil {
+		return err
+	}
+
+	if err := workflow.ExecuteActivity(ctx, acts.SignalRepoWithSlackCommand, authz.Repo, event).Get(ctx, nil); err != nil {
+		return err
+	}
+
+	return respond(ctx, request, fmt.Sprintf("*%s*: %s", authz.Repo.Name, repos.CommandReply(proto)))
+}
+
+// respond sends the text to the user who gave the command.
+func respond(ctx workflow.Context, request *defs.Request, text string) error {
+	acts := &activities.Command{}
+	payload := &defs.ResponsePayload{URL: request.ResponseURL, Text: text}
+
+	return workflow.ExecuteActivity(ctx, acts.RespondToSlack, payload).Get(ctx, nil)
+}
@@ -0,0 +1,91 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/teams/cast"
+	"go.breu.io/quantm/internal/hooks/teams/config"
+	"go.breu.io/quantm/internal/hooks/teams/defs"
+	"go.breu.io/quantm/internal/hooks/teams/errors"
+	"go.breu.io/quantm/internal/hooks/teams/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Kernel implements kernel.Chat for Microsoft Teams.
+	Kernel struct{}
+)
+
+func (k *Kernel) NotifyLinesExceed(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error {
+	return k.send(ctx, target(event.Subject), cast.DiffEventToActivity(event))
+}
+
+func (k *Kernel) NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error {
+	return k.send(ctx, target(event.Subject), cast.MergeEventToActivity(event))
+}
+
+func (k *Kernel) NotifyBranchDrift(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) error {
+	return k.send(ctx, target(event.Subject), cast.DriftEventToActivity(event))
+}
+
+func (k *Kernel) NotifyDigest(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) error {
+	return k.send(ctx, target(event.Subject), cast.DigestEventToActivity(event))
+}
+
+func (k *Kernel) NotifyReport(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Report]) error {
+	return k.send(ctx, target(event.Subject), cast.ReportEventToActivity(event))
+}
+
+// send delivers the activity to the channel or the user linked to link_to.
+func (k *Kernel) send(ctx context.Context, link_to uuid.UUID, activity *defs.Activity) error {
+	link, err := db.Queries().GetChatLink(ctx, link_to)
+	if err != nil {
+		return err
+	}
+
+	client := fns.NewClient(config.Instance())
+
+	switch link.Kind {
+	case defs.KindWebhook:
+		data := &defs.WebhookData{}
+		if err := data.Unmarshal(link.Data); err != nil {
+			return err
+		}
+
+		url, err := auth.Reveal(data.URL, link_to.String())
+		if err != nil {
+			return err
+		}
+
+		return client.PostWebhook(ctx, url, activity)
+	case defs.KindBot:
+		data := &defs.BotData{}
+		if err := data.Unmarshal(link.Data); err != nil {
+			return err
+		}
+
+		password, err := auth.Reveal(data.AppPassword, link_to.String())
+		if err != nil {
+			return err
+		}
+
+		return client.PostToConversation(ctx, data, password, activity)
+	default:
+		return fmt.Errorf("%w: %s", errors.ErrUnknownKind, link.Kind)
+	}
+}
+
+// target returns the user the event belongs to, falling back to the subject itself, i.e. the repo.
+func target(subject events.Subject) uuid.UUID {
+	if subject.UserID != uuid.Nil {
+		return subject.UserID
+	}
+
+	return subject.ID
+}
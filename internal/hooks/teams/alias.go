@@ -0,0 +1,23 @@
+package teams
+
+import (
+	"go.breu.io/quantm/internal/hooks/teams/activities"
+	"go.breu.io/quantm/internal/hooks/teams/config"
+	"go.breu.io/quantm/internal/hooks/teams/nomad"
+)
+
+type (
+	Config = config.Config
+
+	KernelImpl = activities.Kernel
+)
+
+var (
+	DefaultLoginURL = config.DefaultLoginURL
+	DefaultScope    = config.DefaultScope
+
+	WithConfig = config.WithConfig
+	Configure  = config.Instance
+
+	NomadHandler = nomad.NewTeamsServiceHandler
+)
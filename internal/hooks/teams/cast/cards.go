@@ -0,0 +1,217 @@
+package cast
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"go.breu.io/quantm/internal/core/reports"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/teams/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+const (
+	footer = "Powered by quantm.io"
+
+	// max_files caps the files listed on a card, cards above 28 KB are rejected by Teams.
+	max_files = 10
+)
+
+// DiffEventToActivity renders the notification for a change exceeding the line threshold of the repo.
+func DiffEventToActivity(event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) *defs.Activity {
+	lines := event.Payload.GetLines()
+	files := event.Payload.GetFiles()
+
+	renamed := make([]string, len(files.GetRenamed()))
+	for i, file := range files.GetRenamed() {
+		renamed[i] = file.GetOld() + " → " + file.GetNew()
+	}
+
+	body := []defs.Element{
+		title("Line Exceed Detected"),
+		defs.NewTextBlock(
+			"The number of lines in this pull request exceeds the allowed threshold. Please review and adjust accordingly.",
+		),
+		defs.NewFactSet(
+			defs.Fact{Title: "Repository", Value: repo(event.Context.Source)},
+			defs.Fact{Title: "Total Lines", Value: fmt.Sprintf("%d", lines.GetAdded()+lines.GetRemoved())},
+			defs.Fact{Title: "Lines Added", Value: fmt.Sprintf("%d", lines.GetAdded())},
+			defs.Fact{Title: "Lines Deleted", Value: fmt.Sprintf("%d", lines.GetRemoved())},
+		),
+	}
+
+	body = append(body, section("Added Files", files.GetAdded())...)
+	body = append(body, section("Deleted Files", files.GetDeleted())...)
+	body = append(body, section("Modified Files", files.GetModified())...)
+	body = append(body, section("Renamed Files", renamed)...)
+	body = append(body, muted(footer))
+
+	card := defs.NewCard(body...)
+	card.Actions = []defs.Action{defs.NewOpenURL("View Repository", event.Context.Source)}
+
+	return defs.NewActivity("Line Exceed Detected", card)
+}
+
+// MergeEventToActivity renders the notification for a branch conflicting with the default branch.
+func MergeEventToActivity(event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) *defs.Activity {
+	head := event.Payload.GetHeadBranch()
+	base := event.Payload.GetBaseBranch()
+
+	body := []defs.Element{
+		title("Merge Conflict Detected"),
+		defs.NewTextBlock(fmt.Sprintf(
+			"We've detected a merge conflict in your feature branch, **%s**. "+
+				"This means there are changes in your branch that clash with recent updates on **%s**.",
+			head, base,
+		)),
+		defs.NewFactSet(
+			defs.Fact{Title: "Repository", Value: repo(event.Context.Source)},
+			defs.Fact{Title: "Branch", Value: head},
+			defs.Fact{Title: "Default Branch", Value: base},
+			defs.Fact{Title: "Conflicting Files", Value: fmt.Sprintf("%d", len(event.Payload.GetFiles()))},
+		),
+	}
+
+	body = append(body, section("Affected Files", event.Payload.GetFiles())...)
+	body = append(body, muted(footer))
+
+	card := defs.NewCard(body...)
+	card.Actions = []defs.Action{defs.NewOpenURL("View Branch", branch(event.Context.Source, head))}
+
+	return defs.NewActivity("Merge Conflict Detected", card)
+}
+
+// DriftEventToActivity renders the notification for a branch drifting far behind the default branch.
+func DriftEventToActivity(event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) *defs.Activity {
+	days := 0
+	if event.Payload.GetSyncedAt() != nil {
+		days = int(time.Since(event.Payload.GetSyncedAt().AsTime()).Hours() / 24)
+	}
+
+	body := []defs.Element{
+		title("Branch Drift Detected"),
+		defs.NewTextBlock(fmt.Sprintf(
+			"Your feature branch, **%s**, has drifted far behind **%s**. "+
+				"Bringing it up to date now keeps the eventual merge small and predictable.",
+			event.Payload.GetBranch(), event.Payload.GetTrunk(),
+		)),
+		defs.NewFactSet(
+			defs.Fact{Title: "Repository", Value: repo(event.Context.Source)},
+			defs.Fact{Title: "Branch", Value: event.Payload.GetBranch()},
+			defs.Fact{Title: "Commits Behind", Value: fmt.Sprintf("%d", event.Payload.GetBehind())},
+			defs.Fact{Title: "Days Since Sync", Value: fmt.Sprintf("%d", days)},
+		),
+	}
+
+	body = append(body, section("Churned Files", event.Payload.GetChurn())...)
+	body = append(body, muted(footer))
+
+	card := defs.NewCard(body...)
+	card.Actions = []defs.Action{defs.NewOpenURL("View Branch", branch(event.Context.Source, event.Payload.GetBranch()))}
+
+	return defs.NewActivity("Branch Drift Detected", card)
+}
+
+// DigestEventToActivity renders the notifications deferred by the notification preferences, grouped by repo.
+func DigestEventToActivity(event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) *defs.Activity {
+	body := []defs.Element{
+		title("Your quantm Digest"),
+		defs.NewTextBlock(fmt.Sprintf("%d notifications since the last digest.", len(event.Payload.GetItems()))),
+	}
+
+	repos := make([]string, 0)
+	lines := make(map[string][]string)
+
+	for _, item := range event.Payload.GetItems() {
+		if _, ok := lines[item.GetRepo()]; !ok {
+			repos = append(repos, item.GetRepo())
+		}
+
+		lines[item.GetRepo()] = append(lines[item.GetRepo()], fmt.Sprintf("- **%s**: %s", item.GetBranch(), item.GetSummary()))
+	}
+
+	for _, name := range repos {
+		body = append(body, defs.NewTextBlock("**"+name+"**"), defs.NewTextBlock(strings.Join(lines[name], "\n")))
+	}
+
+	body = append(body, muted(footer))
+
+	return defs.NewActivity("quantm Digest", defs.NewCard(body...))
+}
+
+// ReportEventToActivity renders the scheduled report of a repo or a team.
+func ReportEventToActivity(event *events.Event[eventsv1.ChatHook, eventsv1.Report]) *defs.Activity {
+	metrics := reports.Metrics(event.Payload)
+	facts := make([]defs.Fact, len(metrics))
+
+	for i, metric := range metrics {
+		facts[i] = defs.Fact{Title: metric.Title, Value: metric.Value}
+	}
+
+	period := reports.Span(event.Payload)
+	if source := event.Payload.GetSource(); source != "" {
+		period = fmt.Sprintf("%s, %s", repo(source), period)
+	}
+
+	body := []defs.Element{
+		title(reports.Title(event.Payload)),
+		defs.NewTextBlock(period),
+		defs.NewFactSet(facts...),
+	}
+
+	if largest := reports.Largest(event.Payload); len(largest) > 0 {
+		body = append(body, defs.NewTextBlock("**Largest Pull Requests**"), defs.NewTextBlock("- "+strings.Join(largest, "\n- ")))
+	}
+
+	body = append(body, muted(footer))
+
+	return defs.NewActivity(reports.Title(event.Payload), defs.NewCard(body...))
+}
+
+func title(text string) *defs.TextBlock {
+	block := defs.NewTextBlock(text)
+	block.Size = "Medium"
+	block.Weight = "Bolder"
+	block.Color = "Warning"
+
+	return block
+}
+
+func muted(text string) *defs.TextBlock {
+	block := defs.NewTextBlock(text)
+	block.Size = "Small"
+	block.Color = "Light"
+
+	return block
+}
+
+// section renders the files under a heading as a markdown list. Nothing is rendered without files.
+func section(heading string, files []string) []defs.Element {
+	if len(files) == 0 {
+		return nil
+	}
+
+	items := make([]string, 0, max_files+1)
+	for i, file := range files {
+		if i == max_files {
+			items = append(items, fmt.Sprintf("- and %d more", len(files)-max_files))
+			break
+		}
+
+		items = append(items, "- "+file)
+	}
+
+	block := defs.NewTextBlock("**" + heading + "**")
+
+	return []defs.Element{block, defs.NewTextBlock(strings.Join(items, "\n"))}
+}
+
+func repo(source string) string {
+	return path.Base(strings.TrimSuffix(source, "/"))
+}
+
+func branch(source, name string) string {
+	return strings.TrimSuffix(source, "/") + "/tree/" + name
+}
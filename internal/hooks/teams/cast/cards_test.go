@@ -0,0 +1,66 @@
+package cast_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/teams/cast"
+	"go.breu.io/quantm/internal/hooks/teams/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+func TestMergeEventToActivity(t *testing.T) {
+	event := &events.Event[eventsv1.ChatHook, eventsv1.Merge]{
+		Context: events.Context[eventsv1.ChatHook]{Source: "https://github.com/breuhq/quantm"},
+		Payload: &eventsv1.Merge{HeadBranch: "feature", BaseBranch: "main", Files: []string{"a.go", "b.go"}},
+	}
+
+	activity := cast.MergeEventToActivity(event)
+	require.Len(t, activity.Attachments, 1)
+
+	card := activity.Attachments[0].Content
+	assert.Equal(t, "AdaptiveCard", card.Type)
+	require.Len(t, card.Actions, 1)
+	assert.Equal(t, "https://github.com/breuhq/quantm/tree/feature", card.Actions[0].URL)
+
+	facts, ok := card.Body[2].(*defs.FactSet)
+	require.True(t, ok)
+	assert.Equal(t, defs.Fact{Title: "Repository", Value: "quantm"}, facts.Facts[0])
+	assert.Equal(t, defs.Fact{Title: "Conflicting Files", Value: "2"}, facts.Facts[3])
+
+	encoded, err := json.Marshal(activity)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"$schema":"http://adaptivecards.io/schemas/adaptive-card.json"`)
+	assert.Contains(t, string(encoded), `"contentType":"application/vnd.microsoft.card.adaptive"`)
+}
+
+func TestDiffEventToActivityCapsFiles(t *testing.T) {
+	added := make([]string, 12)
+	for i := range added {
+		added[i] = fmt.Sprintf("file_%d.go", i)
+	}
+
+	event := &events.Event[eventsv1.ChatHook, eventsv1.Diff]{
+		Context: events.Context[eventsv1.ChatHook]{Source: "https://github.com/breuhq/quantm"},
+		Payload: &eventsv1.Diff{
+			Lines: &eventsv1.DiffLines{Added: 700, Removed: 20},
+			Files: &eventsv1.DiffFiles{Added: added},
+		},
+	}
+
+	card := cast.DiffEventToActivity(event).Attachments[0].Content
+
+	facts, ok := card.Body[2].(*defs.FactSet)
+	require.True(t, ok)
+	assert.Equal(t, "720", facts.Facts[1].Value)
+
+	files, ok := card.Body[4].(*defs.TextBlock)
+	require.True(t, ok)
+	assert.Contains(t, files.Text, "- file_9.go\n- and 2 more")
+	assert.NotContains(t, files.Text, "file_10.go")
+}
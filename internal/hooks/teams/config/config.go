@@ -0,0 +1,91 @@
+package config
+
+import (
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	_once sync.Once
+	_c    *Config
+)
+
+type (
+	// Config holds the configuration for the Microsoft Teams integration. Teams needs no app wide credentials, both the
+	// incoming webhooks and the bot credentials are given when a channel or a user is linked, so the defaults only need
+	// to be changed for sovereign clouds.
+	Config struct {
+		LoginURL string `koanf:"LOGIN_URL" validate:"required,url"` // Microsoft identity platform the bot tokens are issued by.
+		Scope    string `koanf:"SCOPE" validate:"required"`         // Scope of the bot tokens.
+	}
+
+	ConfigOption func(*Config)
+)
+
+const (
+	DefaultLoginURL = "https://login.microsoftonline.com"
+	DefaultScope    = "https://api.botframework.com/.default"
+
+	// DefaultTenant is the tenant of the multi tenant bots.
+	DefaultTenant = "botframework.com"
+)
+
+func (c *Config) Validate() error {
+	validate := validator.New()
+	return validate.Struct(c)
+}
+
+// TokenURL returns the url the bot tokens of the tenant are requested from. An empty tenant resolves to the tenant of
+// the multi tenant bots.
+func (c *Config) TokenURL(tenant string) string {
+	if tenant == "" {
+		tenant = DefaultTenant
+	}
+
+	return strings.TrimSuffix(c.LoginURL, "/") + "/" + tenant + "/oauth2/v2.0/token"
+}
+
+// WithLoginURL sets the LoginURL field of the Config.
+func WithLoginURL(url string) ConfigOption {
+	return func(config *Config) {
+		config.LoginURL = url
+	}
+}
+
+// WithScope sets the Scope field of the Config.
+func WithScope(scope string) ConfigOption {
+	return func(config *Config) {
+		config.Scope = scope
+	}
+}
+
+// WithConfig copies the values from the given Config into the target Config.
+func WithConfig(cfg *Config) ConfigOption {
+	return func(config *Config) {
+		if cfg.LoginURL != "" {
+			config.LoginURL = cfg.LoginURL
+		}
+
+		if cfg.Scope != "" {
+			config.Scope = cfg.Scope
+		}
+	}
+}
+
+// Instance returns the singleton instance of the Teams configuration.
+func Instance(opts ...ConfigOption) *Config {
+	_once.Do(func() {
+		slog.Debug("teams: configuring instance ...")
+
+		_c = &Config{LoginURL: DefaultLoginURL, Scope: DefaultScope}
+
+		for _, opt := range opts {
+			opt(_c)
+		}
+	})
+
+	return _c
+}
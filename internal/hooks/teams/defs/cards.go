@@ -0,0 +1,104 @@
+package defs
+
+// Adaptive Cards, as far as the notifications need them. See https://adaptivecards.io/explorer/.
+
+const (
+	CardSchema      = "http://adaptivecards.io/schemas/adaptive-card.json"
+	CardVersion     = "1.4" // the latest version rendered by all the Teams clients.
+	CardContentType = "application/vnd.microsoft.card.adaptive"
+)
+
+type (
+	// Element is an element of the body of a card.
+	Element any
+
+	// Card is an Adaptive Card.
+	Card struct {
+		Schema  string    `json:"$schema"`
+		Type    string    `json:"type"`
+		Version string    `json:"version"`
+		Body    []Element `json:"body"`
+		Actions []Action  `json:"actions,omitempty"`
+		MSTeams *MSTeams  `json:"msteams,omitempty"`
+	}
+
+	// MSTeams holds the properties of a card only understood by Teams.
+	MSTeams struct {
+		Width string `json:"width,omitempty"`
+	}
+
+	// TextBlock displays text.
+	TextBlock struct {
+		Type   string `json:"type"`
+		Text   string `json:"text"`
+		Size   string `json:"size,omitempty"`
+		Weight string `json:"weight,omitempty"`
+		Color  string `json:"color,omitempty"`
+		Wrap   bool   `json:"wrap,omitempty"`
+	}
+
+	// FactSet displays a list of facts as a table.
+	FactSet struct {
+		Type  string `json:"type"`
+		Facts []Fact `json:"facts"`
+	}
+
+	Fact struct {
+		Title string `json:"title"`
+		Value string `json:"value"`
+	}
+
+	// Action is an action of a card. Only Action.OpenUrl is used.
+	Action struct {
+		Type  string `json:"type"`
+		Title string `json:"title"`
+		URL   string `json:"url"`
+	}
+
+	// Activity is a Bot Framework activity. Incoming webhooks accept the same shape.
+	Activity struct {
+		Type        string       `json:"type"`
+		Summary     string       `json:"summary,omitempty"` // shown in the notifications of the clients.
+		Attachments []Attachment `json:"attachments"`
+	}
+
+	Attachment struct {
+		ContentType string `json:"contentType"`
+		Content     *Card  `json:"content"`
+	}
+)
+
+// NewCard creates a card spanning the full width of the conversation.
+func NewCard(body ...Element) *Card {
+	return &Card{
+		Schema:  CardSchema,
+		Type:    "AdaptiveCard",
+		Version: CardVersion,
+		Body:    body,
+		MSTeams: &MSTeams{Width: "Full"},
+	}
+}
+
+// NewActivity creates the message activity carrying the card.
+func NewActivity(summary string, card *Card) *Activity {
+	return &Activity{
+		Type:        "message",
+		Summary:     summary,
+		Attachments: []Attachment{{ContentType: CardContentType, Content: card}},
+	}
+}
+
+// NewTextBlock creates a wrapping text block. Text blocks support a subset of markdown.
+func NewTextBlock(text string) *TextBlock {
+	return &TextBlock{Type: "TextBlock", Text: text, Wrap: true}
+}
+
+// NewFactSet creates a fact set.
+func NewFactSet(facts ...Fact) *FactSet {
+	return &FactSet{Type: "FactSet", Facts: facts}
+}
+
+// NewOpenURL creates an action opening the url.
+func NewOpenURL(title, url string) Action {
+	return Action{Type: "Action.OpenUrl", Title: title, URL: url}
+}
@@ -0,0 +1,51 @@
+package defs
+
+import (
+	"encoding/json"
+)
+
+// Kind constants.
+const (
+	KindWebhook = "webhook" // incoming webhook of a channel.
+	KindBot     = "bot"     // bot posting to a channel or to a personal chat.
+)
+
+type (
+	// WebhookData is the data of a chat link to the incoming webhook of a Teams channel. The url carries the credentials
+	// of the webhook, so it is stored concealed.
+	WebhookData struct {
+		URL string `json:"url"`
+	}
+
+	// BotData is the data of a chat link to a conversation of a Teams bot. The app password is stored concealed.
+	BotData struct {
+		ServiceURL     string `json:"service_url"`
+		ConversationID string `json:"conversation_id"`
+		TenantID       string `json:"tenant_id"`
+		AppID          string `json:"app_id"`
+		AppPassword    string `json:"app_password"`
+	}
+
+	// Token is the access token issued to a bot by the Microsoft identity platform.
+	Token struct {
+		TokenType   string `json:"token_type"`
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+)
+
+func (d *WebhookData) Marshal() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+func (d *WebhookData) Unmarshal(data []byte) error {
+	return json.Unmarshal(data, d)
+}
+
+func (d *BotData) Marshal() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+func (d *BotData) Unmarshal(data []byte) error {
+	return json.Unmarshal(data, d)
+}
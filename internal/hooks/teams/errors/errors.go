@@ -0,0 +1,10 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	ErrUnknownKind      = errors.New("unknown kind of chat link")
+	ErrUnexpectedStatus = errors.New("unexpected status from teams")
+)
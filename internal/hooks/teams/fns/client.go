@@ -0,0 +1,117 @@
+package fns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.breu.io/quantm/internal/hooks/teams/config"
+	"go.breu.io/quantm/internal/hooks/teams/defs"
+	"go.breu.io/quantm/internal/hooks/teams/errors"
+)
+
+type (
+	// Client delivers activities to Teams, either through the incoming webhook of a channel or through the Bot Framework
+	// connector of a bot.
+	Client struct {
+		cfg  *config.Config
+		http *http.Client
+	}
+)
+
+// PostWebhook posts the activity to the incoming webhook at url.
+func (c *Client) PostWebhook(ctx context.Context, url string, activity *defs.Activity) error {
+	return c.post(ctx, url, "", activity, nil)
+}
+
+// PostToConversation posts the activity to the conversation of the bot, authenticating with the revealed app password.
+func (c *Client) PostToConversation(ctx context.Context, bot *defs.BotData, password string, activity *defs.Activity) error {
+	token, err := c.token(ctx, bot, password)
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := url.JoinPath(bot.ServiceURL, "v3", "conversations", bot.ConversationID, "activities")
+	if err != nil {
+		return err
+	}
+
+	return c.post(ctx, endpoint, token, activity, nil)
+}
+
+// token requests an access token for the bot with the client credentials grant.
+func (c *Client) token(ctx context.Context, bot *defs.BotData, password string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", bot.AppID)
+	form.Set("client_secret", password)
+	form.Set("scope", c.cfg.Scope)
+
+	endpoint := c.cfg.TokenURL(bot.TenantID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	token := &defs.Token{}
+	if err := c.do(req, token); err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+// post sends the body as json to the endpoint, with the bearer token if given, and decodes the response into result.
+func (c *Client) post(ctx context.Context, endpoint, token string, body, result any) error {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(encoded))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	return c.do(req, result)
+}
+
+func (c *Client) do(req *http.Request, result any) error {
+	res, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		// the url of a webhook carries its credentials, so only the host is reported.
+		return fmt.Errorf("%w: %s %s: %s", errors.ErrUnexpectedStatus, req.Method, req.URL.Host, res.Status)
+	}
+
+	if result != nil {
+		if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewClient creates a client for the given configuration.
+func NewClient(cfg *config.Config) *Client {
+	return &Client{cfg: cfg, http: http.DefaultClient}
+}
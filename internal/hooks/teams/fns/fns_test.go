@@ -0,0 +1,89 @@
+package fns_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/hooks/teams/config"
+	"go.breu.io/quantm/internal/hooks/teams/defs"
+	"go.breu.io/quantm/internal/hooks/teams/errors"
+	"go.breu.io/quantm/internal/hooks/teams/fns"
+)
+
+// TestClientPostToConversation runs the client against a stand-in for both the Microsoft identity platform and the Bot
+// Framework connector.
+func TestClientPostToConversation(t *testing.T) {
+	t.Parallel()
+
+	received := &defs.Activity{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /tenant/oauth2/v2.0/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "app", r.PostForm.Get("client_id"))
+		assert.Equal(t, "password", r.PostForm.Get("client_secret"))
+		assert.Equal(t, config.DefaultScope, r.PostForm.Get("scope"))
+
+		_ = json.NewEncoder(w).Encode(&defs.Token{TokenType: "Bearer", AccessToken: "token", ExpiresIn: 3600})
+	})
+	mux.HandleFunc("POST /v3/conversations/{id}/activities", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "19:channel@thread.tacv2", r.PathValue("id"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(received))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := fns.NewClient(&config.Config{LoginURL: srv.URL, Scope: config.DefaultScope})
+	bot := &defs.BotData{ServiceURL: srv.URL, ConversationID: "19:channel@thread.tacv2", TenantID: "tenant", AppID: "app"}
+	activity := defs.NewActivity("summary", defs.NewCard(defs.NewTextBlock("hello")))
+
+	require.NoError(t, client.PostToConversation(context.Background(), bot, "password", activity))
+	require.Len(t, received.Attachments, 1)
+	assert.Equal(t, "message", received.Type)
+	assert.Equal(t, defs.CardContentType, received.Attachments[0].ContentType)
+	assert.Equal(t, defs.CardVersion, received.Attachments[0].Content.Version)
+}
+
+func TestClientPostToConversationUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	client := fns.NewClient(&config.Config{LoginURL: srv.URL, Scope: config.DefaultScope})
+	bot := &defs.BotData{ServiceURL: srv.URL, ConversationID: "a:user", AppID: "app"}
+
+	err := client.PostToConversation(context.Background(), bot, "wrong", defs.NewActivity("", defs.NewCard()))
+	assert.ErrorIs(t, err, errors.ErrUnexpectedStatus)
+}
+
+func TestClientPostWebhook(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/webhookb2/secret", r.URL.Path)
+		assert.Empty(t, r.Header.Get("Authorization"))
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := fns.NewClient(&config.Config{LoginURL: config.DefaultLoginURL, Scope: config.DefaultScope})
+
+	err := client.PostWebhook(context.Background(), srv.URL+"/webhookb2/secret", defs.NewActivity("", defs.NewCard()))
+	require.NoError(t, err)
+}
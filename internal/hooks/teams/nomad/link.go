@@ -0,0 +1,118 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/teams/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	teamsv1 "go.breu.io/quantm/internal/proto/hooks/teams/v1"
+	"go.breu.io/quantm/internal/proto/hooks/teams/v1/teamsv1connect"
+)
+
+type (
+	TeamsService struct {
+		teamsv1connect.UnimplementedTeamsServiceHandler
+	}
+
+	// data is the data of a chat link.
+	data interface {
+		Marshal() ([]byte, error)
+	}
+)
+
+// Link links a Teams channel or user to a repo or to a user. The secrets are concealed with the id of the link as
+// salt before they are stored.
+func (s *TeamsService) Link(
+	ctx context.Context, req *connect.Request[teamsv1.LinkRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	link_to, err := uuid.Parse(req.Msg.GetLinkTo())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksTeamsModule).WithReason("invalid link_to UUID").Wrap(err)
+	}
+
+	existing, err := db.Queries().GetChatLink(ctx, link_to)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, erratic.NewDatabaseError(erratic.HooksTeamsModule).WithReason("failed to query chat link").Wrap(err)
+	}
+
+	if existing.ID != uuid.Nil {
+		return nil, erratic.NewExistsError(erratic.HooksTeamsModule).WithReason("chat link already exists")
+	}
+
+	var (
+		kind string
+		link data
+	)
+
+	switch target := req.Msg.GetTarget().(type) {
+	case *teamsv1.LinkRequest_Webhook:
+		kind = defs.KindWebhook
+		link, err = webhook(target.Webhook, link_to)
+	case *teamsv1.LinkRequest_Bot:
+		kind = defs.KindBot
+		link, err = bot(target.Bot, link_to)
+	default:
+		return nil, erratic.NewBadRequestError(erratic.HooksTeamsModule).WithReason("missing webhook or bot")
+	}
+
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksTeamsModule).WithReason("unable to conceal secret").Wrap(err)
+	}
+
+	encoded, err := link.Marshal()
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksTeamsModule).WithReason("unable to encode chat link").Wrap(err)
+	}
+
+	params := entities.CreateChatLinkParams{
+		Hook:   int32(eventsv1.ChatHook_CHAT_HOOK_TEAMS),
+		Kind:   kind,
+		LinkTo: link_to,
+		Data:   encoded,
+	}
+
+	if _, err := db.Queries().CreateChatLink(ctx, params); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.HooksTeamsModule).WithReason("failed to save chat link").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func webhook(msg *teamsv1.Webhook, link_to uuid.UUID) (*defs.WebhookData, error) {
+	url, err := auth.Conceal(msg.GetUrl(), link_to.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &defs.WebhookData{URL: url}, nil
+}
+
+func bot(msg *teamsv1.Bot, link_to uuid.UUID) (*defs.BotData, error) {
+	password, err := auth.Conceal(msg.GetAppPassword(), link_to.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &defs.BotData{
+		ServiceURL:     msg.GetServiceUrl(),
+		ConversationID: msg.GetConversationId(),
+		TenantID:       msg.GetTenantId(),
+		AppID:          msg.GetAppId(),
+		AppPassword:    password,
+	}, nil
+}
+
+func NewTeamsServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return teamsv1connect.NewTeamsServiceHandler(&TeamsService{}, opts...)
+}
@@ -0,0 +1,94 @@
+package activities
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/webhook/cast"
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+	"go.breu.io/quantm/internal/hooks/webhook/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+type (
+	// Kernel implements kernel.Chat with outgoing webhooks.
+	Kernel struct{}
+)
+
+func (k *Kernel) NotifyLinesExceed(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Diff]) error {
+	envelope, err := cast.EventToEnvelope(defs.EventLinesExceed, event)
+	if err != nil {
+		return err
+	}
+
+	return k.send(ctx, target(event.Subject), envelope)
+}
+
+func (k *Kernel) NotifyMergeConflict(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Merge]) error {
+	envelope, err := cast.EventToEnvelope(defs.EventMergeConflict, event)
+	if err != nil {
+		return err
+	}
+
+	return k.send(ctx, target(event.Subject), envelope)
+}
+
+func (k *Kernel) NotifyBranchDrift(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Drift]) error {
+	envelope, err := cast.EventToEnvelope(defs.EventBranchDrift, event)
+	if err != nil {
+		return err
+	}
+
+	return k.send(ctx, target(event.Subject), envelope)
+}
+
+func (k *Kernel) NotifyDigest(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Digest]) error {
+	envelope, err := cast.EventToEnvelope(defs.EventDigest, event)
+	if err != nil {
+		return err
+	}
+
+	return k.send(ctx, target(event.Subject), envelope)
+}
+
+func (k *Kernel) NotifyReport(ctx context.Context, event *events.Event[eventsv1.ChatHook, eventsv1.Report]) error {
+	envelope, err := cast.EventToEnvelope(defs.EventReport, event)
+	if err != nil {
+		return err
+	}
+
+	return k.send(ctx, target(event.Subject), envelope)
+}
+
+// send posts the envelope to the webhook linked to link_to.
+func (k *Kernel) send(ctx context.Context, link_to uuid.UUID, envelope *defs.Envelope) error {
+	link, err := db.Queries().GetChatLink(ctx, link_to)
+	if err != nil {
+		return err
+	}
+
+	data := &defs.LinkData{}
+	if err := data.Unmarshal(link.Data); err != nil {
+		return err
+	}
+
+	secret, err := auth.Reveal(data.Secret, link_to.String())
+	if err != nil {
+		return err
+	}
+
+	return fns.NewClient().Post(ctx, data.URL, secret, envelope)
+}
+
+// target returns the user the event belongs to, falling back to the subject itself, i.e. the repo.
+func target(subject events.Subject) uuid.UUID {
+	if subject.UserID != uuid.Nil {
+		return subject.UserID
+	}
+
+	return subject.ID
+}
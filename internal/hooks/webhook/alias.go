@@ -0,0 +1,14 @@
+package webhook
+
+import (
+	"go.breu.io/quantm/internal/hooks/webhook/activities"
+	"go.breu.io/quantm/internal/hooks/webhook/nomad"
+)
+
+type (
+	KernelImpl = activities.Kernel
+)
+
+var (
+	NomadHandler = nomad.NewWebhookServiceHandler
+)
@@ -0,0 +1,34 @@
+package cast
+
+import (
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+)
+
+var (
+	marshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+)
+
+// EventToEnvelope renders the event as the body of a delivery.
+func EventToEnvelope[P events.Payload](name string, event *events.Event[eventsv1.ChatHook, P]) (*defs.Envelope, error) {
+	payload, err := marshaler.Marshal(any(event.Payload).(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+
+	return &defs.Envelope{
+		ID:        event.ID,
+		Event:     name,
+		Version:   event.Version.String(),
+		Timestamp: event.Timestamp,
+		Scope:     string(event.Context.Scope),
+		Action:    string(event.Context.Action),
+		Source:    event.Context.Source,
+		Subject:   event.Subject,
+		Payload:   payload,
+	}, nil
+}
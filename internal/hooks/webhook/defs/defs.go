@@ -0,0 +1,61 @@
+package defs
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/events"
+)
+
+// Kind constants.
+const (
+	KindWebhook = "webhook"
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderEvent     = "X-Quantm-Event"         // name of the event, e.g. merge_conflict.
+	HeaderDelivery  = "X-Quantm-Delivery"      // id of the event, the same across the retries of a delivery.
+	HeaderSignature = "X-Quantm-Signature-256" // "sha256=" followed by the hex encoded HMAC-SHA256 of the body.
+)
+
+// Names of the events.
+const (
+	EventLinesExceed   = "lines_exceed"
+	EventMergeConflict = "merge_conflict"
+	EventBranchDrift   = "branch_drift"
+	EventDigest        = "digest"
+	EventReport        = "report"
+)
+
+type (
+	// LinkData is the data of a chat link to an outgoing webhook. The secret is stored concealed.
+	LinkData struct {
+		URL    string `json:"url"`
+		Secret string `json:"secret"`
+	}
+
+	// Envelope is the JSON body posted to the webhook. The payload is the JSON rendering of the protobuf payload of the
+	// event, with the field names as defined in the proto files.
+	Envelope struct {
+		ID        uuid.UUID       `json:"id"`
+		Event     string          `json:"event"`
+		Version   string          `json:"version"`
+		Timestamp time.Time       `json:"timestamp"`
+		Scope     string          `json:"scope"`
+		Action    string          `json:"action"`
+		Source    string          `json:"source"`
+		Subject   events.Subject  `json:"subject"`
+		Payload   json.RawMessage `json:"payload"`
+	}
+)
+
+func (d *LinkData) Marshal() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+func (d *LinkData) Unmarshal(data []byte) error {
+	return json.Unmarshal(data, d)
+}
@@ -0,0 +1,11 @@
+package errors
+
+import (
+	"errors"
+)
+
+var (
+	ErrUnexpectedStatus = errors.New("unexpected status from webhook")
+	ErrInvalidURL       = errors.New("invalid webhook url")
+	ErrForbiddenAddress = errors.New("webhook address is not public")
+)
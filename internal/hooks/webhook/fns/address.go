@@ -0,0 +1,82 @@
+package fns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+
+	pkgerrors "go.breu.io/quantm/internal/hooks/webhook/errors"
+)
+
+// ValidateURL checks that the url of a webhook is https, and that its host resolves to public addresses only. The
+// addresses are checked again on every delivery, since the host may resolve differently by then.
+func ValidateURL(ctx context.Context, raw string) error {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: unable to parse", pkgerrors.ErrInvalidURL)
+	}
+
+	if parsed.Scheme != "https" {
+		return fmt.Errorf("%w: scheme must be https", pkgerrors.ErrInvalidURL)
+	}
+
+	host := parsed.Hostname()
+	if host == "" {
+		return fmt.Errorf("%w: host is missing", pkgerrors.ErrInvalidURL)
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return check_ip(ip)
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("%w: unable to resolve %s", pkgerrors.ErrInvalidURL, host)
+	}
+
+	for _, addr := range addrs {
+		if err := check_ip(addr.IP); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsPublic reports whether the address is routable on the internet, i.e. neither loopback, private, link-local,
+// multicast nor unspecified.
+func IsPublic(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+func check_ip(ip net.IP) error {
+	if !IsPublic(ip) {
+		return fmt.Errorf("%w: %s", pkgerrors.ErrForbiddenAddress, ip)
+	}
+
+	return nil
+}
+
+// dial_control runs after the host of the connection is resolved, and refuses to connect to an address that is not
+// public, so that a host resolving to a private address after the link was validated is still refused.
+func dial_control(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("%w: %s", pkgerrors.ErrForbiddenAddress, host)
+	}
+
+	return check_ip(ip)
+}
@@ -0,0 +1,167 @@
+package fns
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+	pkgerrors "go.breu.io/quantm/internal/hooks/webhook/errors"
+)
+
+type (
+	// Client posts the signed envelopes to the webhooks. A delivery is retried with exponential backoff on network errors,
+	// rate limits and server errors, any other response is final. Only https urls are posted to, the client connects to
+	// public addresses only, and redirects are not followed.
+	Client struct {
+		http     *http.Client
+		attempts uint
+		delay    time.Duration
+	}
+
+	ClientOption func(*Client)
+)
+
+const (
+	DefaultAttempts = 4
+	DefaultDelay    = 500 * time.Millisecond
+	DefaultTimeout  = 10 * time.Second
+)
+
+// Sign returns the value of the signature header for the body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Post posts the envelope to the webhook at endpoint, signed with the revealed secret.
+func (c *Client) Post(ctx context.Context, endpoint, secret string, envelope *defs.Envelope) error {
+	body, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+
+	signature := Sign(secret, body)
+
+	return retry.Do(
+		func() error { return c.post(ctx, endpoint, signature, envelope, body) },
+		retry.Context(ctx),
+		retry.Attempts(c.attempts),
+		retry.Delay(c.delay),
+		retry.DelayType(retry.BackOffDelay),
+		retry.LastErrorOnly(true),
+		retry.OnRetry(func(count uint, err error) {
+			slog.Warn("webhook: delivery failed, retrying ...", "delivery", envelope.ID, "attempt", count+1, "error", err.Error())
+		}),
+	)
+}
+
+func (c *Client) post(ctx context.Context, endpoint, signature string, envelope *defs.Envelope, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return retry.Unrecoverable(fmt.Errorf("%w: unable to parse", pkgerrors.ErrInvalidURL))
+	}
+
+	if req.URL.Scheme != "https" {
+		return retry.Unrecoverable(fmt.Errorf("%w: scheme must be https", pkgerrors.ErrInvalidURL))
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "quantm-webhook")
+	req.Header.Set(defs.HeaderEvent, envelope.Event)
+	req.Header.Set(defs.HeaderDelivery, envelope.ID.String())
+	req.Header.Set(defs.HeaderSignature, signature)
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return c.strip(req, err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+		return nil
+	}
+
+	// the url of a webhook may carry credentials, so only the host is reported.
+	err = fmt.Errorf("%w: %s: %s", pkgerrors.ErrUnexpectedStatus, req.URL.Host, res.Status)
+
+	if res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= http.StatusInternalServerError {
+		return err
+	}
+
+	return retry.Unrecoverable(err)
+}
+
+// strip drops the url from the error of the request, since the url of a webhook may carry credentials. A connection to
+// an address that is not public is final.
+func (c *Client) strip(req *http.Request, err error) error {
+	if uerr, ok := err.(*url.Error); ok { // nolint: errorlint
+		err = fmt.Errorf("%s %s: %w", uerr.Op, req.URL.Host, uerr.Err)
+	}
+
+	if errors.Is(err, pkgerrors.ErrForbiddenAddress) {
+		return retry.Unrecoverable(err)
+	}
+
+	return err
+}
+
+// WithHTTPClient replaces the client guarding the addresses, e.g. to post to a local server in tests.
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		c.http = client
+	}
+}
+
+// WithRetry sets the number of attempts of a delivery and the delay before the first retry, doubled on every retry.
+func WithRetry(attempts uint, delay time.Duration) ClientOption {
+	return func(c *Client) {
+		c.attempts = attempts
+		c.delay = delay
+	}
+}
+
+// NewClient creates a client, retrying with the defaults unless configured otherwise.
+func NewClient(opts ...ClientOption) *Client {
+	c := &Client{
+		http:     new_http_client(),
+		attempts: DefaultAttempts,
+		delay:    DefaultDelay,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// new_http_client returns the client connecting to public addresses only. The addresses are checked after they are
+// resolved, so that a host cannot resolve to a public address when validated and to a private one when dialed. Proxies
+// are not used, since the address of the proxy would be checked instead of the address of the webhook.
+func new_http_client() *http.Client {
+	dialer := &net.Dialer{Timeout: DefaultTimeout, Control: dial_control}
+
+	return &http.Client{
+		Timeout:   DefaultTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: DefaultTimeout, ForceAttemptHTTP2: true},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
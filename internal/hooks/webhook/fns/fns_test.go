@@ -0,0 +1,141 @@
+package fns_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+	"go.breu.io/quantm/internal/hooks/webhook/errors"
+	"go.breu.io/quantm/internal/hooks/webhook/fns"
+)
+
+func TestClientPostSigned(t *testing.T) {
+	t.Parallel()
+
+	envelope := &defs.Envelope{ID: uuid.New(), Event: defs.EventMergeConflict, Payload: json.RawMessage(`{"files":["a.go"]}`)}
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		assert.Equal(t, fns.Sign("secret", body), r.Header.Get(defs.HeaderSignature))
+		assert.Equal(t, defs.EventMergeConflict, r.Header.Get(defs.HeaderEvent))
+		assert.Equal(t, envelope.ID.String(), r.Header.Get(defs.HeaderDelivery))
+
+		received := &defs.Envelope{}
+		require.NoError(t, json.Unmarshal(body, received))
+		assert.JSONEq(t, `{"files":["a.go"]}`, string(received.Payload))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	require.NoError(t, fns.NewClient(fns.WithHTTPClient(srv.Client())).Post(context.Background(), srv.URL, "secret", envelope))
+}
+
+func TestClientPostRetries(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := fns.NewClient(fns.WithRetry(3, time.Millisecond), fns.WithHTTPClient(srv.Client()))
+
+	require.NoError(t, client.Post(context.Background(), srv.URL, "secret", &defs.Envelope{ID: uuid.New()}))
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestClientPostDoesNotRetryClientErrors(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusGone)
+	}))
+	defer srv.Close()
+
+	client := fns.NewClient(fns.WithRetry(3, time.Millisecond), fns.WithHTTPClient(srv.Client()))
+
+	err := client.Post(context.Background(), srv.URL, "secret", &defs.Envelope{ID: uuid.New()})
+	assert.ErrorIs(t, err, errors.ErrUnexpectedStatus)
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestClientPostRefusesPrivateAddresses(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := fns.NewClient(fns.WithRetry(3, time.Millisecond))
+
+	err := client.Post(context.Background(), srv.URL+"/hook?token=secret", "secret", &defs.Envelope{ID: uuid.New()})
+	assert.ErrorIs(t, err, errors.ErrForbiddenAddress)
+	assert.NotContains(t, err.Error(), "token=secret")
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func TestClientPostRefusesHTTP(t *testing.T) {
+	t.Parallel()
+
+	err := fns.NewClient().Post(context.Background(), "http://example.com/hook", "secret", &defs.Envelope{ID: uuid.New()})
+	assert.ErrorIs(t, err, errors.ErrInvalidURL)
+}
+
+func TestValidateURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		url  string
+		err  error
+	}{
+		{"public", "https://8.8.8.8/hook", nil},
+		{"http", "http://8.8.8.8/hook", errors.ErrInvalidURL},
+		{"no host", "https:///hook", errors.ErrInvalidURL},
+		{"loopback", "https://127.0.0.1/hook", errors.ErrForbiddenAddress},
+		{"private", "https://10.0.0.1/hook", errors.ErrForbiddenAddress},
+		{"link local", "https://169.254.169.254/latest/meta-data", errors.ErrForbiddenAddress},
+		{"loopback v6", "https://[::1]/hook", errors.ErrForbiddenAddress},
+		{"unspecified", "https://0.0.0.0/hook", errors.ErrForbiddenAddress},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := fns.ValidateURL(context.Background(), test.url)
+			if test.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package nomad
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/erratic"
+	"go.breu.io/quantm/internal/hooks/webhook/defs"
+	"go.breu.io/quantm/internal/hooks/webhook/fns"
+	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
+	webhookv1 "go.breu.io/quantm/internal/proto/hooks/webhook/v1"
+	"go.breu.io/quantm/internal/proto/hooks/webhook/v1/webhookv1connect"
+)
+
+type (
+	WebhookService struct {
+		webhookv1connect.UnimplementedWebhookServiceHandler
+	}
+)
+
+// Link links an outgoing webhook to a repo or to a user. Only https urls resolving to public addresses are linked. The
+// secret is concealed with the id of the link as salt before it is stored.
+func (s *WebhookService) Link(
+	ctx context.Context, req *connect.Request[webhookv1.LinkRequest],
+) (*connect.Response[emptypb.Empty], error) {
+	link_to, err := uuid.Parse(req.Msg.GetLinkTo())
+	if err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksWebhookModule).WithReason("invalid link_to UUID").Wrap(err)
+	}
+
+	if err := fns.ValidateURL(ctx, req.Msg.GetUrl()); err != nil {
+		return nil, erratic.NewBadRequestError(erratic.HooksWebhookModule).WithReason(err.Error())
+	}
+
+	existing, err := db.Queries().GetChatLink(ctx, link_to)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, erratic.NewDatabaseError(erratic.HooksWebhookModule).WithReason("failed to query chat link").Wrap(err)
+	}
+
+	if existing.ID != uuid.Nil {
+		return nil, erratic.NewExistsError(erratic.HooksWebhookModule).WithReason("chat link already exists")
+	}
+
+	secret, err := auth.Conceal(req.Msg.GetSecret(), link_to.String())
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksWebhookModule).WithReason("unable to conceal secret").Wrap(err)
+	}
+
+	link := &defs.LinkData{URL: req.Msg.GetUrl(), Secret: secret}
+
+	data, err := link.Marshal()
+	if err != nil {
+		return nil, erratic.NewSystemError(erratic.HooksWebhookModule).WithReason("unable to encode chat link").Wrap(err)
+	}
+
+	params := entities.CreateChatLinkParams{
+		Hook:   int32(eventsv1.ChatHook_CHAT_HOOK_WEBHOOK),
+		Kind:   defs.KindWebhook,
+		LinkTo: link_to,
+		Data:   data,
+	}
+
+	if _, err := db.Queries().CreateChatLink(ctx, params); err != nil {
+		return nil, erratic.NewDatabaseError(erratic.HooksWebhookModule).WithReason("failed to save chat link").Wrap(err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func NewWebhookServiceHandler(opts ...connect.HandlerOption) (string, http.Handler) {
+	return webhookv1connect.NewWebhookServiceHandler(&WebhookService{}, opts...)
+}
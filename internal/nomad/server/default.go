@@ -4,9 +4,18 @@ import (
 	"connectrpc.com/connect"
 
 	"go.breu.io/quantm/internal/auth"
+	"go.breu.io/quantm/internal/core/lineage"
+	"go.breu.io/quantm/internal/core/metrics"
+	"go.breu.io/quantm/internal/core/notify"
+	"go.breu.io/quantm/internal/core/reports"
 	"go.breu.io/quantm/internal/core/repos"
+	"go.breu.io/quantm/internal/hooks/email"
+	"go.breu.io/quantm/internal/hooks/gitea"
 	"go.breu.io/quantm/internal/hooks/github"
+	"go.breu.io/quantm/internal/hooks/gitlab"
 	"go.breu.io/quantm/internal/hooks/slack"
+	"go.breu.io/quantm/internal/hooks/teams"
+	"go.breu.io/quantm/internal/hooks/webhook"
 	"go.breu.io/quantm/internal/nomad/intercepts"
 )
 
@@ -40,11 +49,38 @@ func DefaultServer(opts ...Option) *Server {
 	// -- core/repos --
 	srv.add(repos.NomadHandler(options...))
 
+	// -- core/notify --
+	srv.add(notify.NomadHandler(options...))
+
+	// -- core/reports --
+	srv.add(reports.NomadHandler(options...))
+
+	// -- core/metrics --
+	srv.add(metrics.NomadHandler(options...))
+
+	// -- core/lineage --
+	srv.add(lineage.NomadHandler(options...))
+
 	// -- hooks/github --
 	srv.add(github.NomadHandler(options...))
 
+	// -- hooks/gitlab --
+	srv.add(gitlab.NomadHandler(options...))
+
+	// -- hooks/gitea --
+	srv.add(gitea.NomadHandler(options...))
+
 	// -- hooks/slack --
 	srv.add(slack.NomadHandler(options...))
 
+	// -- hooks/teams --
+	srv.add(teams.NomadHandler(options...))
+
+	// -- hooks/webhook --
+	srv.add(webhook.NomadHandler(options...))
+
+	// -- hooks/email --
+	srv.add(email.NomadHandler(options...))
+
 	return srv
 }
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ctrlplane/core/v1/lineage.proto
+
+package corev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LineageServiceName is the fully-qualified name of the LineageService service.
+	LineageServiceName = "ctrlplane.core.v1.LineageService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LineageServiceGetLineageProcedure is the fully-qualified name of the LineageService's GetLineage
+	// RPC.
+	LineageServiceGetLineageProcedure = "/ctrlplane.core.v1.LineageService/GetLineage"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	lineageServiceServiceDescriptor          = v1.File_ctrlplane_core_v1_lineage_proto.Services().ByName("LineageService")
+	lineageServiceGetLineageMethodDescriptor = lineageServiceServiceDescriptor.Methods().ByName("GetLineage")
+)
+
+// LineageServiceClient is a client for the ctrlplane.core.v1.LineageService service.
+type LineageServiceClient interface {
+	// Get the causal graph of an event, a pull request or a branch of a repo of the org of the authenticated user.
+	GetLineage(context.Context, *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error)
+}
+
+// NewLineageServiceClient constructs a client for the ctrlplane.core.v1.LineageService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLineageServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LineageServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &lineageServiceClient{
+		getLineage: connect.NewClient[v1.GetLineageRequest, v1.GetLineageResponse](
+			httpClient,
+			baseURL+LineageServiceGetLineageProcedure,
+			connect.WithSchema(lineageServiceGetLineageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// lineageServiceClient implements LineageServiceClient.
+type lineageServiceClient struct {
+	getLineage *connect.Client[v1.GetLineageRequest, v1.GetLineageResponse]
+}
+
+// GetLineage calls ctrlplane.core.v1.LineageService.GetLineage.
+func (c *lineageServiceClient) GetLineage(ctx context.Context, req *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error) {
+	return c.getLineage.CallUnary(ctx, req)
+}
+
+// LineageServiceHandler is an implementation of the ctrlplane.core.v1.LineageService service.
+type LineageServiceHandler interface {
+	// Get the causal graph of an event, a pull request or a branch of a repo of the org of the authenticated user.
+	GetLineage(context.Context, *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error)
+}
+
+// NewLineageServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLineageServiceHandler(svc LineageServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	lineageServiceGetLineageHandler := connect.NewUnaryHandler(
+		LineageServiceGetLineageProcedure,
+		svc.GetLineage,
+		connect.WithSchema(lineageServiceGetLineageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ctrlplane.core.v1.LineageService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LineageServiceGetLineageProcedure:
+			lineageServiceGetLineageHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLineageServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLineageServiceHandler struct{}
+
+func (UnimplementedLineageServiceHandler) GetLineage(context.Context, *connect.Request[v1.GetLineageRequest]) (*connect.Response[v1.GetLineageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.LineageService.GetLineage is not implemented"))
+}
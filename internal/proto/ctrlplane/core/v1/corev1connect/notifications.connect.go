@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ctrlplane/core/v1/notifications.proto
+
+package corev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// NotificationServiceName is the fully-qualified name of the NotificationService service.
+	NotificationServiceName = "ctrlplane.core.v1.NotificationService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// NotificationServiceGetNotificationPreferenceProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationPreference RPC.
+	NotificationServiceGetNotificationPreferenceProcedure = "/ctrlplane.core.v1.NotificationService/GetNotificationPreference"
+	// NotificationServiceSetNotificationPreferenceProcedure is the fully-qualified name of the
+	// NotificationService's SetNotificationPreference RPC.
+	NotificationServiceSetNotificationPreferenceProcedure = "/ctrlplane.core.v1.NotificationService/SetNotificationPreference"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	notificationServiceServiceDescriptor                         = v1.File_ctrlplane_core_v1_notifications_proto.Services().ByName("NotificationService")
+	notificationServiceGetNotificationPreferenceMethodDescriptor = notificationServiceServiceDescriptor.Methods().ByName("GetNotificationPreference")
+	notificationServiceSetNotificationPreferenceMethodDescriptor = notificationServiceServiceDescriptor.Methods().ByName("SetNotificationPreference")
+)
+
+// NotificationServiceClient is a client for the ctrlplane.core.v1.NotificationService service.
+type NotificationServiceClient interface {
+	// Get the notification preferences of the authenticated user, or of one of the teams of the user.
+	GetNotificationPreference(context.Context, *connect.Request[v1.GetNotificationPreferenceRequest]) (*connect.Response[v1.GetNotificationPreferenceResponse], error)
+	// Set the notification preferences of the authenticated user, or of a team the user is an admin of.
+	SetNotificationPreference(context.Context, *connect.Request[v1.SetNotificationPreferenceRequest]) (*connect.Response[v1.SetNotificationPreferenceResponse], error)
+}
+
+// NewNotificationServiceClient constructs a client for the ctrlplane.core.v1.NotificationService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NotificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &notificationServiceClient{
+		getNotificationPreference: connect.NewClient[v1.GetNotificationPreferenceRequest, v1.GetNotificationPreferenceResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationPreferenceProcedure,
+			connect.WithSchema(notificationServiceGetNotificationPreferenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setNotificationPreference: connect.NewClient[v1.SetNotificationPreferenceRequest, v1.SetNotificationPreferenceResponse](
+			httpClient,
+			baseURL+NotificationServiceSetNotificationPreferenceProcedure,
+			connect.WithSchema(notificationServiceSetNotificationPreferenceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// notificationServiceClient implements NotificationServiceClient.
+type notificationServiceClient struct {
+	getNotificationPreference *connect.Client[v1.GetNotificationPreferenceRequest, v1.GetNotificationPreferenceResponse]
+	setNotificationPreference *connect.Client[v1.SetNotificationPreferenceRequest, v1.SetNotificationPreferenceResponse]
+}
+
+// GetNotificationPreference calls ctrlplane.core.v1.NotificationService.GetNotificationPreference.
+func (c *notificationServiceClient) GetNotificationPreference(ctx context.Context, req *connect.Request[v1.GetNotificationPreferenceRequest]) (*connect.Response[v1.GetNotificationPreferenceResponse], error) {
+	return c.getNotificationPreference.CallUnary(ctx, req)
+}
+
+// SetNotificationPreference calls ctrlplane.core.v1.NotificationService.SetNotificationPreference.
+func (c *notificationServiceClient) SetNotificationPreference(ctx context.Context, req *connect.Request[v1.SetNotificationPreferenceRequest]) (*connect.Response[v1.SetNotificationPreferenceResponse], error) {
+	return c.setNotificationPreference.CallUnary(ctx, req)
+}
+
+// NotificationServiceHandler is an implementation of the ctrlplane.core.v1.NotificationService
+// service.
+type NotificationServiceHandler interface {
+	// Get the notification preferences of the authenticated user, or of one of the teams of the user.
+	GetNotificationPreference(context.Context, *connect.Request[v1.GetNotificationPreferenceRequest]) (*connect.Response[v1.GetNotificationPreferenceResponse], error)
+	// Set the notification preferences of the authenticated user, or of a team the user is an admin of.
+	SetNotificationPreference(context.Context, *connect.Request[v1.SetNotificationPreferenceRequest]) (*connect.Response[v1.SetNotificationPreferenceResponse], error)
+}
+
+// NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	notificationServiceGetNotificationPreferenceHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationPreferenceProcedure,
+		svc.GetNotificationPreference,
+		connect.WithSchema(notificationServiceGetNotificationPreferenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceSetNotificationPreferenceHandler := connect.NewUnaryHandler(
+		NotificationServiceSetNotificationPreferenceProcedure,
+		svc.SetNotificationPreference,
+		connect.WithSchema(notificationServiceSetNotificationPreferenceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ctrlplane.core.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NotificationServiceGetNotificationPreferenceProcedure:
+			notificationServiceGetNotificationPreferenceHandler.ServeHTTP(w, r)
+		case NotificationServiceSetNotificationPreferenceProcedure:
+			notificationServiceSetNotificationPreferenceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNotificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNotificationServiceHandler struct{}
+
+func (UnimplementedNotificationServiceHandler) GetNotificationPreference(context.Context, *connect.Request[v1.GetNotificationPreferenceRequest]) (*connect.Response[v1.GetNotificationPreferenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.NotificationService.GetNotificationPreference is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) SetNotificationPreference(context.Context, *connect.Request[v1.SetNotificationPreferenceRequest]) (*connect.Response[v1.SetNotificationPreferenceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.NotificationService.SetNotificationPreference is not implemented"))
+}
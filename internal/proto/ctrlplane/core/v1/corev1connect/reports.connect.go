@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: ctrlplane/core/v1/reports.proto
+
+package corev1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/ctrlplane/core/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ReportServiceName is the fully-qualified name of the ReportService service.
+	ReportServiceName = "ctrlplane.core.v1.ReportService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ReportServiceGetReportScheduleProcedure is the fully-qualified name of the ReportService's
+	// GetReportSchedule RPC.
+	ReportServiceGetReportScheduleProcedure = "/ctrlplane.core.v1.ReportService/GetReportSchedule"
+	// ReportServiceSetReportScheduleProcedure is the fully-qualified name of the ReportService's
+	// SetReportSchedule RPC.
+	ReportServiceSetReportScheduleProcedure = "/ctrlplane.core.v1.ReportService/SetReportSchedule"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	reportServiceServiceDescriptor                 = v1.File_ctrlplane_core_v1_reports_proto.Services().ByName("ReportService")
+	reportServiceGetReportScheduleMethodDescriptor = reportServiceServiceDescriptor.Methods().ByName("GetReportSchedule")
+	reportServiceSetReportScheduleMethodDescriptor = reportServiceServiceDescriptor.Methods().ByName("SetReportSchedule")
+)
+
+// ReportServiceClient is a client for the ctrlplane.core.v1.ReportService service.
+type ReportServiceClient interface {
+	// Get the report schedule of a repo or of a team of the org of the authenticated user.
+	GetReportSchedule(context.Context, *connect.Request[v1.GetReportScheduleRequest]) (*connect.Response[v1.GetReportScheduleResponse], error)
+	// Set the report schedule of a repo or of a team of the org of the authenticated user.
+	SetReportSchedule(context.Context, *connect.Request[v1.SetReportScheduleRequest]) (*connect.Response[v1.SetReportScheduleResponse], error)
+}
+
+// NewReportServiceClient constructs a client for the ctrlplane.core.v1.ReportService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReportServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReportServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &reportServiceClient{
+		getReportSchedule: connect.NewClient[v1.GetReportScheduleRequest, v1.GetReportScheduleResponse](
+			httpClient,
+			baseURL+ReportServiceGetReportScheduleProcedure,
+			connect.WithSchema(reportServiceGetReportScheduleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setReportSchedule: connect.NewClient[v1.SetReportScheduleRequest, v1.SetReportScheduleResponse](
+			httpClient,
+			baseURL+ReportServiceSetReportScheduleProcedure,
+			connect.WithSchema(reportServiceSetReportScheduleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// reportServiceClient implements ReportServiceClient.
+type reportServiceClient struct {
+	getReportSchedule *connect.Client[v1.GetReportScheduleRequest, v1.GetReportScheduleResponse]
+	setReportSchedule *connect.Client[v1.SetReportScheduleRequest, v1.SetReportScheduleResponse]
+}
+
+// GetReportSchedule calls ctrlplane.core.v1.ReportService.GetReportSchedule.
+func (c *reportServiceClient) GetReportSchedule(ctx context.Context, req *connect.Request[v1.GetReportScheduleRequest]) (*connect.Response[v1.GetReportScheduleResponse], error) {
+	return c.getReportSchedule.CallUnary(ctx, req)
+}
+
+// SetReportSchedule calls ctrlplane.core.v1.ReportService.SetReportSchedule.
+func (c *reportServiceClient) SetReportSchedule(ctx context.Context, req *connect.Request[v1.SetReportScheduleRequest]) (*connect.Response[v1.SetReportScheduleResponse], error) {
+	return c.setReportSchedule.CallUnary(ctx, req)
+}
+
+// ReportServiceHandler is an implementation of the ctrlplane.core.v1.ReportService service.
+type ReportServiceHandler interface {
+	// Get the report schedule of a repo or of a team of the org of the authenticated user.
+	GetReportSchedule(context.Context, *connect.Request[v1.GetReportScheduleRequest]) (*connect.Response[v1.GetReportScheduleResponse], error)
+	// Set the report schedule of a repo or of a team of the org of the authenticated user.
+	SetReportSchedule(context.Context, *connect.Request[v1.SetReportScheduleRequest]) (*connect.Response[v1.SetReportScheduleResponse], error)
+}
+
+// NewReportServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReportServiceHandler(svc ReportServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	reportServiceGetReportScheduleHandler := connect.NewUnaryHandler(
+		ReportServiceGetReportScheduleProcedure,
+		svc.GetReportSchedule,
+		connect.WithSchema(reportServiceGetReportScheduleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	reportServiceSetReportScheduleHandler := connect.NewUnaryHandler(
+		ReportServiceSetReportScheduleProcedure,
+		svc.SetReportSchedule,
+		connect.WithSchema(reportServiceSetReportScheduleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/ctrlplane.core.v1.ReportService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReportServiceGetReportScheduleProcedure:
+			reportServiceGetReportScheduleHandler.ServeHTTP(w, r)
+		case ReportServiceSetReportScheduleProcedure:
+			reportServiceSetReportScheduleHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReportServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReportServiceHandler struct{}
+
+func (UnimplementedReportServiceHandler) GetReportSchedule(context.Context, *connect.Request[v1.GetReportScheduleRequest]) (*connect.Response[v1.GetReportScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.ReportService.GetReportSchedule is not implemented"))
+}
+
+func (UnimplementedReportServiceHandler) SetReportSchedule(context.Context, *connect.Request[v1.SetReportScheduleRequest]) (*connect.Response[v1.SetReportScheduleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.ReportService.SetReportSchedule is not implemented"))
+}
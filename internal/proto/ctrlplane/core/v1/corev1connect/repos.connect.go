@@ -43,6 +43,15 @@ const (
 	RepoServiceGetOrgReposByOrgIDProcedure = "/ctrlplane.core.v1.RepoService/GetOrgReposByOrgID"
 	// RepoServiceListReposProcedure is the fully-qualified name of the RepoService's ListRepos RPC.
 	RepoServiceListReposProcedure = "/ctrlplane.core.v1.RepoService/ListRepos"
+	// RepoServiceListBranchDriftProcedure is the fully-qualified name of the RepoService's
+	// ListBranchDrift RPC.
+	RepoServiceListBranchDriftProcedure = "/ctrlplane.core.v1.RepoService/ListBranchDrift"
+	// RepoServiceGetRepoSettingsProcedure is the fully-qualified name of the RepoService's
+	// GetRepoSettings RPC.
+	RepoServiceGetRepoSettingsProcedure = "/ctrlplane.core.v1.RepoService/GetRepoSettings"
+	// RepoServiceSetRepoSettingsProcedure is the fully-qualified name of the RepoService's
+	// SetRepoSettings RPC.
+	RepoServiceSetRepoSettingsProcedure = "/ctrlplane.core.v1.RepoService/SetRepoSettings"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -52,6 +61,9 @@ var (
 	repoServiceGetRepoByIDMethodDescriptor        = repoServiceServiceDescriptor.Methods().ByName("GetRepoByID")
 	repoServiceGetOrgReposByOrgIDMethodDescriptor = repoServiceServiceDescriptor.Methods().ByName("GetOrgReposByOrgID")
 	repoServiceListReposMethodDescriptor          = repoServiceServiceDescriptor.Methods().ByName("ListRepos")
+	repoServiceListBranchDriftMethodDescriptor    = repoServiceServiceDescriptor.Methods().ByName("ListBranchDrift")
+	repoServiceGetRepoSettingsMethodDescriptor    = repoServiceServiceDescriptor.Methods().ByName("GetRepoSettings")
+	repoServiceSetRepoSettingsMethodDescriptor    = repoServiceServiceDescriptor.Methods().ByName("SetRepoSettings")
 )
 
 // RepoServiceClient is a client for the ctrlplane.core.v1.RepoService service.
@@ -64,6 +76,12 @@ type RepoServiceClient interface {
 	GetOrgReposByOrgID(context.Context, *connect.Request[v1.GetOrgReposByOrgIDRequest]) (*connect.Response[v1.GetOrgReposByOrgIDResponse], error)
 	// List all org's repos.
 	ListRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListReposResponse], error)
+	// List the branches of a repo along with how far they have drifted from the default branch.
+	ListBranchDrift(context.Context, *connect.Request[v1.ListBranchDriftRequest]) (*connect.Response[v1.ListBranchDriftResponse], error)
+	// Get the settings of a repo of the org of the authenticated user.
+	GetRepoSettings(context.Context, *connect.Request[v1.GetRepoSettingsRequest]) (*connect.Response[v1.GetRepoSettingsResponse], error)
+	// Set the settings of a repo of the org of the authenticated user.
+	SetRepoSettings(context.Context, *connect.Request[v1.SetRepoSettingsRequest]) (*connect.Response[v1.SetRepoSettingsResponse], error)
 }
 
 // NewRepoServiceClient constructs a client for the ctrlplane.core.v1.RepoService service. By
@@ -100,6 +118,24 @@ func NewRepoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(repoServiceListReposMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		listBranchDrift: connect.NewClient[v1.ListBranchDriftRequest, v1.ListBranchDriftResponse](
+			httpClient,
+			baseURL+RepoServiceListBranchDriftProcedure,
+			connect.WithSchema(repoServiceListBranchDriftMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRepoSettings: connect.NewClient[v1.GetRepoSettingsRequest, v1.GetRepoSettingsResponse](
+			httpClient,
+			baseURL+RepoServiceGetRepoSettingsProcedure,
+			connect.WithSchema(repoServiceGetRepoSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		setRepoSettings: connect.NewClient[v1.SetRepoSettingsRequest, v1.SetRepoSettingsResponse](
+			httpClient,
+			baseURL+RepoServiceSetRepoSettingsProcedure,
+			connect.WithSchema(repoServiceSetRepoSettingsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -109,6 +145,9 @@ type repoServiceClient struct {
 	getRepoByID        *connect.Client[v1.GetRepoByIDRequest, v1.GetRepoByIDResponse]
 	getOrgReposByOrgID *connect.Client[v1.GetOrgReposByOrgIDRequest, v1.GetOrgReposByOrgIDResponse]
 	listRepos          *connect.Client[emptypb.Empty, v1.ListReposResponse]
+	listBranchDrift    *connect.Client[v1.ListBranchDriftRequest, v1.ListBranchDriftResponse]
+	getRepoSettings    *connect.Client[v1.GetRepoSettingsRequest, v1.GetRepoSettingsResponse]
+	setRepoSettings    *connect.Client[v1.SetRepoSettingsRequest, v1.SetRepoSettingsResponse]
 }
 
 // CreateRepo calls ctrlplane.core.v1.RepoService.CreateRepo.
@@ -131,6 +170,21 @@ func (c *repoServiceClient) ListRepos(ctx context.Context, req *connect.Request[
 	return c.listRepos.CallUnary(ctx, req)
 }
 
+// ListBranchDrift calls ctrlplane.core.v1.RepoService.ListBranchDrift.
+func (c *repoServiceClient) ListBranchDrift(ctx context.Context, req *connect.Request[v1.ListBranchDriftRequest]) (*connect.Response[v1.ListBranchDriftResponse], error) {
+	return c.listBranchDrift.CallUnary(ctx, req)
+}
+
+// GetRepoSettings calls ctrlplane.core.v1.RepoService.GetRepoSettings.
+func (c *repoServiceClient) GetRepoSettings(ctx context.Context, req *connect.Request[v1.GetRepoSettingsRequest]) (*connect.Response[v1.GetRepoSettingsResponse], error) {
+	return c.getRepoSettings.CallUnary(ctx, req)
+}
+
+// SetRepoSettings calls ctrlplane.core.v1.RepoService.SetRepoSettings.
+func (c *repoServiceClient) SetRepoSettings(ctx context.Context, req *connect.Request[v1.SetRepoSettingsRequest]) (*connect.Response[v1.SetRepoSettingsResponse], error) {
+	return c.setRepoSettings.CallUnary(ctx, req)
+}
+
 // RepoServiceHandler is an implementation of the ctrlplane.core.v1.RepoService service.
 type RepoServiceHandler interface {
 	// Create org's core repo.
@@ -141,6 +195,12 @@ type RepoServiceHandler interface {
 	GetOrgReposByOrgID(context.Context, *connect.Request[v1.GetOrgReposByOrgIDRequest]) (*connect.Response[v1.GetOrgReposByOrgIDResponse], error)
 	// List all org's repos.
 	ListRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListReposResponse], error)
+	// List the branches of a repo along with how far they have drifted from the default branch.
+	ListBranchDrift(context.Context, *connect.Request[v1.ListBranchDriftRequest]) (*connect.Response[v1.ListBranchDriftResponse], error)
+	// Get the settings of a repo of the org of the authenticated user.
+	GetRepoSettings(context.Context, *connect.Request[v1.GetRepoSettingsRequest]) (*connect.Response[v1.GetRepoSettingsResponse], error)
+	// Set the settings of a repo of the org of the authenticated user.
+	SetRepoSettings(context.Context, *connect.Request[v1.SetRepoSettingsRequest]) (*connect.Response[v1.SetRepoSettingsResponse], error)
 }
 
 // NewRepoServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -173,6 +233,24 @@ func NewRepoServiceHandler(svc RepoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(repoServiceListReposMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	repoServiceListBranchDriftHandler := connect.NewUnaryHandler(
+		RepoServiceListBranchDriftProcedure,
+		svc.ListBranchDrift,
+		connect.WithSchema(repoServiceListBranchDriftMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	repoServiceGetRepoSettingsHandler := connect.NewUnaryHandler(
+		RepoServiceGetRepoSettingsProcedure,
+		svc.GetRepoSettings,
+		connect.WithSchema(repoServiceGetRepoSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	repoServiceSetRepoSettingsHandler := connect.NewUnaryHandler(
+		RepoServiceSetRepoSettingsProcedure,
+		svc.SetRepoSettings,
+		connect.WithSchema(repoServiceSetRepoSettingsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/ctrlplane.core.v1.RepoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case RepoServiceCreateRepoProcedure:
@@ -183,6 +261,12 @@ func NewRepoServiceHandler(svc RepoServiceHandler, opts ...connect.HandlerOption
 			repoServiceGetOrgReposByOrgIDHandler.ServeHTTP(w, r)
 		case RepoServiceListReposProcedure:
 			repoServiceListReposHandler.ServeHTTP(w, r)
+		case RepoServiceListBranchDriftProcedure:
+			repoServiceListBranchDriftHandler.ServeHTTP(w, r)
+		case RepoServiceGetRepoSettingsProcedure:
+			repoServiceGetRepoSettingsHandler.ServeHTTP(w, r)
+		case RepoServiceSetRepoSettingsProcedure:
+			repoServiceSetRepoSettingsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -207,3 +291,15 @@ func (UnimplementedRepoServiceHandler) GetOrgReposByOrgID(context.Context, *conn
 func (UnimplementedRepoServiceHandler) ListRepos(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ListReposResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.RepoService.ListRepos is not implemented"))
 }
+
+func (UnimplementedRepoServiceHandler) ListBranchDrift(context.Context, *connect.Request[v1.ListBranchDriftRequest]) (*connect.Response[v1.ListBranchDriftResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.RepoService.ListBranchDrift is not implemented"))
+}
+
+func (UnimplementedRepoServiceHandler) GetRepoSettings(context.Context, *connect.Request[v1.GetRepoSettingsRequest]) (*connect.Response[v1.GetRepoSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.RepoService.GetRepoSettings is not implemented"))
+}
+
+func (UnimplementedRepoServiceHandler) SetRepoSettings(context.Context, *connect.Request[v1.SetRepoSettingsRequest]) (*connect.Response[v1.SetRepoSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ctrlplane.core.v1.RepoService.SetRepoSettings is not implemented"))
+}
@@ -0,0 +1,545 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: ctrlplane/core/v1/metrics.proto
+
+package corev1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// What the metrics are computed on.
+type MetricsScope int32
+
+const (
+	MetricsScope_METRICS_SCOPE_UNSPECIFIED MetricsScope = 0
+	MetricsScope_METRICS_SCOPE_REPO        MetricsScope = 1
+	// The repos of the team.
+	MetricsScope_METRICS_SCOPE_TEAM MetricsScope = 2
+	// The repos of the org of the authenticated user.
+	MetricsScope_METRICS_SCOPE_ORG MetricsScope = 3
+)
+
+// Enum value maps for MetricsScope.
+var (
+	MetricsScope_name = map[int32]string{
+		0: "METRICS_SCOPE_UNSPECIFIED",
+		1: "METRICS_SCOPE_REPO",
+		2: "METRICS_SCOPE_TEAM",
+		3: "METRICS_SCOPE_ORG",
+	}
+	MetricsScope_value = map[string]int32{
+		"METRICS_SCOPE_UNSPECIFIED": 0,
+		"METRICS_SCOPE_REPO":        1,
+		"METRICS_SCOPE_TEAM":        2,
+		"METRICS_SCOPE_ORG":         3,
+	}
+)
+
+func (x MetricsScope) Enum() *MetricsScope {
+	p := new(MetricsScope)
+	*p = x
+	return p
+}
+
+func (x MetricsScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricsScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctrlplane_core_v1_metrics_proto_enumTypes[0].Descriptor()
+}
+
+func (MetricsScope) Type() protoreflect.EnumType {
+	return &file_ctrlplane_core_v1_metrics_proto_enumTypes[0]
+}
+
+func (x MetricsScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricsScope.Descriptor instead.
+func (MetricsScope) EnumDescriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_metrics_proto_rawDescGZIP(), []int{0}
+}
+
+// How the metrics are grouped over time.
+type MetricsInterval int32
+
+const (
+	// A single group for the whole range.
+	MetricsInterval_METRICS_INTERVAL_UNSPECIFIED MetricsInterval = 0
+	MetricsInterval_METRICS_INTERVAL_DAY         MetricsInterval = 1
+	// Weeks start on Monday.
+	MetricsInterval_METRICS_INTERVAL_WEEK  MetricsInterval = 2
+	MetricsInterval_METRICS_INTERVAL_MONTH MetricsInterval = 3
+)
+
+// Enum value maps for MetricsInterval.
+var (
+	MetricsInterval_name = map[int32]string{
+		0: "METRICS_INTERVAL_UNSPECIFIED",
+		1: "METRICS_INTERVAL_DAY",
+		2: "METRICS_INTERVAL_WEEK",
+		3: "METRICS_INTERVAL_MONTH",
+	}
+	MetricsInterval_value = map[string]int32{
+		"METRICS_INTERVAL_UNSPECIFIED": 0,
+		"METRICS_INTERVAL_DAY":         1,
+		"METRICS_INTERVAL_WEEK":        2,
+		"METRICS_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x MetricsInterval) Enum() *MetricsInterval {
+	p := new(MetricsInterval)
+	*p = x
+	return p
+}
+
+func (x MetricsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctrlplane_core_v1_metrics_proto_enumTypes[1].Descriptor()
+}
+
+func (MetricsInterval) Type() protoreflect.EnumType {
+	return &file_ctrlplane_core_v1_metrics_proto_enumTypes[1]
+}
+
+func (x MetricsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricsInterval.Descriptor instead.
+func (MetricsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_metrics_proto_rawDescGZIP(), []int{1}
+}
+
+// Represents the DORA metrics over a period. A deployment is a pull request merged on the trunk, and the trunk of a
+// repo is a branch its pull requests are merged on.
+type DoraMetrics struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Start of the period, inclusive.
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// End of the period, exclusive.
+	To          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Deployments uint64                 `protobuf:"varint,3,opt,name=deployments,proto3" json:"deployments,omitempty"`
+	// Deployments per day.
+	DeploymentFrequency float64 `protobuf:"fixed64,4,opt,name=deployment_frequency,json=deploymentFrequency,proto3" json:"deployment_frequency,omitempty"`
+	// Median time from the first commit on a branch to its merge on the trunk. Unset without deployments.
+	LeadTime *durationpb.Duration `protobuf:"bytes,5,opt,name=lead_time,json=leadTime,proto3" json:"lead_time,omitempty"`
+	// Pipelines finished on the trunk, and the ones that failed.
+	Pipelines       uint64 `protobuf:"varint,6,opt,name=pipelines,proto3" json:"pipelines,omitempty"`
+	FailedPipelines uint64 `protobuf:"varint,7,opt,name=failed_pipelines,json=failedPipelines,proto3" json:"failed_pipelines,omitempty"`
+	// Share of the pipelines on the trunk that failed, from 0 to 1.
+	ChangeFailureRate float64 `protobuf:"fixed64,8,opt,name=change_failure_rate,json=changeFailureRate,proto3" json:"change_failure_rate,omitempty"`
+	// Median time from a pipeline failing on the trunk, after a successful one, to the next successful one. Unset without
+	// a restore.
+	TimeToRestore *durationpb.Duration `protobuf:"bytes,9,opt,name=time_to_restore,json=timeToRestore,proto3" json:"time_to_restore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoraMetrics) Reset() {
+	*x = DoraMetrics{}
+	mi := &file_ctrlplane_core_v1_metrics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoraMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoraMetrics) ProtoMessage() {}
+
+func (x *DoraMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_metrics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoraMetrics.ProtoReflect.Descriptor instead.
+func (*DoraMetrics) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_metrics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DoraMetrics) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DoraMetrics) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DoraMetrics) GetDeployments() uint64 {
+	if x != nil {
+		return x.Deployments
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetDeploymentFrequency() float64 {
+	if x != nil {
+		return x.DeploymentFrequency
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetLeadTime() *durationpb.Duration {
+	if x != nil {
+		return x.LeadTime
+	}
+	return nil
+}
+
+func (x *DoraMetrics) GetPipelines() uint64 {
+	if x != nil {
+		return x.Pipelines
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetFailedPipelines() uint64 {
+	if x != nil {
+		return x.FailedPipelines
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetChangeFailureRate() float64 {
+	if x != nil {
+		return x.ChangeFailureRate
+	}
+	return 0
+}
+
+func (x *DoraMetrics) GetTimeToRestore() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToRestore
+	}
+	return nil
+}
+
+// Request to get the DORA metrics of a repo, a team or the org, from inclusive, to exclusive.
+type GetDoraMetricsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Scope MetricsScope           `protobuf:"varint,1,opt,name=scope,proto3,enum=ctrlplane.core.v1.MetricsScope" json:"scope,omitempty"`
+	// ID of the repo or of the team, ignored for the org.
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Interval      MetricsInterval        `protobuf:"varint,5,opt,name=interval,proto3,enum=ctrlplane.core.v1.MetricsInterval" json:"interval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoraMetricsRequest) Reset() {
+	*x = GetDoraMetricsRequest{}
+	mi := &file_ctrlplane_core_v1_metrics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoraMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoraMetricsRequest) ProtoMessage() {}
+
+func (x *GetDoraMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_metrics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoraMetricsRequest.ProtoReflect.Descriptor instead.
+func (*GetDoraMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_metrics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetDoraMetricsRequest) GetScope() MetricsScope {
+	if x != nil {
+		return x.Scope
+	}
+	return MetricsScope_METRICS_SCOPE_UNSPECIFIED
+}
+
+func (x *GetDoraMetricsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDoraMetricsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetDoraMetricsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetDoraMetricsRequest) GetInterval() MetricsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return MetricsInterval_METRICS_INTERVAL_UNSPECIFIED
+}
+
+// Response containing the DORA metrics of each period, in order.
+type GetDoraMetricsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       []*DoraMetrics         `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDoraMetricsResponse) Reset() {
+	*x = GetDoraMetricsResponse{}
+	mi := &file_ctrlplane_core_v1_metrics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDoraMetricsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDoraMetricsResponse) ProtoMessage() {}
+
+func (x *GetDoraMetricsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_metrics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDoraMetricsResponse.ProtoReflect.Descriptor instead.
+func (*GetDoraMetricsResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_metrics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDoraMetricsResponse) GetMetrics() []*DoraMetrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+var File_ctrlplane_core_v1_metrics_proto protoreflect.FileDescriptor
+
+var file_ctrlplane_core_v1_metrics_proto_rawDesc = string([]byte{
+	0x0a, 0x1f, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x11, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb2, 0x03, 0x0a, 0x0b, 0x44, 0x6f, 0x72, 0x61, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x31, 0x0a, 0x14, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x66,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x6c, 0x65, 0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x5f, 0x70, 0x69, 0x70, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x50, 0x69, 0x70, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x41, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x54, 0x6f,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44,
+	0x6f, 0x72, 0x61, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x41, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x52, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x72, 0x61, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x72, 0x61, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2a, 0x74,
+	0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1d,
+	0x0a, 0x19, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x52,
+	0x45, 0x50, 0x4f, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53,
+	0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x41, 0x4d, 0x10, 0x02, 0x12, 0x15, 0x0a,
+	0x11, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x4f,
+	0x52, 0x47, 0x10, 0x03, 0x2a, 0x84, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x54, 0x52,
+	0x49, 0x43, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45,
+	0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x44,
+	0x41, 0x59, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x53, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x56, 0x41, 0x4c, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x03, 0x32, 0x77, 0x0a, 0x0e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x72, 0x61, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12,
+	0x28, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x72, 0x61, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x63, 0x74, 0x72, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x6f, 0x72, 0x61, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0xc6, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x74, 0x72,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39,
+	0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x76, 0x31, 0x3b, 0x63, 0x6f, 0x72, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa,
+	0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x65,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c,
+	0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_ctrlplane_core_v1_metrics_proto_rawDescOnce sync.Once
+	file_ctrlplane_core_v1_metrics_proto_rawDescData []byte
+)
+
+func file_ctrlplane_core_v1_metrics_proto_rawDescGZIP() []byte {
+	file_ctrlplane_core_v1_metrics_proto_rawDescOnce.Do(func() {
+		file_ctrlplane_core_v1_metrics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ctrlplane_core_v1_metrics_proto_rawDesc), len(file_ctrlplane_core_v1_metrics_proto_rawDesc)))
+	})
+	return file_ctrlplane_core_v1_metrics_proto_rawDescData
+}
+
+var file_ctrlplane_core_v1_metrics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_ctrlplane_core_v1_metrics_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_ctrlplane_core_v1_metrics_proto_goTypes = []any{
+	(MetricsScope)(0),              // 0: ctrlplane.core.v1.MetricsScope
+	(MetricsInterval)(0),           // 1: ctrlplane.core.v1.MetricsInterval
+	(*DoraMetrics)(nil),            // 2: ctrlplane.core.v1.DoraMetrics
+	(*GetDoraMetricsRequest)(nil),  // 3: ctrlplane.core.v1.GetDoraMetricsRequest
+	(*GetDoraMetricsResponse)(nil), // 4: ctrlplane.core.v1.GetDoraMetricsResponse
+	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
+}
+var file_ctrlplane_core_v1_metrics_proto_depIdxs = []int32{
+	5,  // 0: ctrlplane.core.v1.DoraMetrics.from:type_name -> google.protobuf.Timestamp
+	5,  // 1: ctrlplane.core.v1.DoraMetrics.to:type_name -> google.protobuf.Timestamp
+	6,  // 2: ctrlplane.core.v1.DoraMetrics.lead_time:type_name -> google.protobuf.Duration
+	6,  // 3: ctrlplane.core.v1.DoraMetrics.time_to_restore:type_name -> google.protobuf.Duration
+	0,  // 4: ctrlplane.core.v1.GetDoraMetricsRequest.scope:type_name -> ctrlplane.core.v1.MetricsScope
+	5,  // 5: ctrlplane.core.v1.GetDoraMetricsRequest.from:type_name -> google.protobuf.Timestamp
+	5,  // 6: ctrlplane.core.v1.GetDoraMetricsRequest.to:type_name -> google.protobuf.Timestamp
+	1,  // 7: ctrlplane.core.v1.GetDoraMetricsRequest.interval:type_name -> ctrlplane.core.v1.MetricsInterval
+	2,  // 8: ctrlplane.core.v1.GetDoraMetricsResponse.metrics:type_name -> ctrlplane.core.v1.DoraMetrics
+	3,  // 9: ctrlplane.core.v1.MetricsService.GetDoraMetrics:input_type -> ctrlplane.core.v1.GetDoraMetricsRequest
+	4,  // 10: ctrlplane.core.v1.MetricsService.GetDoraMetrics:output_type -> ctrlplane.core.v1.GetDoraMetricsResponse
+	10, // [10:11] is the sub-list for method output_type
+	9,  // [9:10] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_ctrlplane_core_v1_metrics_proto_init() }
+func file_ctrlplane_core_v1_metrics_proto_init() {
+	if File_ctrlplane_core_v1_metrics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ctrlplane_core_v1_metrics_proto_rawDesc), len(file_ctrlplane_core_v1_metrics_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ctrlplane_core_v1_metrics_proto_goTypes,
+		DependencyIndexes: file_ctrlplane_core_v1_metrics_proto_depIdxs,
+		EnumInfos:         file_ctrlplane_core_v1_metrics_proto_enumTypes,
+		MessageInfos:      file_ctrlplane_core_v1_metrics_proto_msgTypes,
+	}.Build()
+	File_ctrlplane_core_v1_metrics_proto = out.File
+	file_ctrlplane_core_v1_metrics_proto_goTypes = nil
+	file_ctrlplane_core_v1_metrics_proto_depIdxs = nil
+}
@@ -0,0 +1,531 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: ctrlplane/core/v1/notifications.proto
+
+package corev1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Where the notifications are delivered.
+type NotificationChannel int32
+
+const (
+	NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED NotificationChannel = 0
+	// Direct message to the user on the chat linked to the user.
+	NotificationChannel_NOTIFICATION_CHANNEL_DM NotificationChannel = 1
+	// The channel linked to the repo.
+	NotificationChannel_NOTIFICATION_CHANNEL_TEAM NotificationChannel = 2
+	// The email address of the user.
+	NotificationChannel_NOTIFICATION_CHANNEL_EMAIL NotificationChannel = 3
+)
+
+// Enum value maps for NotificationChannel.
+var (
+	NotificationChannel_name = map[int32]string{
+		0: "NOTIFICATION_CHANNEL_UNSPECIFIED",
+		1: "NOTIFICATION_CHANNEL_DM",
+		2: "NOTIFICATION_CHANNEL_TEAM",
+		3: "NOTIFICATION_CHANNEL_EMAIL",
+	}
+	NotificationChannel_value = map[string]int32{
+		"NOTIFICATION_CHANNEL_UNSPECIFIED": 0,
+		"NOTIFICATION_CHANNEL_DM":          1,
+		"NOTIFICATION_CHANNEL_TEAM":        2,
+		"NOTIFICATION_CHANNEL_EMAIL":       3,
+	}
+)
+
+func (x NotificationChannel) Enum() *NotificationChannel {
+	p := new(NotificationChannel)
+	*p = x
+	return p
+}
+
+func (x NotificationChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctrlplane_core_v1_notifications_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationChannel) Type() protoreflect.EnumType {
+	return &file_ctrlplane_core_v1_notifications_proto_enumTypes[0]
+}
+
+func (x NotificationChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationChannel.Descriptor instead.
+func (NotificationChannel) EnumDescriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_notifications_proto_rawDescGZIP(), []int{0}
+}
+
+// Represents the notification preferences of a user or of a team. The preferences of a team apply to its members
+// without preferences of their own.
+type NotificationPreference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the user or of the team.
+	LinkTo string `protobuf:"bytes,1,opt,name=link_to,json=linkTo,proto3" json:"link_to,omitempty"`
+	// Kinds of notifications not to receive, i.e. lines_exceeded, merge_conflict, branch_drift, conflict_resolved and
+	// pr_merged.
+	Muted   []string            `protobuf:"bytes,2,rep,name=muted,proto3" json:"muted,omitempty"`
+	Channel NotificationChannel `protobuf:"varint,3,opt,name=channel,proto3,enum=ctrlplane.core.v1.NotificationChannel" json:"channel,omitempty"`
+	// IANA name of the timezone the quiet hours and the digest are in, e.g. Europe/Berlin.
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Start of the quiet hours, in minutes after midnight. Equal to quiet_end if there are no quiet hours.
+	QuietStart int32 `protobuf:"varint,5,opt,name=quiet_start,json=quietStart,proto3" json:"quiet_start,omitempty"`
+	// End of the quiet hours, in minutes after midnight.
+	QuietEnd int32 `protobuf:"varint,6,opt,name=quiet_end,json=quietEnd,proto3" json:"quiet_end,omitempty"`
+	// Batches the low priority notifications, i.e. the size warnings and the stale branch reminders, into a daily digest.
+	Digest bool `protobuf:"varint,7,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Hour of the day the digest is delivered at.
+	DigestHour    int32 `protobuf:"varint,8,opt,name=digest_hour,json=digestHour,proto3" json:"digest_hour,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreference) Reset() {
+	*x = NotificationPreference{}
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreference) ProtoMessage() {}
+
+func (x *NotificationPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreference.ProtoReflect.Descriptor instead.
+func (*NotificationPreference) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_notifications_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationPreference) GetLinkTo() string {
+	if x != nil {
+		return x.LinkTo
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetMuted() []string {
+	if x != nil {
+		return x.Muted
+	}
+	return nil
+}
+
+func (x *NotificationPreference) GetChannel() NotificationChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return NotificationChannel_NOTIFICATION_CHANNEL_UNSPECIFIED
+}
+
+func (x *NotificationPreference) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *NotificationPreference) GetQuietStart() int32 {
+	if x != nil {
+		return x.QuietStart
+	}
+	return 0
+}
+
+func (x *NotificationPreference) GetQuietEnd() int32 {
+	if x != nil {
+		return x.QuietEnd
+	}
+	return 0
+}
+
+func (x *NotificationPreference) GetDigest() bool {
+	if x != nil {
+		return x.Digest
+	}
+	return false
+}
+
+func (x *NotificationPreference) GetDigestHour() int32 {
+	if x != nil {
+		return x.DigestHour
+	}
+	return 0
+}
+
+// Request to get the notification preferences of a user or of a team.
+type GetNotificationPreferenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkTo        string                 `protobuf:"bytes,1,opt,name=link_to,json=linkTo,proto3" json:"link_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferenceRequest) Reset() {
+	*x = GetNotificationPreferenceRequest{}
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferenceRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_notifications_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetNotificationPreferenceRequest) GetLinkTo() string {
+	if x != nil {
+		return x.LinkTo
+	}
+	return ""
+}
+
+// Response containing the notification preferences, the defaults if none are set.
+type GetNotificationPreferenceResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Preference    *NotificationPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferenceResponse) Reset() {
+	*x = GetNotificationPreferenceResponse{}
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferenceResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferenceResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_notifications_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetNotificationPreferenceResponse) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+// Request to set the notification preferences of a user or of a team.
+type SetNotificationPreferenceRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Preference    *NotificationPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationPreferenceRequest) Reset() {
+	*x = SetNotificationPreferenceRequest{}
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationPreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferenceRequest) ProtoMessage() {}
+
+func (x *SetNotificationPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_notifications_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetNotificationPreferenceRequest) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+// Response containing the notification preferences as saved.
+type SetNotificationPreferenceResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Preference    *NotificationPreference `protobuf:"bytes,1,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationPreferenceResponse) Reset() {
+	*x = SetNotificationPreferenceResponse{}
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationPreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationPreferenceResponse) ProtoMessage() {}
+
+func (x *SetNotificationPreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_notifications_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationPreferenceResponse.ProtoReflect.Descriptor instead.
+func (*SetNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_notifications_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetNotificationPreferenceResponse) GetPreference() *NotificationPreference {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+var File_ctrlplane_core_v1_notifications_proto protoreflect.FileDescriptor
+
+var file_ctrlplane_core_v1_notifications_proto_rawDesc = string([]byte{
+	0x0a, 0x25, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x02, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x6c,
+	0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2b, 0x0a, 0x0b, 0x71, 0x75, 0x69,
+	0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x1a, 0x05, 0x10, 0xa0, 0x0b, 0x28, 0x00, 0x52, 0x0a, 0x71, 0x75, 0x69, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x27, 0x0a, 0x09, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f,
+	0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05,
+	0x10, 0xa0, 0x0b, 0x28, 0x00, 0x52, 0x08, 0x71, 0x75, 0x69, 0x65, 0x74, 0x45, 0x6e, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0b, 0x64, 0x69, 0x67, 0x65, 0x73,
+	0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba, 0x48,
+	0x06, 0x1a, 0x04, 0x10, 0x18, 0x28, 0x00, 0x52, 0x0a, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x48,
+	0x6f, 0x75, 0x72, 0x22, 0x45, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f,
+	0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x22, 0x6e, 0x0a, 0x21, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6d, 0x0a, 0x20, 0x53, 0x65,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x49,
+	0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6e, 0x0a, 0x21, 0x53, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0a, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x70,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2a, 0x97, 0x01, 0x0a, 0x13, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4e, 0x4f, 0x54, 0x49, 0x46,
+	0x49, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x44, 0x4d, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x54, 0x45, 0x41,
+	0x4d, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x4d, 0x41, 0x49,
+	0x4c, 0x10, 0x03, 0x32, 0xa7, 0x02, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x74, 0x72, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34,
+	0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x33, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xcc, 0x01,
+	0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67,
+	0x6f, 0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76,
+	0x31, 0x3b, 0x63, 0x6f, 0x72, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02,
+	0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x43,
+	0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_ctrlplane_core_v1_notifications_proto_rawDescOnce sync.Once
+	file_ctrlplane_core_v1_notifications_proto_rawDescData []byte
+)
+
+func file_ctrlplane_core_v1_notifications_proto_rawDescGZIP() []byte {
+	file_ctrlplane_core_v1_notifications_proto_rawDescOnce.Do(func() {
+		file_ctrlplane_core_v1_notifications_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ctrlplane_core_v1_notifications_proto_rawDesc), len(file_ctrlplane_core_v1_notifications_proto_rawDesc)))
+	})
+	return file_ctrlplane_core_v1_notifications_proto_rawDescData
+}
+
+var file_ctrlplane_core_v1_notifications_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ctrlplane_core_v1_notifications_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ctrlplane_core_v1_notifications_proto_goTypes = []any{
+	(NotificationChannel)(0),                  // 0: ctrlplane.core.v1.NotificationChannel
+	(*NotificationPreference)(nil),            // 1: ctrlplane.core.v1.NotificationPreference
+	(*GetNotificationPreferenceRequest)(nil),  // 2: ctrlplane.core.v1.GetNotificationPreferenceRequest
+	(*GetNotificationPreferenceResponse)(nil), // 3: ctrlplane.core.v1.GetNotificationPreferenceResponse
+	(*SetNotificationPreferenceRequest)(nil),  // 4: ctrlplane.core.v1.SetNotificationPreferenceRequest
+	(*SetNotificationPreferenceResponse)(nil), // 5: ctrlplane.core.v1.SetNotificationPreferenceResponse
+}
+var file_ctrlplane_core_v1_notifications_proto_depIdxs = []int32{
+	0, // 0: ctrlplane.core.v1.NotificationPreference.channel:type_name -> ctrlplane.core.v1.NotificationChannel
+	1, // 1: ctrlplane.core.v1.GetNotificationPreferenceResponse.preference:type_name -> ctrlplane.core.v1.NotificationPreference
+	1, // 2: ctrlplane.core.v1.SetNotificationPreferenceRequest.preference:type_name -> ctrlplane.core.v1.NotificationPreference
+	1, // 3: ctrlplane.core.v1.SetNotificationPreferenceResponse.preference:type_name -> ctrlplane.core.v1.NotificationPreference
+	2, // 4: ctrlplane.core.v1.NotificationService.GetNotificationPreference:input_type -> ctrlplane.core.v1.GetNotificationPreferenceRequest
+	4, // 5: ctrlplane.core.v1.NotificationService.SetNotificationPreference:input_type -> ctrlplane.core.v1.SetNotificationPreferenceRequest
+	3, // 6: ctrlplane.core.v1.NotificationService.GetNotificationPreference:output_type -> ctrlplane.core.v1.GetNotificationPreferenceResponse
+	5, // 7: ctrlplane.core.v1.NotificationService.SetNotificationPreference:output_type -> ctrlplane.core.v1.SetNotificationPreferenceResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ctrlplane_core_v1_notifications_proto_init() }
+func file_ctrlplane_core_v1_notifications_proto_init() {
+	if File_ctrlplane_core_v1_notifications_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ctrlplane_core_v1_notifications_proto_rawDesc), len(file_ctrlplane_core_v1_notifications_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ctrlplane_core_v1_notifications_proto_goTypes,
+		DependencyIndexes: file_ctrlplane_core_v1_notifications_proto_depIdxs,
+		EnumInfos:         file_ctrlplane_core_v1_notifications_proto_enumTypes,
+		MessageInfos:      file_ctrlplane_core_v1_notifications_proto_msgTypes,
+	}.Build()
+	File_ctrlplane_core_v1_notifications_proto = out.File
+	file_ctrlplane_core_v1_notifications_proto_goTypes = nil
+	file_ctrlplane_core_v1_notifications_proto_depIdxs = nil
+}
@@ -0,0 +1,490 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: ctrlplane/core/v1/reports.proto
+
+package corev1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// How often the report is delivered.
+type ReportFrequency int32
+
+const (
+	ReportFrequency_REPORT_FREQUENCY_UNSPECIFIED ReportFrequency = 0
+	// Every day, covering the last day.
+	ReportFrequency_REPORT_FREQUENCY_DAILY ReportFrequency = 1
+	// Every week, covering the last week.
+	ReportFrequency_REPORT_FREQUENCY_WEEKLY ReportFrequency = 2
+)
+
+// Enum value maps for ReportFrequency.
+var (
+	ReportFrequency_name = map[int32]string{
+		0: "REPORT_FREQUENCY_UNSPECIFIED",
+		1: "REPORT_FREQUENCY_DAILY",
+		2: "REPORT_FREQUENCY_WEEKLY",
+	}
+	ReportFrequency_value = map[string]int32{
+		"REPORT_FREQUENCY_UNSPECIFIED": 0,
+		"REPORT_FREQUENCY_DAILY":       1,
+		"REPORT_FREQUENCY_WEEKLY":      2,
+	}
+)
+
+func (x ReportFrequency) Enum() *ReportFrequency {
+	p := new(ReportFrequency)
+	*p = x
+	return p
+}
+
+func (x ReportFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctrlplane_core_v1_reports_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportFrequency) Type() protoreflect.EnumType {
+	return &file_ctrlplane_core_v1_reports_proto_enumTypes[0]
+}
+
+func (x ReportFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportFrequency.Descriptor instead.
+func (ReportFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_reports_proto_rawDescGZIP(), []int{0}
+}
+
+// Represents the schedule of the report of a repo or of a team, delivered on the chat linked to it.
+type ReportSchedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID of the repo or of the team.
+	LinkTo    string          `protobuf:"bytes,1,opt,name=link_to,json=linkTo,proto3" json:"link_to,omitempty"`
+	Frequency ReportFrequency `protobuf:"varint,2,opt,name=frequency,proto3,enum=ctrlplane.core.v1.ReportFrequency" json:"frequency,omitempty"`
+	// IANA name of the timezone the hour and the weekday are in, e.g. Europe/Berlin.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Hour of the day the report is delivered at.
+	Hour int32 `protobuf:"varint,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	// Day of the week the weekly report is delivered on, 0 being Sunday.
+	Weekday       int32 `protobuf:"varint,5,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	Enabled       bool  `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportSchedule) Reset() {
+	*x = ReportSchedule{}
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportSchedule) ProtoMessage() {}
+
+func (x *ReportSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportSchedule.ProtoReflect.Descriptor instead.
+func (*ReportSchedule) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_reports_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReportSchedule) GetLinkTo() string {
+	if x != nil {
+		return x.LinkTo
+	}
+	return ""
+}
+
+func (x *ReportSchedule) GetFrequency() ReportFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return ReportFrequency_REPORT_FREQUENCY_UNSPECIFIED
+}
+
+func (x *ReportSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ReportSchedule) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *ReportSchedule) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *ReportSchedule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Request to get the report schedule of a repo or of a team.
+type GetReportScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LinkTo        string                 `protobuf:"bytes,1,opt,name=link_to,json=linkTo,proto3" json:"link_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportScheduleRequest) Reset() {
+	*x = GetReportScheduleRequest{}
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportScheduleRequest) ProtoMessage() {}
+
+func (x *GetReportScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetReportScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_reports_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetReportScheduleRequest) GetLinkTo() string {
+	if x != nil {
+		return x.LinkTo
+	}
+	return ""
+}
+
+// Response containing the report schedule.
+type GetReportScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ReportSchedule        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportScheduleResponse) Reset() {
+	*x = GetReportScheduleResponse{}
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportScheduleResponse) ProtoMessage() {}
+
+func (x *GetReportScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetReportScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_reports_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetReportScheduleResponse) GetSchedule() *ReportSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Request to set the report schedule of a repo or of a team.
+type SetReportScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ReportSchedule        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReportScheduleRequest) Reset() {
+	*x = SetReportScheduleRequest{}
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReportScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReportScheduleRequest) ProtoMessage() {}
+
+func (x *SetReportScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReportScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetReportScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_reports_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetReportScheduleRequest) GetSchedule() *ReportSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+// Response containing the report schedule as saved.
+type SetReportScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ReportSchedule        `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReportScheduleResponse) Reset() {
+	*x = SetReportScheduleResponse{}
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReportScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReportScheduleResponse) ProtoMessage() {}
+
+func (x *SetReportScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_reports_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReportScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetReportScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_reports_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetReportScheduleResponse) GetSchedule() *ReportSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+var File_ctrlplane_core_v1_reports_proto protoreflect.FileDescriptor
+
+var file_ctrlplane_core_v1_reports_proto_rawDesc = string([]byte{
+	0x0a, 0x1f, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x11, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xfb, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x12, 0x4c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x63, 0x74, 0x72,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x1d, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x10, 0x18, 0x28, 0x00, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72,
+	0x12, 0x23, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x09, 0xba, 0x48, 0x06, 0x1a, 0x04, 0x10, 0x07, 0x28, 0x00, 0x52, 0x07, 0x77, 0x65,
+	0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
+	0x3d, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x6c,
+	0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x22, 0x5a,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x59, 0x0a, 0x18, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x5a, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x2a, 0x6c, 0x0a, 0x0f, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59,
+	0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x4c, 0x59, 0x10, 0x02, 0x32,
+	0xef, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6e, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0xc6, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x6f, 0x2e,
+	0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74,
+	0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x63, 0x6f, 0x72, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x11, 0x43,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x43, 0x6f, 0x72,
+	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+})
+
+var (
+	file_ctrlplane_core_v1_reports_proto_rawDescOnce sync.Once
+	file_ctrlplane_core_v1_reports_proto_rawDescData []byte
+)
+
+func file_ctrlplane_core_v1_reports_proto_rawDescGZIP() []byte {
+	file_ctrlplane_core_v1_reports_proto_rawDescOnce.Do(func() {
+		file_ctrlplane_core_v1_reports_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ctrlplane_core_v1_reports_proto_rawDesc), len(file_ctrlplane_core_v1_reports_proto_rawDesc)))
+	})
+	return file_ctrlplane_core_v1_reports_proto_rawDescData
+}
+
+var file_ctrlplane_core_v1_reports_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ctrlplane_core_v1_reports_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_ctrlplane_core_v1_reports_proto_goTypes = []any{
+	(ReportFrequency)(0),              // 0: ctrlplane.core.v1.ReportFrequency
+	(*ReportSchedule)(nil),            // 1: ctrlplane.core.v1.ReportSchedule
+	(*GetReportScheduleRequest)(nil),  // 2: ctrlplane.core.v1.GetReportScheduleRequest
+	(*GetReportScheduleResponse)(nil), // 3: ctrlplane.core.v1.GetReportScheduleResponse
+	(*SetReportScheduleRequest)(nil),  // 4: ctrlplane.core.v1.SetReportScheduleRequest
+	(*SetReportScheduleResponse)(nil), // 5: ctrlplane.core.v1.SetReportScheduleResponse
+}
+var file_ctrlplane_core_v1_reports_proto_depIdxs = []int32{
+	0, // 0: ctrlplane.core.v1.ReportSchedule.frequency:type_name -> ctrlplane.core.v1.ReportFrequency
+	1, // 1: ctrlplane.core.v1.GetReportScheduleResponse.schedule:type_name -> ctrlplane.core.v1.ReportSchedule
+	1, // 2: ctrlplane.core.v1.SetReportScheduleRequest.schedule:type_name -> ctrlplane.core.v1.ReportSchedule
+	1, // 3: ctrlplane.core.v1.SetReportScheduleResponse.schedule:type_name -> ctrlplane.core.v1.ReportSchedule
+	2, // 4: ctrlplane.core.v1.ReportService.GetReportSchedule:input_type -> ctrlplane.core.v1.GetReportScheduleRequest
+	4, // 5: ctrlplane.core.v1.ReportService.SetReportSchedule:input_type -> ctrlplane.core.v1.SetReportScheduleRequest
+	3, // 6: ctrlplane.core.v1.ReportService.GetReportSchedule:output_type -> ctrlplane.core.v1.GetReportScheduleResponse
+	5, // 7: ctrlplane.core.v1.ReportService.SetReportSchedule:output_type -> ctrlplane.core.v1.SetReportScheduleResponse
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ctrlplane_core_v1_reports_proto_init() }
+func file_ctrlplane_core_v1_reports_proto_init() {
+	if File_ctrlplane_core_v1_reports_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ctrlplane_core_v1_reports_proto_rawDesc), len(file_ctrlplane_core_v1_reports_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ctrlplane_core_v1_reports_proto_goTypes,
+		DependencyIndexes: file_ctrlplane_core_v1_reports_proto_depIdxs,
+		EnumInfos:         file_ctrlplane_core_v1_reports_proto_enumTypes,
+		MessageInfos:      file_ctrlplane_core_v1_reports_proto_msgTypes,
+	}.Build()
+	File_ctrlplane_core_v1_reports_proto = out.File
+	file_ctrlplane_core_v1_reports_proto_goTypes = nil
+	file_ctrlplane_core_v1_reports_proto_depIdxs = nil
+}
@@ -26,6 +26,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How the branches that opted in are brought up to date with the default branch.
+type UpdateStrategy int32
+
+const (
+	UpdateStrategy_UPDATE_STRATEGY_UNSPECIFIED UpdateStrategy = 0
+	// The branches are never updated.
+	UpdateStrategy_UPDATE_STRATEGY_NONE UpdateStrategy = 1
+	// The branches are rebased on the default branch and force pushed.
+	UpdateStrategy_UPDATE_STRATEGY_REBASE UpdateStrategy = 2
+	// The default branch is merged into the branches.
+	UpdateStrategy_UPDATE_STRATEGY_MERGE UpdateStrategy = 3
+)
+
+// Enum value maps for UpdateStrategy.
+var (
+	UpdateStrategy_name = map[int32]string{
+		0: "UPDATE_STRATEGY_UNSPECIFIED",
+		1: "UPDATE_STRATEGY_NONE",
+		2: "UPDATE_STRATEGY_REBASE",
+		3: "UPDATE_STRATEGY_MERGE",
+	}
+	UpdateStrategy_value = map[string]int32{
+		"UPDATE_STRATEGY_UNSPECIFIED": 0,
+		"UPDATE_STRATEGY_NONE":        1,
+		"UPDATE_STRATEGY_REBASE":      2,
+		"UPDATE_STRATEGY_MERGE":       3,
+	}
+)
+
+func (x UpdateStrategy) Enum() *UpdateStrategy {
+	p := new(UpdateStrategy)
+	*p = x
+	return p
+}
+
+func (x UpdateStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ctrlplane_core_v1_repos_proto_enumTypes[0].Descriptor()
+}
+
+func (UpdateStrategy) Type() protoreflect.EnumType {
+	return &file_ctrlplane_core_v1_repos_proto_enumTypes[0]
+}
+
+func (x UpdateStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateStrategy.Descriptor instead.
+func (UpdateStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{0}
+}
+
 // Represents repo within the control plane.
 type Repo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -693,6 +749,467 @@ func (x *ListReposResponse) GetRepos() []*RepoExtended {
 	return nil
 }
 
+// Represents the drift of a branch from the default branch of the repo.
+type BranchDrift struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branch        string                 `protobuf:"bytes,1,opt,name=branch,proto3" json:"branch,omitempty"`
+	Ahead         int32                  `protobuf:"varint,2,opt,name=ahead,proto3" json:"ahead,omitempty"`
+	Behind        int32                  `protobuf:"varint,3,opt,name=behind,proto3" json:"behind,omitempty"`
+	SyncedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=synced_at,json=syncedAt,proto3" json:"synced_at,omitempty"`
+	DaysSinceSync int32                  `protobuf:"varint,5,opt,name=days_since_sync,json=daysSinceSync,proto3" json:"days_since_sync,omitempty"`
+	Churn         []string               `protobuf:"bytes,6,rep,name=churn,proto3" json:"churn,omitempty"`
+	// True if any of the drift thresholds configured on the repo is exceeded.
+	Exceeded      bool                   `protobuf:"varint,7,opt,name=exceeded,proto3" json:"exceeded,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BranchDrift) Reset() {
+	*x = BranchDrift{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BranchDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchDrift) ProtoMessage() {}
+
+func (x *BranchDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchDrift.ProtoReflect.Descriptor instead.
+func (*BranchDrift) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BranchDrift) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *BranchDrift) GetAhead() int32 {
+	if x != nil {
+		return x.Ahead
+	}
+	return 0
+}
+
+func (x *BranchDrift) GetBehind() int32 {
+	if x != nil {
+		return x.Behind
+	}
+	return 0
+}
+
+func (x *BranchDrift) GetSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return nil
+}
+
+func (x *BranchDrift) GetDaysSinceSync() int32 {
+	if x != nil {
+		return x.DaysSinceSync
+	}
+	return 0
+}
+
+func (x *BranchDrift) GetChurn() []string {
+	if x != nil {
+		return x.Churn
+	}
+	return nil
+}
+
+func (x *BranchDrift) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+func (x *BranchDrift) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request to list the drift of the branches of a repo.
+type ListBranchDriftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoId        string                 `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBranchDriftRequest) Reset() {
+	*x = ListBranchDriftRequest{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBranchDriftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBranchDriftRequest) ProtoMessage() {}
+
+func (x *ListBranchDriftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBranchDriftRequest.ProtoReflect.Descriptor instead.
+func (*ListBranchDriftRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListBranchDriftRequest) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+// Response containing the drift of the branches of a repo.
+type ListBranchDriftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Branches      []*BranchDrift         `protobuf:"bytes,1,rep,name=branches,proto3" json:"branches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBranchDriftResponse) Reset() {
+	*x = ListBranchDriftResponse{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBranchDriftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBranchDriftResponse) ProtoMessage() {}
+
+func (x *ListBranchDriftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBranchDriftResponse.ProtoReflect.Descriptor instead.
+func (*ListBranchDriftResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListBranchDriftResponse) GetBranches() []*BranchDrift {
+	if x != nil {
+		return x.Branches
+	}
+	return nil
+}
+
+// Represents the settings of a repo, taken by the workflows of the repo started after they are saved.
+type RepoSettings struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RepoId string                 `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	// Window in which the pushes to a branch are coalesced into a single analysis.
+	PushDebounce *durationpb.Duration `protobuf:"bytes,2,opt,name=push_debounce,json=pushDebounce,proto3" json:"push_debounce,omitempty"`
+	AutoUpdate   UpdateStrategy       `protobuf:"varint,3,opt,name=auto_update,json=autoUpdate,proto3,enum=ctrlplane.core.v1.UpdateStrategy" json:"auto_update,omitempty"`
+	// Commits the branches may be behind the default branch before they drift, zero disables the check.
+	DriftBehind int32 `protobuf:"varint,4,opt,name=drift_behind,json=driftBehind,proto3" json:"drift_behind,omitempty"`
+	// Time the branches may go without syncing with the default branch before they drift, zero disables the check.
+	DriftDuration *durationpb.Duration `protobuf:"bytes,5,opt,name=drift_duration,json=driftDuration,proto3" json:"drift_duration,omitempty"`
+	// Files changed on the default branch that the branches also touch before they drift, zero disables the check.
+	DriftChurn    int32 `protobuf:"varint,6,opt,name=drift_churn,json=driftChurn,proto3" json:"drift_churn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RepoSettings) Reset() {
+	*x = RepoSettings{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RepoSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoSettings) ProtoMessage() {}
+
+func (x *RepoSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoSettings.ProtoReflect.Descriptor instead.
+func (*RepoSettings) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RepoSettings) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *RepoSettings) GetPushDebounce() *durationpb.Duration {
+	if x != nil {
+		return x.PushDebounce
+	}
+	return nil
+}
+
+func (x *RepoSettings) GetAutoUpdate() UpdateStrategy {
+	if x != nil {
+		return x.AutoUpdate
+	}
+	return UpdateStrategy_UPDATE_STRATEGY_UNSPECIFIED
+}
+
+func (x *RepoSettings) GetDriftBehind() int32 {
+	if x != nil {
+		return x.DriftBehind
+	}
+	return 0
+}
+
+func (x *RepoSettings) GetDriftDuration() *durationpb.Duration {
+	if x != nil {
+		return x.DriftDuration
+	}
+	return nil
+}
+
+func (x *RepoSettings) GetDriftChurn() int32 {
+	if x != nil {
+		return x.DriftChurn
+	}
+	return 0
+}
+
+// Request to get the settings of a repo.
+type GetRepoSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RepoId        string                 `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoSettingsRequest) Reset() {
+	*x = GetRepoSettingsRequest{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoSettingsRequest) ProtoMessage() {}
+
+func (x *GetRepoSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRepoSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRepoSettingsRequest) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+// Response containing the settings of a repo.
+type GetRepoSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *RepoSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRepoSettingsResponse) Reset() {
+	*x = GetRepoSettingsResponse{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRepoSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRepoSettingsResponse) ProtoMessage() {}
+
+func (x *GetRepoSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRepoSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRepoSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetRepoSettingsResponse) GetSettings() *RepoSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Request to set the settings of a repo.
+type SetRepoSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *RepoSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoSettingsRequest) Reset() {
+	*x = SetRepoSettingsRequest{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoSettingsRequest) ProtoMessage() {}
+
+func (x *SetRepoSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetRepoSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetRepoSettingsRequest) GetSettings() *RepoSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// Response containing the settings of a repo as saved.
+type SetRepoSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *RepoSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRepoSettingsResponse) Reset() {
+	*x = SetRepoSettingsResponse{}
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRepoSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRepoSettingsResponse) ProtoMessage() {}
+
+func (x *SetRepoSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ctrlplane_core_v1_repos_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRepoSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetRepoSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_ctrlplane_core_v1_repos_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetRepoSettingsResponse) GetSettings() *RepoSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
 var File_ctrlplane_core_v1_repos_proto protoreflect.FileDescriptor
 
 var file_ctrlplane_core_v1_repos_proto_rawDesc = string([]byte{
@@ -816,45 +1333,144 @@ var file_ctrlplane_core_v1_repos_proto_rawDesc = string([]byte{
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x32, 0x84, 0x03,
-	0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x24, 0x2e, 0x63, 0x74,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x52, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x22, 0xa1, 0x02,
+	0x0a, 0x0b, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x68, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x65, 0x68, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x65, 0x68,
+	0x69, 0x6e, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x64, 0x61, 0x79, 0x73, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x64, 0x61, 0x79, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x72, 0x6e, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x75, 0x72, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x78,
+	0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x3b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x44,
+	0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x72,
+	0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x22, 0x55,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x44, 0x72, 0x69, 0x66,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x74,
 	0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52,
-	0x65, 0x70, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x08, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x65, 0x73, 0x22, 0xed, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x0d, 0x70, 0x75, 0x73,
+	0x68, 0x5f, 0x64, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05,
+	0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x0c, 0x70, 0x75, 0x73, 0x68, 0x44, 0x65, 0x62, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x62, 0x65, 0x68,
+	0x69, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x0b, 0x64, 0x72, 0x69, 0x66, 0x74, 0x42, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x12,
+	0x4a, 0x0a, 0x0e, 0x64, 0x72, 0x69, 0x66, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0d, 0x64, 0x72,
+	0x69, 0x66, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0b, 0x64,
+	0x72, 0x69, 0x66, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x64, 0x72, 0x69, 0x66, 0x74,
+	0x43, 0x68, 0x75, 0x72, 0x6e, 0x22, 0x3b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x49, 0x64, 0x22, 0x56, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x55, 0x0a, 0x16, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x22, 0x56, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
 	0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x79, 0x4f, 0x72, 0x67, 0x49, 0x44, 0x12, 0x2c, 0x2e, 0x63,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2a, 0x82, 0x01, 0x0a, 0x0e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x1f, 0x0a, 0x1b,
+	0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x52, 0x45, 0x42, 0x41, 0x53,
+	0x45, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x03, 0x32, 0xc2,
+	0x05, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x24, 0x2e, 0x63,
 	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x79, 0x4f, 0x72,
-	0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x74, 0x72,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x79, 0x4f, 0x72, 0x67, 0x49,
-	0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24,
-	0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0xc4, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x74, 0x72,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a,
-	0x52, 0x65, 0x70, 0x6f, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x6f,
-	0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x63, 0x6f, 0x72, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x11,
-	0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x43, 0x6f,
-	0x72, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x65, 0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x65, 0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x12, 0x25, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x79, 0x4f, 0x72, 0x67, 0x49, 0x44, 0x12, 0x2c, 0x2e,
+	0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x79, 0x4f,
+	0x72, 0x67, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x63, 0x74,
+	0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x4f, 0x72, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x42, 0x79, 0x4f, 0x72, 0x67,
+	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x24, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x29, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x68, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x29, 0x2e, 0x63,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x74, 0x72, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0xc4, 0x01, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x74, 0x72, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x52,
+	0x65, 0x70, 0x6f, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x6f, 0x2e,
+	0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x74,
+	0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b,
+	0x63, 0x6f, 0x72, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x11, 0x43,
+	0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x2e, 0x43, 0x6f, 0x72, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x11, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65, 0x5c, 0x43, 0x6f, 0x72,
+	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1d, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x5c, 0x43, 0x6f, 0x72, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x43, 0x74, 0x72, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x65,
+	0x3a, 0x3a, 0x43, 0x6f, 0x72, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 })
 
 var (
@@ -869,50 +1485,75 @@ func file_ctrlplane_core_v1_repos_proto_rawDescGZIP() []byte {
 	return file_ctrlplane_core_v1_repos_proto_rawDescData
 }
 
-var file_ctrlplane_core_v1_repos_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_ctrlplane_core_v1_repos_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_ctrlplane_core_v1_repos_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_ctrlplane_core_v1_repos_proto_goTypes = []any{
-	(*Repo)(nil),                       // 0: ctrlplane.core.v1.Repo
-	(*CreateRepoRequest)(nil),          // 1: ctrlplane.core.v1.CreateRepoRequest
-	(*CreateRepoResponse)(nil),         // 2: ctrlplane.core.v1.CreateRepoResponse
-	(*GetRepoByIDRequest)(nil),         // 3: ctrlplane.core.v1.GetRepoByIDRequest
-	(*GetRepoByIDResponse)(nil),        // 4: ctrlplane.core.v1.GetRepoByIDResponse
-	(*GetOrgReposByOrgIDRequest)(nil),  // 5: ctrlplane.core.v1.GetOrgReposByOrgIDRequest
-	(*GetOrgReposByOrgIDResponse)(nil), // 6: ctrlplane.core.v1.GetOrgReposByOrgIDResponse
-	(*RepoExtended)(nil),               // 7: ctrlplane.core.v1.RepoExtended
-	(*ListReposResponse)(nil),          // 8: ctrlplane.core.v1.ListReposResponse
-	(*timestamppb.Timestamp)(nil),      // 9: google.protobuf.Timestamp
-	(v1.RepoHook)(0),                   // 10: ctrlplane.events.v1.RepoHook
-	(*durationpb.Duration)(nil),        // 11: google.protobuf.Duration
-	(*emptypb.Empty)(nil),              // 12: google.protobuf.Empty
+	(UpdateStrategy)(0),                // 0: ctrlplane.core.v1.UpdateStrategy
+	(*Repo)(nil),                       // 1: ctrlplane.core.v1.Repo
+	(*CreateRepoRequest)(nil),          // 2: ctrlplane.core.v1.CreateRepoRequest
+	(*CreateRepoResponse)(nil),         // 3: ctrlplane.core.v1.CreateRepoResponse
+	(*GetRepoByIDRequest)(nil),         // 4: ctrlplane.core.v1.GetRepoByIDRequest
+	(*GetRepoByIDResponse)(nil),        // 5: ctrlplane.core.v1.GetRepoByIDResponse
+	(*GetOrgReposByOrgIDRequest)(nil),  // 6: ctrlplane.core.v1.GetOrgReposByOrgIDRequest
+	(*GetOrgReposByOrgIDResponse)(nil), // 7: ctrlplane.core.v1.GetOrgReposByOrgIDResponse
+	(*RepoExtended)(nil),               // 8: ctrlplane.core.v1.RepoExtended
+	(*ListReposResponse)(nil),          // 9: ctrlplane.core.v1.ListReposResponse
+	(*BranchDrift)(nil),                // 10: ctrlplane.core.v1.BranchDrift
+	(*ListBranchDriftRequest)(nil),     // 11: ctrlplane.core.v1.ListBranchDriftRequest
+	(*ListBranchDriftResponse)(nil),    // 12: ctrlplane.core.v1.ListBranchDriftResponse
+	(*RepoSettings)(nil),               // 13: ctrlplane.core.v1.RepoSettings
+	(*GetRepoSettingsRequest)(nil),     // 14: ctrlplane.core.v1.GetRepoSettingsRequest
+	(*GetRepoSettingsResponse)(nil),    // 15: ctrlplane.core.v1.GetRepoSettingsResponse
+	(*SetRepoSettingsRequest)(nil),     // 16: ctrlplane.core.v1.SetRepoSettingsRequest
+	(*SetRepoSettingsResponse)(nil),    // 17: ctrlplane.core.v1.SetRepoSettingsResponse
+	(*timestamppb.Timestamp)(nil),      // 18: google.protobuf.Timestamp
+	(v1.RepoHook)(0),                   // 19: ctrlplane.events.v1.RepoHook
+	(*durationpb.Duration)(nil),        // 20: google.protobuf.Duration
+	(*emptypb.Empty)(nil),              // 21: google.protobuf.Empty
 }
 var file_ctrlplane_core_v1_repos_proto_depIdxs = []int32{
-	9,  // 0: ctrlplane.core.v1.Repo.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 1: ctrlplane.core.v1.Repo.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 2: ctrlplane.core.v1.Repo.hook:type_name -> ctrlplane.events.v1.RepoHook
-	11, // 3: ctrlplane.core.v1.Repo.stale_duration:type_name -> google.protobuf.Duration
-	10, // 4: ctrlplane.core.v1.CreateRepoRequest.hook:type_name -> ctrlplane.events.v1.RepoHook
-	11, // 5: ctrlplane.core.v1.CreateRepoRequest.stale_duration:type_name -> google.protobuf.Duration
-	0,  // 6: ctrlplane.core.v1.CreateRepoResponse.repo:type_name -> ctrlplane.core.v1.Repo
-	0,  // 7: ctrlplane.core.v1.GetRepoByIDResponse.repo:type_name -> ctrlplane.core.v1.Repo
-	0,  // 8: ctrlplane.core.v1.GetOrgReposByOrgIDResponse.repo:type_name -> ctrlplane.core.v1.Repo
-	9,  // 9: ctrlplane.core.v1.RepoExtended.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 10: ctrlplane.core.v1.RepoExtended.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 11: ctrlplane.core.v1.RepoExtended.hook:type_name -> ctrlplane.events.v1.RepoHook
-	11, // 12: ctrlplane.core.v1.RepoExtended.stale_duration:type_name -> google.protobuf.Duration
-	7,  // 13: ctrlplane.core.v1.ListReposResponse.repos:type_name -> ctrlplane.core.v1.RepoExtended
-	1,  // 14: ctrlplane.core.v1.RepoService.CreateRepo:input_type -> ctrlplane.core.v1.CreateRepoRequest
-	3,  // 15: ctrlplane.core.v1.RepoService.GetRepoByID:input_type -> ctrlplane.core.v1.GetRepoByIDRequest
-	5,  // 16: ctrlplane.core.v1.RepoService.GetOrgReposByOrgID:input_type -> ctrlplane.core.v1.GetOrgReposByOrgIDRequest
-	12, // 17: ctrlplane.core.v1.RepoService.ListRepos:input_type -> google.protobuf.Empty
-	2,  // 18: ctrlplane.core.v1.RepoService.CreateRepo:output_type -> ctrlplane.core.v1.CreateRepoResponse
-	4,  // 19: ctrlplane.core.v1.RepoService.GetRepoByID:output_type -> ctrlplane.core.v1.GetRepoByIDResponse
-	6,  // 20: ctrlplane.core.v1.RepoService.GetOrgReposByOrgID:output_type -> ctrlplane.core.v1.GetOrgReposByOrgIDResponse
-	8,  // 21: ctrlplane.core.v1.RepoService.ListRepos:output_type -> ctrlplane.core.v1.ListReposResponse
-	18, // [18:22] is the sub-list for method output_type
-	14, // [14:18] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	18, // 0: ctrlplane.core.v1.Repo.created_at:type_name -> google.protobuf.Timestamp
+	18, // 1: ctrlplane.core.v1.Repo.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 2: ctrlplane.core.v1.Repo.hook:type_name -> ctrlplane.events.v1.RepoHook
+	20, // 3: ctrlplane.core.v1.Repo.stale_duration:type_name -> google.protobuf.Duration
+	19, // 4: ctrlplane.core.v1.CreateRepoRequest.hook:type_name -> ctrlplane.events.v1.RepoHook
+	20, // 5: ctrlplane.core.v1.CreateRepoRequest.stale_duration:type_name -> google.protobuf.Duration
+	1,  // 6: ctrlplane.core.v1.CreateRepoResponse.repo:type_name -> ctrlplane.core.v1.Repo
+	1,  // 7: ctrlplane.core.v1.GetRepoByIDResponse.repo:type_name -> ctrlplane.core.v1.Repo
+	1,  // 8: ctrlplane.core.v1.GetOrgReposByOrgIDResponse.repo:type_name -> ctrlplane.core.v1.Repo
+	18, // 9: ctrlplane.core.v1.RepoExtended.created_at:type_name -> google.protobuf.Timestamp
+	18, // 10: ctrlplane.core.v1.RepoExtended.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 11: ctrlplane.core.v1.RepoExtended.hook:type_name -> ctrlplane.events.v1.RepoHook
+	20, // 12: ctrlplane.core.v1.RepoExtended.stale_duration:type_name -> google.protobuf.Duration
+	8,  // 13: ctrlplane.core.v1.ListReposResponse.repos:type_name -> ctrlplane.core.v1.RepoExtended
+	18, // 14: ctrlplane.core.v1.BranchDrift.synced_at:type_name -> google.protobuf.Timestamp
+	18, // 15: ctrlplane.core.v1.BranchDrift.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 16: ctrlplane.core.v1.ListBranchDriftResponse.branches:type_name -> ctrlplane.core.v1.BranchDrift
+	20, // 17: ctrlplane.core.v1.RepoSettings.push_debounce:type_name -> google.protobuf.Duration
+	0,  // 18: ctrlplane.core.v1.RepoSettings.auto_update:type_name -> ctrlplane.core.v1.UpdateStrategy
+	20, // 19: ctrlplane.core.v1.RepoSettings.drift_duration:type_name -> google.protobuf.Duration
+	13, // 20: ctrlplane.core.v1.GetRepoSettingsResponse.settings:type_name -> ctrlplane.core.v1.RepoSettings
+	13, // 21: ctrlplane.core.v1.SetRepoSettingsRequest.settings:type_name -> ctrlplane.core.v1.RepoSettings
+	13, // 22: ctrlplane.core.v1.SetRepoSettingsResponse.settings:type_name -> ctrlplane.core.v1.RepoSettings
+	2,  // 23: ctrlplane.core.v1.RepoService.CreateRepo:input_type -> ctrlplane.core.v1.CreateRepoRequest
+	4,  // 24: ctrlplane.core.v1.RepoService.GetRepoByID:input_type -> ctrlplane.core.v1.GetRepoByIDRequest
+	6,  // 25: ctrlplane.core.v1.RepoService.GetOrgReposByOrgID:input_type -> ctrlplane.core.v1.GetOrgReposByOrgIDRequest
+	21, // 26: ctrlplane.core.v1.RepoService.ListRepos:input_type -> google.protobuf.Empty
+	11, // 27: ctrlplane.core.v1.RepoService.ListBranchDrift:input_type -> ctrlplane.core.v1.ListBranchDriftRequest
+	14, // 28: ctrlplane.core.v1.RepoService.GetRepoSettings:input_type -> ctrlplane.core.v1.GetRepoSettingsRequest
+	16, // 29: ctrlplane.core.v1.RepoService.SetRepoSettings:input_type -> ctrlplane.core.v1.SetRepoSettingsRequest
+	3,  // 30: ctrlplane.core.v1.RepoService.CreateRepo:output_type -> ctrlplane.core.v1.CreateRepoResponse
+	5,  // 31: ctrlplane.core.v1.RepoService.GetRepoByID:output_type -> ctrlplane.core.v1.GetRepoByIDResponse
+	7,  // 32: ctrlplane.core.v1.RepoService.GetOrgReposByOrgID:output_type -> ctrlplane.core.v1.GetOrgReposByOrgIDResponse
+	9,  // 33: ctrlplane.core.v1.RepoService.ListRepos:output_type -> ctrlplane.core.v1.ListReposResponse
+	12, // 34: ctrlplane.core.v1.RepoService.ListBranchDrift:output_type -> ctrlplane.core.v1.ListBranchDriftResponse
+	15, // 35: ctrlplane.core.v1.RepoService.GetRepoSettings:output_type -> ctrlplane.core.v1.GetRepoSettingsResponse
+	17, // 36: ctrlplane.core.v1.RepoService.SetRepoSettings:output_type -> ctrlplane.core.v1.SetRepoSettingsResponse
+	30, // [30:37] is the sub-list for method output_type
+	23, // [23:30] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_ctrlplane_core_v1_repos_proto_init() }
@@ -925,13 +1566,14 @@ func file_ctrlplane_core_v1_repos_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ctrlplane_core_v1_repos_proto_rawDesc), len(file_ctrlplane_core_v1_repos_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      1,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ctrlplane_core_v1_repos_proto_goTypes,
 		DependencyIndexes: file_ctrlplane_core_v1_repos_proto_depIdxs,
+		EnumInfos:         file_ctrlplane_core_v1_repos_proto_enumTypes,
 		MessageInfos:      file_ctrlplane_core_v1_repos_proto_msgTypes,
 	}.Build()
 	File_ctrlplane_core_v1_repos_proto = out.File
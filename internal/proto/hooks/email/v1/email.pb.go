@@ -0,0 +1,165 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: hooks/email/v1/email.proto
+
+package emailv1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request to link email addresses to a repo or to a user.
+type LinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the repo or of the user to link.
+	LinkTo string `protobuf:"bytes,1,opt,name=link_to,json=linkTo,proto3" json:"link_to,omitempty"`
+	// Addresses the notifications are sent to.
+	Addresses     []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkRequest) Reset() {
+	*x = LinkRequest{}
+	mi := &file_hooks_email_v1_email_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRequest) ProtoMessage() {}
+
+func (x *LinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_email_v1_email_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRequest.ProtoReflect.Descriptor instead.
+func (*LinkRequest) Descriptor() ([]byte, []int) {
+	return file_hooks_email_v1_email_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LinkRequest) GetLinkTo() string {
+	if x != nil {
+		return x.LinkTo
+	}
+	return ""
+}
+
+func (x *LinkRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+var File_hooks_email_v1_email_proto protoreflect.FileDescriptor
+
+var file_hooks_email_v1_email_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x2e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75,
+	0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5e, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x12, 0x2c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xba, 0x48, 0x0b,
+	0x92, 0x01, 0x08, 0x08, 0x01, 0x22, 0x04, 0x72, 0x02, 0x60, 0x01, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x32, 0x4b, 0x0a, 0x0c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b,
+	0x2e, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x42, 0xb3, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x2e, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65,
+	0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x48, 0x45, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x2e,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x73,
+	0x5c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x48, 0x6f, 0x6f, 0x6b,
+	0x73, 0x5c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x3a,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+})
+
+var (
+	file_hooks_email_v1_email_proto_rawDescOnce sync.Once
+	file_hooks_email_v1_email_proto_rawDescData []byte
+)
+
+func file_hooks_email_v1_email_proto_rawDescGZIP() []byte {
+	file_hooks_email_v1_email_proto_rawDescOnce.Do(func() {
+		file_hooks_email_v1_email_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hooks_email_v1_email_proto_rawDesc), len(file_hooks_email_v1_email_proto_rawDesc)))
+	})
+	return file_hooks_email_v1_email_proto_rawDescData
+}
+
+var file_hooks_email_v1_email_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hooks_email_v1_email_proto_goTypes = []any{
+	(*LinkRequest)(nil),   // 0: hooks.email.v1.LinkRequest
+	(*emptypb.Empty)(nil), // 1: google.protobuf.Empty
+}
+var file_hooks_email_v1_email_proto_depIdxs = []int32{
+	0, // 0: hooks.email.v1.EmailService.Link:input_type -> hooks.email.v1.LinkRequest
+	1, // 1: hooks.email.v1.EmailService.Link:output_type -> google.protobuf.Empty
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hooks_email_v1_email_proto_init() }
+func file_hooks_email_v1_email_proto_init() {
+	if File_hooks_email_v1_email_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hooks_email_v1_email_proto_rawDesc), len(file_hooks_email_v1_email_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hooks_email_v1_email_proto_goTypes,
+		DependencyIndexes: file_hooks_email_v1_email_proto_depIdxs,
+		MessageInfos:      file_hooks_email_v1_email_proto_msgTypes,
+	}.Build()
+	File_hooks_email_v1_email_proto = out.File
+	file_hooks_email_v1_email_proto_goTypes = nil
+	file_hooks_email_v1_email_proto_depIdxs = nil
+}
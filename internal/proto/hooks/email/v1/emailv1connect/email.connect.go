@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: hooks/email/v1/email.proto
+
+package emailv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/hooks/email/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// EmailServiceName is the fully-qualified name of the EmailService service.
+	EmailServiceName = "hooks.email.v1.EmailService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// EmailServiceLinkProcedure is the fully-qualified name of the EmailService's Link RPC.
+	EmailServiceLinkProcedure = "/hooks.email.v1.EmailService/Link"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	emailServiceServiceDescriptor    = v1.File_hooks_email_v1_email_proto.Services().ByName("EmailService")
+	emailServiceLinkMethodDescriptor = emailServiceServiceDescriptor.Methods().ByName("Link")
+)
+
+// EmailServiceClient is a client for the hooks.email.v1.EmailService service.
+type EmailServiceClient interface {
+	// Link email addresses, so that the notifications for the repo or the user are sent to them.
+	Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewEmailServiceClient constructs a client for the hooks.email.v1.EmailService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewEmailServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) EmailServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &emailServiceClient{
+		link: connect.NewClient[v1.LinkRequest, emptypb.Empty](
+			httpClient,
+			baseURL+EmailServiceLinkProcedure,
+			connect.WithSchema(emailServiceLinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// emailServiceClient implements EmailServiceClient.
+type emailServiceClient struct {
+	link *connect.Client[v1.LinkRequest, emptypb.Empty]
+}
+
+// Link calls hooks.email.v1.EmailService.Link.
+func (c *emailServiceClient) Link(ctx context.Context, req *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.link.CallUnary(ctx, req)
+}
+
+// EmailServiceHandler is an implementation of the hooks.email.v1.EmailService service.
+type EmailServiceHandler interface {
+	// Link email addresses, so that the notifications for the repo or the user are sent to them.
+	Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewEmailServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewEmailServiceHandler(svc EmailServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	emailServiceLinkHandler := connect.NewUnaryHandler(
+		EmailServiceLinkProcedure,
+		svc.Link,
+		connect.WithSchema(emailServiceLinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/hooks.email.v1.EmailService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case EmailServiceLinkProcedure:
+			emailServiceLinkHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedEmailServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedEmailServiceHandler struct{}
+
+func (UnimplementedEmailServiceHandler) Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.email.v1.EmailService.Link is not implemented"))
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: hooks/gitea/v1/gitea.proto
+
+package giteav1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request to install quantm on a Gitea or Forgejo organization of the configured instance.
+type InstallRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Name of the organization on the instance. The configured token must belong to an owner of the organization.
+	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallRequest) Reset() {
+	*x = InstallRequest{}
+	mi := &file_hooks_gitea_v1_gitea_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallRequest) ProtoMessage() {}
+
+func (x *InstallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_gitea_v1_gitea_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallRequest.ProtoReflect.Descriptor instead.
+func (*InstallRequest) Descriptor() ([]byte, []int) {
+	return file_hooks_gitea_v1_gitea_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstallRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InstallRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+var File_hooks_gitea_v1_gitea_proto protoreflect.FileDescriptor
+
+var file_hooks_gitea_v1_gitea_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x65, 0x61, 0x2f, 0x76, 0x31,
+	0x2f, 0x67, 0x69, 0x74, 0x65, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x2e, 0x67, 0x69, 0x74, 0x65, 0x61, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75,
+	0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x0e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x6f, 0x72, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x32, 0x51, 0x0a, 0x0c, 0x47, 0x69, 0x74, 0x65,
+	0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x67, 0x69, 0x74, 0x65,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0xb3, 0x01, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x67, 0x69, 0x74, 0x65, 0x61, 0x2e,
+	0x76, 0x31, 0x42, 0x0a, 0x47, 0x69, 0x74, 0x65, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x37, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x65, 0x61, 0x2f, 0x76,
+	0x31, 0x3b, 0x67, 0x69, 0x74, 0x65, 0x61, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x48, 0x47, 0x58, 0xaa,
+	0x02, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x47, 0x69, 0x74, 0x65, 0x61, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x5c, 0x47, 0x69, 0x74, 0x65, 0x61, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1a, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x5c, 0x47, 0x69, 0x74, 0x65, 0x61, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x10, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x3a, 0x47, 0x69, 0x74, 0x65, 0x61, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_hooks_gitea_v1_gitea_proto_rawDescOnce sync.Once
+	file_hooks_gitea_v1_gitea_proto_rawDescData []byte
+)
+
+func file_hooks_gitea_v1_gitea_proto_rawDescGZIP() []byte {
+	file_hooks_gitea_v1_gitea_proto_rawDescOnce.Do(func() {
+		file_hooks_gitea_v1_gitea_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hooks_gitea_v1_gitea_proto_rawDesc), len(file_hooks_gitea_v1_gitea_proto_rawDesc)))
+	})
+	return file_hooks_gitea_v1_gitea_proto_rawDescData
+}
+
+var file_hooks_gitea_v1_gitea_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hooks_gitea_v1_gitea_proto_goTypes = []any{
+	(*InstallRequest)(nil), // 0: hooks.gitea.v1.InstallRequest
+	(*emptypb.Empty)(nil),  // 1: google.protobuf.Empty
+}
+var file_hooks_gitea_v1_gitea_proto_depIdxs = []int32{
+	0, // 0: hooks.gitea.v1.GiteaService.Install:input_type -> hooks.gitea.v1.InstallRequest
+	1, // 1: hooks.gitea.v1.GiteaService.Install:output_type -> google.protobuf.Empty
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hooks_gitea_v1_gitea_proto_init() }
+func file_hooks_gitea_v1_gitea_proto_init() {
+	if File_hooks_gitea_v1_gitea_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hooks_gitea_v1_gitea_proto_rawDesc), len(file_hooks_gitea_v1_gitea_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hooks_gitea_v1_gitea_proto_goTypes,
+		DependencyIndexes: file_hooks_gitea_v1_gitea_proto_depIdxs,
+		MessageInfos:      file_hooks_gitea_v1_gitea_proto_msgTypes,
+	}.Build()
+	File_hooks_gitea_v1_gitea_proto = out.File
+	file_hooks_gitea_v1_gitea_proto_goTypes = nil
+	file_hooks_gitea_v1_gitea_proto_depIdxs = nil
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: hooks/gitea/v1/gitea.proto
+
+package giteav1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/hooks/gitea/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// GiteaServiceName is the fully-qualified name of the GiteaService service.
+	GiteaServiceName = "hooks.gitea.v1.GiteaService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// GiteaServiceInstallProcedure is the fully-qualified name of the GiteaService's Install RPC.
+	GiteaServiceInstallProcedure = "/hooks.gitea.v1.GiteaService/Install"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	giteaServiceServiceDescriptor       = v1.File_hooks_gitea_v1_gitea_proto.Services().ByName("GiteaService")
+	giteaServiceInstallMethodDescriptor = giteaServiceServiceDescriptor.Methods().ByName("Install")
+)
+
+// GiteaServiceClient is a client for the hooks.gitea.v1.GiteaService service.
+type GiteaServiceClient interface {
+	// Install quantm on all the repositories of the organization. Repositories created later are synced via webhook.
+	Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewGiteaServiceClient constructs a client for the hooks.gitea.v1.GiteaService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGiteaServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GiteaServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &giteaServiceClient{
+		install: connect.NewClient[v1.InstallRequest, emptypb.Empty](
+			httpClient,
+			baseURL+GiteaServiceInstallProcedure,
+			connect.WithSchema(giteaServiceInstallMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// giteaServiceClient implements GiteaServiceClient.
+type giteaServiceClient struct {
+	install *connect.Client[v1.InstallRequest, emptypb.Empty]
+}
+
+// Install calls hooks.gitea.v1.GiteaService.Install.
+func (c *giteaServiceClient) Install(ctx context.Context, req *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.install.CallUnary(ctx, req)
+}
+
+// GiteaServiceHandler is an implementation of the hooks.gitea.v1.GiteaService service.
+type GiteaServiceHandler interface {
+	// Install quantm on all the repositories of the organization. Repositories created later are synced via webhook.
+	Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewGiteaServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGiteaServiceHandler(svc GiteaServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	giteaServiceInstallHandler := connect.NewUnaryHandler(
+		GiteaServiceInstallProcedure,
+		svc.Install,
+		connect.WithSchema(giteaServiceInstallMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/hooks.gitea.v1.GiteaService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GiteaServiceInstallProcedure:
+			giteaServiceInstallHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGiteaServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGiteaServiceHandler struct{}
+
+func (UnimplementedGiteaServiceHandler) Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.gitea.v1.GiteaService.Install is not implemented"))
+}
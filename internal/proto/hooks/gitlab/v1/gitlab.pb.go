@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: hooks/gitlab/v1/gitlab.proto
+
+package gitlabv1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Request to install quantm on a GitLab group using a group access token.
+type InstallRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	OrgId string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	// Base URL of the GitLab instance, e.g. https://gitlab.example.com. Defaults to the configured instance.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Full path or numeric id of the group.
+	Group string `protobuf:"bytes,3,opt,name=group,proto3" json:"group,omitempty"`
+	// Group access token with the api scope and at least the maintainer role.
+	AccessToken   string `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallRequest) Reset() {
+	*x = InstallRequest{}
+	mi := &file_hooks_gitlab_v1_gitlab_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallRequest) ProtoMessage() {}
+
+func (x *InstallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_gitlab_v1_gitlab_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallRequest.ProtoReflect.Descriptor instead.
+func (*InstallRequest) Descriptor() ([]byte, []int) {
+	return file_hooks_gitlab_v1_gitlab_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstallRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+func (x *InstallRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *InstallRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *InstallRequest) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+var File_hooks_gitlab_v1_gitlab_proto protoreflect.FileDescriptor
+
+var file_hooks_gitlab_v1_gitlab_proto_rawDesc = string([]byte{
+	0x0a, 0x1c, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x76, 0x31, 0x1a,
+	0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x0e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06,
+	0x6f, 0x72, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x05, 0x6f, 0x72, 0x67, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
+	0x1d, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x32, 0x53, 0x0a, 0x0d, 0x47, 0x69,
+	0x74, 0x6c, 0x61, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0xbb, 0x01, 0x0a, 0x13, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x67, 0x69,
+	0x74, 0x6c, 0x61, 0x62, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65, 0x75, 0x2e,
+	0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x67,
+	0x69, 0x74, 0x6c, 0x61, 0x62, 0x2f, 0x76, 0x31, 0x3b, 0x67, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x48, 0x47, 0x58, 0xaa, 0x02, 0x0f, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x2e,
+	0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0f, 0x48, 0x6f, 0x6f, 0x6b,
+	0x73, 0x5c, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1b, 0x48, 0x6f,
+	0x6f, 0x6b, 0x73, 0x5c, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x11, 0x48, 0x6f, 0x6f, 0x6b,
+	0x73, 0x3a, 0x3a, 0x47, 0x69, 0x74, 0x6c, 0x61, 0x62, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_hooks_gitlab_v1_gitlab_proto_rawDescOnce sync.Once
+	file_hooks_gitlab_v1_gitlab_proto_rawDescData []byte
+)
+
+func file_hooks_gitlab_v1_gitlab_proto_rawDescGZIP() []byte {
+	file_hooks_gitlab_v1_gitlab_proto_rawDescOnce.Do(func() {
+		file_hooks_gitlab_v1_gitlab_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hooks_gitlab_v1_gitlab_proto_rawDesc), len(file_hooks_gitlab_v1_gitlab_proto_rawDesc)))
+	})
+	return file_hooks_gitlab_v1_gitlab_proto_rawDescData
+}
+
+var file_hooks_gitlab_v1_gitlab_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_hooks_gitlab_v1_gitlab_proto_goTypes = []any{
+	(*InstallRequest)(nil), // 0: hooks.gitlab.v1.InstallRequest
+	(*emptypb.Empty)(nil),  // 1: google.protobuf.Empty
+}
+var file_hooks_gitlab_v1_gitlab_proto_depIdxs = []int32{
+	0, // 0: hooks.gitlab.v1.GitlabService.Install:input_type -> hooks.gitlab.v1.InstallRequest
+	1, // 1: hooks.gitlab.v1.GitlabService.Install:output_type -> google.protobuf.Empty
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hooks_gitlab_v1_gitlab_proto_init() }
+func file_hooks_gitlab_v1_gitlab_proto_init() {
+	if File_hooks_gitlab_v1_gitlab_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hooks_gitlab_v1_gitlab_proto_rawDesc), len(file_hooks_gitlab_v1_gitlab_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hooks_gitlab_v1_gitlab_proto_goTypes,
+		DependencyIndexes: file_hooks_gitlab_v1_gitlab_proto_depIdxs,
+		MessageInfos:      file_hooks_gitlab_v1_gitlab_proto_msgTypes,
+	}.Build()
+	File_hooks_gitlab_v1_gitlab_proto = out.File
+	file_hooks_gitlab_v1_gitlab_proto_goTypes = nil
+	file_hooks_gitlab_v1_gitlab_proto_depIdxs = nil
+}
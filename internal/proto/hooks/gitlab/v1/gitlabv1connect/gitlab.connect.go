@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: hooks/gitlab/v1/gitlab.proto
+
+package gitlabv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/hooks/gitlab/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// GitlabServiceName is the fully-qualified name of the GitlabService service.
+	GitlabServiceName = "hooks.gitlab.v1.GitlabService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// GitlabServiceInstallProcedure is the fully-qualified name of the GitlabService's Install RPC.
+	GitlabServiceInstallProcedure = "/hooks.gitlab.v1.GitlabService/Install"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	gitlabServiceServiceDescriptor       = v1.File_hooks_gitlab_v1_gitlab_proto.Services().ByName("GitlabService")
+	gitlabServiceInstallMethodDescriptor = gitlabServiceServiceDescriptor.Methods().ByName("Install")
+)
+
+// GitlabServiceClient is a client for the hooks.gitlab.v1.GitlabService service.
+type GitlabServiceClient interface {
+	// Install quantm on all the projects of the group, including the projects of its subgroups.
+	Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewGitlabServiceClient constructs a client for the hooks.gitlab.v1.GitlabService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGitlabServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GitlabServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &gitlabServiceClient{
+		install: connect.NewClient[v1.InstallRequest, emptypb.Empty](
+			httpClient,
+			baseURL+GitlabServiceInstallProcedure,
+			connect.WithSchema(gitlabServiceInstallMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// gitlabServiceClient implements GitlabServiceClient.
+type gitlabServiceClient struct {
+	install *connect.Client[v1.InstallRequest, emptypb.Empty]
+}
+
+// Install calls hooks.gitlab.v1.GitlabService.Install.
+func (c *gitlabServiceClient) Install(ctx context.Context, req *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.install.CallUnary(ctx, req)
+}
+
+// GitlabServiceHandler is an implementation of the hooks.gitlab.v1.GitlabService service.
+type GitlabServiceHandler interface {
+	// Install quantm on all the projects of the group, including the projects of its subgroups.
+	Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewGitlabServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGitlabServiceHandler(svc GitlabServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	gitlabServiceInstallHandler := connect.NewUnaryHandler(
+		GitlabServiceInstallProcedure,
+		svc.Install,
+		connect.WithSchema(gitlabServiceInstallMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/hooks.gitlab.v1.GitlabService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GitlabServiceInstallProcedure:
+			gitlabServiceInstallHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGitlabServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGitlabServiceHandler struct{}
+
+func (UnimplementedGitlabServiceHandler) Install(context.Context, *connect.Request[v1.InstallRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.gitlab.v1.GitlabService.Install is not implemented"))
+}
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.4
+// 	protoc        (unknown)
+// source: hooks/teams/v1/teams.proto
+
+package teamsv1
+
+import (
+	_ "go.breu.io/quantm/internal/proto/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Incoming webhook configured on a Teams channel. Messages can only be posted to the channel it belongs to.
+type Webhook struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Webhook) Reset() {
+	*x = Webhook{}
+	mi := &file_hooks_teams_v1_teams_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Webhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Webhook) ProtoMessage() {}
+
+func (x *Webhook) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_teams_v1_teams_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Webhook.ProtoReflect.Descriptor instead.
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return file_hooks_teams_v1_teams_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Webhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// Bot registered with the Azure Bot Service, posting to a channel or to a personal chat with a user.
+type Bot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service URL of the conversation, as received by the bot, e.g. https://smba.trafficmanager.net/emea/.
+	ServiceUrl string `protobuf:"bytes,1,opt,name=service_url,json=serviceUrl,proto3" json:"service_url,omitempty"`
+	// Id of the channel or of the personal chat to post to.
+	ConversationId string `protobuf:"bytes,2,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`
+	// Id of the Microsoft Entra tenant the bot is registered in.
+	TenantId string `protobuf:"bytes,3,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	// Microsoft app id and password of the bot.
+	AppId         string `protobuf:"bytes,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppPassword   string `protobuf:"bytes,5,opt,name=app_password,json=appPassword,proto3" json:"app_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bot) Reset() {
+	*x = Bot{}
+	mi := &file_hooks_teams_v1_teams_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bot) ProtoMessage() {}
+
+func (x *Bot) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_teams_v1_teams_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bot.ProtoReflect.Descriptor instead.
+func (*Bot) Descriptor() ([]byte, []int) {
+	return file_hooks_teams_v1_teams_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Bot) GetServiceUrl() string {
+	if x != nil {
+		return x.ServiceUrl
+	}
+	return ""
+}
+
+func (x *Bot) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *Bot) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Bot) GetAppId() string {
+	if x != nil {
+		return x.AppId
+	}
+	return ""
+}
+
+func (x *Bot) GetAppPassword() string {
+	if x != nil {
+		return x.AppPassword
+	}
+	return ""
+}
+
+// Request to link a Teams channel or user to a repo or to a user.
+type LinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id of the repo or of the user to link.
+	LinkTo string `protobuf:"bytes,1,opt,name=link_to,json=linkTo,proto3" json:"link_to,omitempty"`
+	// Types that are valid to be assigned to Target:
+	//
+	//	*LinkRequest_Webhook
+	//	*LinkRequest_Bot
+	Target        isLinkRequest_Target `protobuf_oneof:"target"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkRequest) Reset() {
+	*x = LinkRequest{}
+	mi := &file_hooks_teams_v1_teams_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkRequest) ProtoMessage() {}
+
+func (x *LinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hooks_teams_v1_teams_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkRequest.ProtoReflect.Descriptor instead.
+func (*LinkRequest) Descriptor() ([]byte, []int) {
+	return file_hooks_teams_v1_teams_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LinkRequest) GetLinkTo() string {
+	if x != nil {
+		return x.LinkTo
+	}
+	return ""
+}
+
+func (x *LinkRequest) GetTarget() isLinkRequest_Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *LinkRequest) GetWebhook() *Webhook {
+	if x != nil {
+		if x, ok := x.Target.(*LinkRequest_Webhook); ok {
+			return x.Webhook
+		}
+	}
+	return nil
+}
+
+func (x *LinkRequest) GetBot() *Bot {
+	if x != nil {
+		if x, ok := x.Target.(*LinkRequest_Bot); ok {
+			return x.Bot
+		}
+	}
+	return nil
+}
+
+type isLinkRequest_Target interface {
+	isLinkRequest_Target()
+}
+
+type LinkRequest_Webhook struct {
+	Webhook *Webhook `protobuf:"bytes,2,opt,name=webhook,proto3,oneof"`
+}
+
+type LinkRequest_Bot struct {
+	Bot *Bot `protobuf:"bytes,3,opt,name=bot,proto3,oneof"`
+}
+
+func (*LinkRequest_Webhook) isLinkRequest_Target() {}
+
+func (*LinkRequest_Bot) isLinkRequest_Target() {}
+
+var File_hooks_teams_v1_teams_proto protoreflect.FileDescriptor
+
+var file_hooks_teams_v1_teams_proto_rawDesc = string([]byte{
+	0x0a, 0x1a, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75,
+	0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0xd4, 0x01,
+	0x0a, 0x03, 0x42, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0x88, 0x01, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x30, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x6c, 0x69, 0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x54, 0x6f, 0x12, 0x33, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2e, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x48, 0x00, 0x52, 0x07, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x27, 0x0a, 0x03,
+	0x62, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x74, 0x48, 0x00,
+	0x52, 0x03, 0x62, 0x6f, 0x74, 0x42, 0x0f, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12,
+	0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x32, 0x4b, 0x0a, 0x0c, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b,
+	0x2e, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x42, 0xb3, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x68, 0x6f, 0x6f, 0x6b,
+	0x73, 0x2e, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x65, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x6f, 0x2e, 0x62, 0x72, 0x65,
+	0x75, 0x2e, 0x69, 0x6f, 0x2f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x6d, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2f, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x48, 0x54, 0x58, 0xaa, 0x02, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x2e,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x73,
+	0x5c, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x48, 0x6f, 0x6f, 0x6b,
+	0x73, 0x5c, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x48, 0x6f, 0x6f, 0x6b, 0x73, 0x3a, 0x3a,
+	0x54, 0x65, 0x61, 0x6d, 0x73, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+})
+
+var (
+	file_hooks_teams_v1_teams_proto_rawDescOnce sync.Once
+	file_hooks_teams_v1_teams_proto_rawDescData []byte
+)
+
+func file_hooks_teams_v1_teams_proto_rawDescGZIP() []byte {
+	file_hooks_teams_v1_teams_proto_rawDescOnce.Do(func() {
+		file_hooks_teams_v1_teams_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_hooks_teams_v1_teams_proto_rawDesc), len(file_hooks_teams_v1_teams_proto_rawDesc)))
+	})
+	return file_hooks_teams_v1_teams_proto_rawDescData
+}
+
+var file_hooks_teams_v1_teams_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hooks_teams_v1_teams_proto_goTypes = []any{
+	(*Webhook)(nil),       // 0: hooks.teams.v1.Webhook
+	(*Bot)(nil),           // 1: hooks.teams.v1.Bot
+	(*LinkRequest)(nil),   // 2: hooks.teams.v1.LinkRequest
+	(*emptypb.Empty)(nil), // 3: google.protobuf.Empty
+}
+var file_hooks_teams_v1_teams_proto_depIdxs = []int32{
+	0, // 0: hooks.teams.v1.LinkRequest.webhook:type_name -> hooks.teams.v1.Webhook
+	1, // 1: hooks.teams.v1.LinkRequest.bot:type_name -> hooks.teams.v1.Bot
+	2, // 2: hooks.teams.v1.TeamsService.Link:input_type -> hooks.teams.v1.LinkRequest
+	3, // 3: hooks.teams.v1.TeamsService.Link:output_type -> google.protobuf.Empty
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_hooks_teams_v1_teams_proto_init() }
+func file_hooks_teams_v1_teams_proto_init() {
+	if File_hooks_teams_v1_teams_proto != nil {
+		return
+	}
+	file_hooks_teams_v1_teams_proto_msgTypes[2].OneofWrappers = []any{
+		(*LinkRequest_Webhook)(nil),
+		(*LinkRequest_Bot)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_hooks_teams_v1_teams_proto_rawDesc), len(file_hooks_teams_v1_teams_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_hooks_teams_v1_teams_proto_goTypes,
+		DependencyIndexes: file_hooks_teams_v1_teams_proto_depIdxs,
+		MessageInfos:      file_hooks_teams_v1_teams_proto_msgTypes,
+	}.Build()
+	File_hooks_teams_v1_teams_proto = out.File
+	file_hooks_teams_v1_teams_proto_goTypes = nil
+	file_hooks_teams_v1_teams_proto_depIdxs = nil
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: hooks/teams/v1/teams.proto
+
+package teamsv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/hooks/teams/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// TeamsServiceName is the fully-qualified name of the TeamsService service.
+	TeamsServiceName = "hooks.teams.v1.TeamsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// TeamsServiceLinkProcedure is the fully-qualified name of the TeamsService's Link RPC.
+	TeamsServiceLinkProcedure = "/hooks.teams.v1.TeamsService/Link"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	teamsServiceServiceDescriptor    = v1.File_hooks_teams_v1_teams_proto.Services().ByName("TeamsService")
+	teamsServiceLinkMethodDescriptor = teamsServiceServiceDescriptor.Methods().ByName("Link")
+)
+
+// TeamsServiceClient is a client for the hooks.teams.v1.TeamsService service.
+type TeamsServiceClient interface {
+	// Link a Teams channel or user, so that the notifications for the repo or the user are delivered to it.
+	Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewTeamsServiceClient constructs a client for the hooks.teams.v1.TeamsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTeamsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TeamsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &teamsServiceClient{
+		link: connect.NewClient[v1.LinkRequest, emptypb.Empty](
+			httpClient,
+			baseURL+TeamsServiceLinkProcedure,
+			connect.WithSchema(teamsServiceLinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// teamsServiceClient implements TeamsServiceClient.
+type teamsServiceClient struct {
+	link *connect.Client[v1.LinkRequest, emptypb.Empty]
+}
+
+// Link calls hooks.teams.v1.TeamsService.Link.
+func (c *teamsServiceClient) Link(ctx context.Context, req *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.link.CallUnary(ctx, req)
+}
+
+// TeamsServiceHandler is an implementation of the hooks.teams.v1.TeamsService service.
+type TeamsServiceHandler interface {
+	// Link a Teams channel or user, so that the notifications for the repo or the user are delivered to it.
+	Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewTeamsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTeamsServiceHandler(svc TeamsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	teamsServiceLinkHandler := connect.NewUnaryHandler(
+		TeamsServiceLinkProcedure,
+		svc.Link,
+		connect.WithSchema(teamsServiceLinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/hooks.teams.v1.TeamsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TeamsServiceLinkProcedure:
+			teamsServiceLinkHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTeamsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTeamsServiceHandler struct{}
+
+func (UnimplementedTeamsServiceHandler) Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.teams.v1.TeamsService.Link is not implemented"))
+}
@@ -0,0 +1,115 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: hooks/webhook/v1/webhook.proto
+
+package webhookv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "go.breu.io/quantm/internal/proto/hooks/webhook/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// WebhookServiceName is the fully-qualified name of the WebhookService service.
+	WebhookServiceName = "hooks.webhook.v1.WebhookService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// WebhookServiceLinkProcedure is the fully-qualified name of the WebhookService's Link RPC.
+	WebhookServiceLinkProcedure = "/hooks.webhook.v1.WebhookService/Link"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	webhookServiceServiceDescriptor    = v1.File_hooks_webhook_v1_webhook_proto.Services().ByName("WebhookService")
+	webhookServiceLinkMethodDescriptor = webhookServiceServiceDescriptor.Methods().ByName("Link")
+)
+
+// WebhookServiceClient is a client for the hooks.webhook.v1.WebhookService service.
+type WebhookServiceClient interface {
+	// Link an outgoing webhook, so that the notifications for the repo or the user are posted to it.
+	Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewWebhookServiceClient constructs a client for the hooks.webhook.v1.WebhookService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) WebhookServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &webhookServiceClient{
+		link: connect.NewClient[v1.LinkRequest, emptypb.Empty](
+			httpClient,
+			baseURL+WebhookServiceLinkProcedure,
+			connect.WithSchema(webhookServiceLinkMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// webhookServiceClient implements WebhookServiceClient.
+type webhookServiceClient struct {
+	link *connect.Client[v1.LinkRequest, emptypb.Empty]
+}
+
+// Link calls hooks.webhook.v1.WebhookService.Link.
+func (c *webhookServiceClient) Link(ctx context.Context, req *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.link.CallUnary(ctx, req)
+}
+
+// WebhookServiceHandler is an implementation of the hooks.webhook.v1.WebhookService service.
+type WebhookServiceHandler interface {
+	// Link an outgoing webhook, so that the notifications for the repo or the user are posted to it.
+	Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error)
+}
+
+// NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	webhookServiceLinkHandler := connect.NewUnaryHandler(
+		WebhookServiceLinkProcedure,
+		svc.Link,
+		connect.WithSchema(webhookServiceLinkMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/hooks.webhook.v1.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WebhookServiceLinkProcedure:
+			webhookServiceLinkHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedWebhookServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedWebhookServiceHandler struct{}
+
+func (UnimplementedWebhookServiceHandler) Link(context.Context, *connect.Request[v1.LinkRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("hooks.webhook.v1.WebhookService.Link is not implemented"))
+}
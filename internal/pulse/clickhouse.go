@@ -0,0 +1,443 @@
+package pulse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/pulse/migrations"
+)
+
+const (
+	statement__events__batch = `
+INSERT INTO %s (
+	version,
+	id,
+	parents,
+	hook,
+	scope,
+	action,
+	source,
+	subject_id,
+	subject_name,
+	user_id,
+	team_id,
+	org_id,
+	timestamp,
+	payload
+)
+`
+
+	// statement__events__report counts the events of a repo or a team over a period. The merge and drift events are the
+	// ones sent to the chat, i.e. with a chat hook, or none if no chat is linked.
+	statement__events__report = `
+SELECT
+  countIf(scope = 'merge' AND action = 'failure') AS conflicts_detected,
+  countIf(scope = 'merge' AND action = 'completed') AS conflicts_resolved,
+  countIf(scope = 'drift' AND action = 'requested') AS stale_branches
+FROM %s
+WHERE %s = ? AND timestamp >= ? AND timestamp < ?
+`
+
+	// statement__events__report_prs selects the pull requests of a repo or a team merged over a period, with the time
+	// they were opened at and last queued at before the merge, and the lines of the latest diff of their branch. Only
+	// the events of the repo hooks tell a merge, the chat hooks echo them.
+	statement__events__report_prs = `
+WITH merged AS (
+  SELECT subject_id, pr_number, any(branch) AS branch, min(timestamp) AS merged_at
+  FROM %[1]s
+  WHERE %[2]s = ? AND timestamp >= ? AND timestamp < ?
+    AND scope = 'pr' AND action = 'closed' AND %[3]s AND JSONExtractBool(payload, 'merged')
+  GROUP BY subject_id, pr_number
+)
+SELECT
+  m.subject_id,
+  m.pr_number,
+  m.branch,
+  m.merged_at,
+  minIf(e.timestamp, e.scope = 'pr' AND e.action = 'opened' AND e.pr_number = m.pr_number) AS opened_at,
+  maxIf(e.timestamp, e.scope = 'merge_queue' AND e.action = 'added' AND e.pr_number = m.pr_number AND e.timestamp <= m.merged_at)
+    AS queued_at,
+  toInt64(argMaxIf(e.lines_added + e.lines_removed, e.timestamp, e.scope = 'diff' AND e.timestamp <= m.merged_at)) AS lines
+FROM merged AS m
+LEFT JOIN (
+  SELECT subject_id, pr_number, branch, scope, action, timestamp, lines_added, lines_removed
+  FROM %[1]s
+  WHERE subject_id IN (SELECT subject_id FROM merged) AND scope IN ('pr', 'merge_queue', 'diff')
+) AS e ON e.subject_id = m.subject_id AND e.branch = m.branch
+GROUP BY m.subject_id, m.pr_number, m.branch, m.merged_at
+`
+)
+
+type (
+	// clickhouse writes the events of each org to its own table, events_<slug>.
+	clickhouse struct{}
+)
+
+func (s *clickhouse) Provision(ctx context.Context, slug string) error {
+	return migrations.Up(ctx, Get().Connection(), migrations.KindEvents, table_name("events", slug))
+}
+
+func (s *clickhouse) Migrate(ctx context.Context, command migrations.Command, slug string) (*migrations.Status, error) {
+	return migrations.Run(ctx, Get().Connection(), command, migrations.KindEvents, table_name("events", slug))
+}
+
+func (s *clickhouse) Write(ctx context.Context, slug string, rows []*Row) error {
+	batch, err := Get().Connection().PrepareBatch(ctx, fmt.Sprintf(statement__events__batch, table_name("events", slug)))
+	if err != nil {
+		return err
+	}
+
+	for _, r := range rows {
+		err := batch.Append(
+			r.Version,
+			r.ID,
+			r.Parents,
+			r.Hook,
+			r.Scope,
+			r.Action,
+			r.Source,
+			r.SubjectID,
+			r.SubjectName,
+			r.UserID,
+			r.TeamID,
+			r.OrgID,
+			r.Timestamp,
+			string(r.Payload),
+		)
+		if err != nil {
+			_ = batch.Abort()
+			return err
+		}
+	}
+
+	return batch.Send()
+}
+
+func (s *clickhouse) Report(
+	ctx context.Context, slug string, by ReportBy, id uuid.UUID, from, to time.Time,
+) (*ReportCounts, error) {
+	table := table_name("events", slug)
+	counts := &ReportCounts{}
+
+	err := Get().
+		Connection().
+		QueryRow(ctx, fmt.Sprintf(statement__events__report, table, by), id, from, to).
+		Scan(&counts.ConflictsDetected, &counts.ConflictsResolved, &counts.StaleBranches)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := Get().Connection().Query(ctx, fmt.Sprintf(statement__events__report_prs, table, by, events.RepoHookFilter), id, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	prs := make([]*ReportPR, 0)
+
+	for rows.Next() {
+		pr := &ReportPR{}
+		if err := rows.Scan(&pr.SubjectID, &pr.Number, &pr.Branch, &pr.MergedAt, &pr.OpenedAt, &pr.QueuedAt, &pr.Lines); err != nil {
+			return nil, err
+		}
+
+		// the aggregates over no events are the zero of clickhouse, i.e. the epoch.
+		pr.OpenedAt, pr.QueuedAt = unset_epoch(pr.OpenedAt), unset_epoch(pr.QueuedAt)
+		prs = append(prs, pr)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	counts.Summarize(prs)
+
+	return counts, nil
+}
+
+// unset_epoch returns the zero time for the epoch.
+func unset_epoch(t time.Time) time.Time {
+	if t.Unix() <= 0 {
+		return time.Time{}
+	}
+
+	return t
+}
+
+// -- lineage --
+
+const (
+	// statement__events__lineage selects the roots of the events matching the filter, then the roots and the events
+	// descending from them.
+	statement__events__lineage = `
+WITH (
+  SELECT groupUniqArray(if(empty(parents), id, parents[1])) FROM %[1]s WHERE %[2]s
+) AS roots
+SELECT
+  version, id, parents, hook, scope, action, source, subject_id, subject_name, user_id, team_id, org_id, timestamp, payload
+FROM %[1]s
+WHERE has(roots, id) OR hasAny(parents, roots)
+ORDER BY timestamp, id
+LIMIT %[3]d
+`
+)
+
+func (s *clickhouse) Lineage(ctx context.Context, slug string, query *LineageQuery) ([]*Row, error) {
+	filter, args := lineage_filter(query)
+	stmt := fmt.Sprintf(statement__events__lineage, table_name("events", slug), filter, LineageLimit)
+
+	rows, err := Get().Connection().Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scan_rows(rows, slug)
+}
+
+// lineage_filter returns the condition selecting the events the query traces, with its arguments.
+func lineage_filter(query *LineageQuery) (string, []any) {
+	switch {
+	case query.EventID != uuid.Nil:
+		return "id = ?", []any{query.EventID}
+	case query.PRNumber > 0:
+		return "subject_id = ? AND pr_number = ?", []any{query.RepoID, query.PRNumber}
+	default:
+		return "subject_id = ? AND branch = ?", []any{query.RepoID, query.Branch}
+	}
+}
+
+// -- events --
+
+const (
+	// statement__events__repo selects a page of the events of a repo, skipping the ones derived from the events of the
+	// given scopes.
+	statement__events__repo = `
+SELECT
+  version, id, parents, hook, scope, action, source, subject_id, subject_name, user_id, team_id, org_id, timestamp, payload
+FROM %[1]s
+WHERE subject_id = ? AND timestamp >= ? AND timestamp < ? AND has(?, scope)
+  AND NOT (notEmpty(parents) AND parents[-1] IN (SELECT id FROM %[1]s WHERE subject_id = ? AND has(?, scope)))
+ORDER BY timestamp, id
+LIMIT %[2]d OFFSET %[3]d
+`
+)
+
+func (s *clickhouse) Events(ctx context.Context, slug string, query *EventsQuery) ([]*Row, error) {
+	stmt := fmt.Sprintf(statement__events__repo, table_name("events", slug), query.Limit, query.Offset)
+
+	rows, err := Get().
+		Connection().
+		Query(ctx, stmt, query.RepoID, query.From, query.To, query.Scopes, query.RepoID, query.Derived)
+	if err != nil {
+		return nil, err
+	}
+
+	return scan_rows(rows, slug)
+}
+
+// scan_rows reads the events selected with all the columns of the events table, then closes the rows.
+func scan_rows(rows driver.Rows, slug string) ([]*Row, error) {
+	defer func() { _ = rows.Close() }()
+
+	result := make([]*Row, 0)
+
+	for rows.Next() {
+		var payload string
+
+		r := &Row{Slug: slug}
+
+		err := rows.Scan(
+			&r.Version, &r.ID, &r.Parents, &r.Hook, &r.Scope, &r.Action, &r.Source,
+			&r.SubjectID, &r.SubjectName, &r.UserID, &r.TeamID, &r.OrgID, &r.Timestamp, &payload,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Payload = json.RawMessage(payload)
+		result = append(result, r)
+	}
+
+	return result, rows.Err()
+}
+
+// -- dora --
+
+const (
+	// statement__dora__deployments counts the deployments of each period, with the median of their lead time in seconds,
+	// NaN if unknown. The lead time of a deployment starts at the first commit of the pushes to its branch since the
+	// branch was last created, deleted or merged, so that the earlier branches of the same name do not count.
+	statement__dora__deployments = `
+SELECT
+  %[2]s AS period,
+  count() AS deployments,
+  quantileIf(0.5)(
+    dateDiff('second', first_commit_at, merged_at),
+    first_commit_at > toDateTime(0) AND first_commit_at <= merged_at
+  ) AS lead_time
+FROM (
+  SELECT
+    l.id,
+    any(l.merged_at) AS merged_at,
+    minIf(p.first_commit_at, p.first_commit_at > toDateTime(0) AND p.pushed_at >= l.started_at AND p.pushed_at <= l.merged_at)
+      AS first_commit_at
+  FROM (
+    SELECT
+      d.id,
+      any(d.subject_id) AS subject_id,
+      any(d.branch) AS branch,
+      any(d.merged_at) AS merged_at,
+      maxIf(r.reset_at, r.reset_at < d.merged_at) AS started_at
+    FROM (
+      SELECT id, subject_id, branch, merged_at
+      FROM %[1]s_deployments FINAL
+      WHERE %[3]s = ? AND merged_at >= ? AND merged_at < ?
+    ) AS d
+    LEFT JOIN (
+      SELECT subject_id, branch, reset_at FROM %[1]s_branch_resets FINAL
+      UNION ALL
+      SELECT subject_id, branch, merged_at AS reset_at FROM %[1]s_deployments FINAL
+    ) AS r ON d.subject_id = r.subject_id AND d.branch = r.branch
+    GROUP BY d.id
+  ) AS l
+  LEFT JOIN (
+    SELECT subject_id, branch, pushed_at, first_commit_at
+    FROM %[1]s_branch_pushes FINAL
+  ) AS p ON l.subject_id = p.subject_id AND l.branch = p.branch
+  GROUP BY l.id
+)
+GROUP BY period
+`
+
+	// statement__dora__pipelines counts the pipelines done on the trunk in each period, and the failed ones, with the
+	// median of the time to restore in seconds, NaN if unknown. The previous and the next pipelines are looked up over
+	// the whole history, so that a failure is restored after the end of the period.
+	statement__dora__pipelines = `
+SELECT
+  %[2]s AS period,
+  count() AS pipelines,
+  countIf(failed = 1) AS failed_pipelines,
+  quantileIf(0.5)(
+    dateDiff('second', finished_at, restored_at),
+    failed = 1 AND previous = 0 AND restored_at > finished_at
+  ) AS time_to_restore
+FROM (
+  SELECT
+    finished_at,
+    failed,
+    lagInFrame(failed, 1, toUInt8(0)) OVER (
+      PARTITION BY subject_id, branch ORDER BY finished_at ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW
+    ) AS previous,
+    minIf(finished_at, failed = 0) OVER (
+      PARTITION BY subject_id, branch ORDER BY finished_at ROWS BETWEEN 1 FOLLOWING AND UNBOUNDED FOLLOWING
+    ) AS restored_at
+  FROM %[1]s_pipelines FINAL
+  WHERE %[3]s = ? AND (subject_id, branch) IN (SELECT subject_id, base_branch FROM %[1]s_deployments)
+)
+WHERE finished_at >= ? AND finished_at < ?
+GROUP BY period
+`
+)
+
+func (s *clickhouse) Dora(ctx context.Context, slug string, query *DoraQuery) ([]*Dora, error) {
+	table := table_name("events", slug)
+	periods := Periods(query)
+	starts := make(map[time.Time]*Dora, len(periods))
+
+	for _, period := range periods {
+		starts[Start(period.From, query.Interval)] = period
+	}
+
+	stmt := fmt.Sprintf(statement__dora__deployments, table, period(query.Interval, "merged_at"), query.By)
+
+	rows, err := Get().Connection().Query(ctx, stmt, query.ID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		var (
+			start       time.Time
+			deployments uint64
+			lead_time   float64
+		)
+
+		if err := rows.Scan(&start, &deployments, &lead_time); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+
+		if p, ok := starts[start.UTC()]; ok {
+			p.Deployments = deployments
+			p.LeadTime = seconds(lead_time)
+		}
+	}
+
+	if err := errors.Join(rows.Err(), rows.Close()); err != nil {
+		return nil, err
+	}
+
+	stmt = fmt.Sprintf(statement__dora__pipelines, table, period(query.Interval, "finished_at"), query.By)
+
+	rows, err = Get().Connection().Query(ctx, stmt, query.ID, query.From, query.To)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var (
+			start           time.Time
+			pipelines       uint64
+			failed          uint64
+			time_to_restore float64
+		)
+
+		if err := rows.Scan(&start, &pipelines, &failed, &time_to_restore); err != nil {
+			return nil, err
+		}
+
+		if p, ok := starts[start.UTC()]; ok {
+			p.Pipelines = pipelines
+			p.FailedPipelines = failed
+			p.TimeToRestore = seconds(time_to_restore)
+		}
+	}
+
+	return periods, rows.Err()
+}
+
+// period returns the expression of the start of the period of the column, in UTC.
+func period(interval Interval, column string) string {
+	switch interval {
+	case IntervalDay:
+		return fmt.Sprintf("toStartOfDay(%s, 'UTC')", column)
+	case IntervalWeek:
+		return fmt.Sprintf("toDateTime(toMonday(%s, 'UTC'), 'UTC')", column)
+	case IntervalMonth:
+		return fmt.Sprintf("toDateTime(toStartOfMonth(%s, 'UTC'), 'UTC')", column)
+	default:
+		return "toDateTime(0, 'UTC')"
+	}
+}
+
+// seconds returns the duration of the seconds, nil if unknown.
+func seconds(value float64) *time.Duration {
+	if math.IsNaN(value) {
+		return nil
+	}
+
+	duration := time.Duration(value * float64(time.Second))
+
+	return &duration
+}
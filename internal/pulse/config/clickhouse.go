@@ -4,13 +4,22 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"os"
+	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
 	"github.com/go-playground/validator/v10"
 )
 
+const (
+	SinkClickhouse = "clickhouse" // SinkClickhouse writes the events to a table per org in clickhouse.
+	SinkPostgres   = "postgres"   // SinkPostgres writes the events to the pulse_events table of the database.
+	SinkNDJSON     = "ndjson"     // SinkNDJSON writes the events to a file per org, one json per line.
+)
+
 type (
 	// Config encapsulates configuration and connection management for a ClickHouse database.
 	Config struct {
@@ -20,6 +29,20 @@ type (
 		Password string `json:"pass" koanf:"PASS" validate:"required"` // Database password.
 		Name     string `json:"name" koanf:"NAME" validate:"required"` // Database name.
 
+		// Sink is where the events are written to. Only the clickhouse sink connects to clickhouse, the postgres sink is
+		// meant for small installs, without the DORA metrics, and the ndjson sink, writing to files in Dir, for local
+		// development and the tests.
+		Sink string `json:"sink" koanf:"SINK" validate:"oneof=clickhouse postgres ndjson"`
+		Dir  string `json:"dir" koanf:"DIR" validate:"required_if=Sink ndjson"`
+
+		// Events are written in batches, once BatchSize events are buffered or every FlushInterval. Until then, they are
+		// appended to the segments of the Spool, so that they survive a crash of the worker. The segments are named after
+		// the Spool, along with its dead letter file and its lock. The spool must be on a persistent volume, and is only
+		// used by one worker at a time, so there is no default, and the workers refuse to start without one.
+		BatchSize     int           `json:"batch_size" koanf:"BATCH_SIZE" validate:"gte=1"`
+		FlushInterval time.Duration `json:"flush_interval" koanf:"FLUSH_INTERVAL" validate:"gt=0"`
+		Spool         string        `json:"spool" koanf:"SPOOL"`
+
 		conn driver.Conn // Established database connection.
 		once *sync.Once  // Ensures single connection initialization.
 	}
@@ -37,6 +60,12 @@ var (
 		Password: "ctrlplane", // Default password.
 		Name:     "ctrlplane", // Default database name.
 
+		Sink: SinkClickhouse,
+		Dir:  filepath.Join(os.TempDir(), "quantm", "events"),
+
+		BatchSize:     1000,
+		FlushInterval: 5 * time.Second,
+
 		once: &sync.Once{}, // Guarantees single connection attempt.
 	}
 )
@@ -88,8 +117,12 @@ func (c *Config) Connection() driver.Conn {
 
 // Start initiates a connection to the ClickHouse database.  Uses a sync.Once to ensure the connection is established only
 // once, even with concurrent calls.  The provided context allows for cancellation or timeout during connection establishment.
-// Returns an error from the connect function.
+// Returns an error from the connect function. Nothing is connected unless the events are written to clickhouse.
 func (c *Config) Start(ctx context.Context) error {
+	if c.Sink != SinkClickhouse {
+		return nil
+	}
+
 	var err error
 
 	c.once.Do(func() {
@@ -153,6 +186,11 @@ func WithConfig(cfg *Config) Option {
 		c.User = cfg.User
 		c.Password = cfg.Password
 		c.Name = cfg.Name
+		c.Sink = cfg.Sink
+		c.Dir = cfg.Dir
+		c.BatchSize = cfg.BatchSize
+		c.FlushInterval = cfg.FlushInterval
+		c.Spool = cfg.Spool
 	}
 }
 
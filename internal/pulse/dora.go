@@ -0,0 +1,136 @@
+package pulse
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ReportByOrg ReportBy = "org_id" // reports the events of the repos of an org.
+)
+
+const (
+	IntervalNone  Interval = ""      // a single period for the whole range.
+	IntervalDay   Interval = "day"   // periods of a day.
+	IntervalWeek  Interval = "week"  // periods of a week, starting on Monday.
+	IntervalMonth Interval = "month" // periods of a month.
+)
+
+type (
+	// Interval is the length of the periods the metrics are grouped by. The periods start at midnight, UTC.
+	Interval string
+
+	// DoraQuery selects the events of a repo, a team or the org, from inclusive, to exclusive.
+	DoraQuery struct {
+		By       ReportBy
+		ID       uuid.UUID
+		From     time.Time
+		To       time.Time
+		Interval Interval
+	}
+
+	// Dora are the DORA metrics over a period. The durations are nil when unknown.
+	Dora struct {
+		From            time.Time
+		To              time.Time
+		Deployments     uint64
+		LeadTime        *time.Duration
+		Pipelines       uint64
+		FailedPipelines uint64
+		TimeToRestore   *time.Duration
+	}
+
+	// DoraSink is a sink computing the DORA metrics.
+	DoraSink interface {
+		Dora(ctx context.Context, slug string, query *DoraQuery) ([]*Dora, error)
+	}
+)
+
+var (
+	ErrUnsupported = errors.New("pulse: not supported by the sink")
+)
+
+// Frequency returns the deployments per day.
+func (d *Dora) Frequency() float64 {
+	days := d.To.Sub(d.From).Hours() / 24
+	if days <= 0 {
+		return 0
+	}
+
+	return float64(d.Deployments) / days
+}
+
+// FailureRate returns the share of the pipelines that failed.
+func (d *Dora) FailureRate() float64 {
+	if d.Pipelines == 0 {
+		return 0
+	}
+
+	return float64(d.FailedPipelines) / float64(d.Pipelines)
+}
+
+// DoraMetrics returns the DORA metrics of the org for each period of the query, in order.
+func DoraMetrics(ctx context.Context, slug string, query *DoraQuery) ([]*Dora, error) {
+	sink, ok := GetSink().(DoraSink)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	return sink.Dora(ctx, slug, query)
+}
+
+// Periods splits the range of the query into the periods of its interval. The first and the last periods are cut to
+// the range.
+func Periods(query *DoraQuery) []*Dora {
+	periods := make([]*Dora, 0)
+
+	if query.Interval == IntervalNone {
+		return append(periods, &Dora{From: query.From, To: query.To})
+	}
+
+	for from := query.From; from.Before(query.To); {
+		to := next(from, query.Interval)
+		if to.After(query.To) {
+			to = query.To
+		}
+
+		periods = append(periods, &Dora{From: from, To: to})
+		from = to
+	}
+
+	return periods
+}
+
+// Start returns the start of the period of the interval the time falls in, the zero time for a single period.
+func Start(t time.Time, interval Interval) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch interval {
+	case IntervalDay:
+		return day
+	case IntervalWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case IntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Time{}
+	}
+}
+
+// next returns the start of the period following the one the time falls in.
+func next(t time.Time, interval Interval) time.Time {
+	start := Start(t, interval)
+
+	switch interval {
+	case IntervalDay:
+		return start.AddDate(0, 0, 1)
+	case IntervalWeek:
+		return start.AddDate(0, 0, 7)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
@@ -0,0 +1,44 @@
+package pulse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/pulse"
+)
+
+func TestPeriods(t *testing.T) {
+	from := time.Date(2024, 5, 8, 12, 0, 0, 0, time.UTC) // a wednesday
+	to := time.Date(2024, 5, 21, 0, 0, 0, 0, time.UTC)
+
+	single := pulse.Periods(&pulse.DoraQuery{From: from, To: to})
+	if assert.Len(t, single, 1) {
+		assert.Equal(t, from, single[0].From)
+		assert.Equal(t, to, single[0].To)
+	}
+
+	weeks := pulse.Periods(&pulse.DoraQuery{From: from, To: to, Interval: pulse.IntervalWeek})
+	if assert.Len(t, weeks, 3) {
+		assert.Equal(t, from, weeks[0].From, "cut to the range")
+		assert.Equal(t, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC), weeks[0].To)
+		assert.Equal(t, time.Date(2024, 5, 20, 0, 0, 0, 0, time.UTC), weeks[2].From)
+		assert.Equal(t, to, weeks[2].To, "cut to the range")
+	}
+
+	days := pulse.Periods(&pulse.DoraQuery{From: from, To: to, Interval: pulse.IntervalDay})
+	assert.Len(t, days, 13)
+
+	months := pulse.Periods(&pulse.DoraQuery{From: from, To: to.AddDate(0, 1, 0), Interval: pulse.IntervalMonth})
+	assert.Len(t, months, 2)
+}
+
+func TestDoraRates(t *testing.T) {
+	from := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	dora := &pulse.Dora{From: from, To: from.AddDate(0, 0, 7), Deployments: 14, Pipelines: 8, FailedPipelines: 2}
+
+	assert.InDelta(t, 2.0, dora.Frequency(), 1e-9)
+	assert.InDelta(t, 0.25, dora.FailureRate(), 1e-9)
+	assert.Zero(t, (&pulse.Dora{}).FailureRate())
+}
@@ -0,0 +1,82 @@
+package pulse
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// EventsQuery selects a page of the events of a repo, from inclusive, to exclusive, in order of their timestamp. Only
+	// the events of the scopes are kept, and the events whose direct parent has one of the derived scopes are skipped,
+	// i.e. the ones the workflows derived from the events of these scopes.
+	EventsQuery struct {
+		RepoID  uuid.UUID
+		From    time.Time
+		To      time.Time
+		Scopes  []string
+		Derived []string
+		Offset  int
+		Limit   int
+	}
+
+	// EventSink is a sink reading back the events of a repo.
+	EventSink interface {
+		Events(ctx context.Context, slug string, query *EventsQuery) ([]*Row, error)
+	}
+)
+
+// RepoEvents returns the page of the events of the repo of the org selected by the query.
+func RepoEvents(ctx context.Context, slug string, query *EventsQuery) ([]*Row, error) {
+	sink, ok := GetSink().(EventSink)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	return sink.Events(ctx, slug, query)
+}
+
+// Select returns the page of the rows selected by the query, in order of their timestamp, for the sinks filtering the
+// events themselves.
+func (q *EventsQuery) Select(rows []*Row) []*Row {
+	scopes := make(map[uuid.UUID]string)
+
+	for _, r := range rows {
+		if r.SubjectID == q.RepoID {
+			scopes[r.ID] = r.Scope
+		}
+	}
+
+	selected := make([]*Row, 0)
+
+	for _, r := range rows {
+		if r.SubjectID != q.RepoID || r.Timestamp.Before(q.From) || !r.Timestamp.Before(q.To) {
+			continue
+		}
+
+		if !slices.Contains(q.Scopes, r.Scope) {
+			continue
+		}
+
+		if len(r.Parents) > 0 && slices.Contains(q.Derived, scopes[r.Parents[len(r.Parents)-1]]) {
+			continue
+		}
+
+		selected = append(selected, r)
+	}
+
+	slices.SortStableFunc(selected, func(a, b *Row) int { return a.Timestamp.Compare(b.Timestamp) })
+
+	if q.Offset >= len(selected) {
+		return selected[:0]
+	}
+
+	selected = selected[q.Offset:]
+	if q.Limit > 0 && len(selected) > q.Limit {
+		selected = selected[:q.Limit]
+	}
+
+	return selected
+}
@@ -0,0 +1,165 @@
+package pulse
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// LineageLimit is the most events a lineage holds. The oldest ones are kept.
+	LineageLimit = 1000
+)
+
+type (
+	// LineageQuery selects the events to trace, either an event by its id, or the events of a pull request or a branch
+	// of a repo.
+	LineageQuery struct {
+		EventID  uuid.UUID
+		RepoID   uuid.UUID
+		PRNumber int64
+		Branch   string
+	}
+
+	// Hop is an event of a lineage. The parent is the closest ancestor of the event that was persisted, nil for a root.
+	Hop struct {
+		Event    *Row
+		Parent   uuid.UUID
+		Duration *time.Duration // Duration is the time from the parent to the event, nil for a root.
+		Elapsed  time.Duration  // Elapsed is the time from the root to the event.
+	}
+
+	// Lineage is the causal graph of the events descending from the same roots, as recorded in Context.Parents.
+	Lineage struct {
+		Roots []uuid.UUID
+		Hops  []*Hop
+	}
+
+	// LineageSink is a sink tracing the events. It returns the events descending from the roots of the events selected
+	// by the query, and the roots themselves.
+	LineageSink interface {
+		Lineage(ctx context.Context, slug string, query *LineageQuery) ([]*Row, error)
+	}
+)
+
+// Root returns the id of the root of the event, i.e. its first parent, or the event itself.
+func (r *Row) Root() uuid.UUID {
+	if len(r.Parents) > 0 {
+		return r.Parents[0]
+	}
+
+	return r.ID
+}
+
+// PRNumber returns the number of the pull request of the event, 0 if none, as the pr_number column of the events table.
+func (r *Row) PRNumber() int64 {
+	payload := struct {
+		Number json.Number `json:"number"`
+	}{}
+
+	if err := json.Unmarshal(r.Payload, &payload); err != nil {
+		return 0
+	}
+
+	number, _ := payload.Number.Int64()
+
+	return number
+}
+
+// Branch returns the branch of the event, empty if none, as the branch column of the events table.
+func (r *Row) Branch() string {
+	payload := struct {
+		HeadBranch *string `json:"head_branch"`
+		Branch     string  `json:"branch"`
+	}{}
+
+	if err := json.Unmarshal(r.Payload, &payload); err != nil {
+		return ""
+	}
+
+	if payload.HeadBranch != nil {
+		return *payload.HeadBranch
+	}
+
+	return payload.Branch
+}
+
+// Match reports whether the row is selected by the query.
+func (q *LineageQuery) Match(r *Row) bool {
+	switch {
+	case q.EventID != uuid.Nil:
+		return r.ID == q.EventID
+	case r.SubjectID != q.RepoID:
+		return false
+	case q.PRNumber > 0:
+		return r.PRNumber() == q.PRNumber
+	default:
+		return q.Branch != "" && r.Branch() == q.Branch
+	}
+}
+
+// TraceLineage returns the lineage of the events of the org selected by the query, nil if none.
+func TraceLineage(ctx context.Context, slug string, query *LineageQuery) (*Lineage, error) {
+	sink, ok := GetSink().(LineageSink)
+	if !ok {
+		return nil, ErrUnsupported
+	}
+
+	rows, err := sink.Lineage(ctx, slug, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return Graph(rows), nil
+}
+
+// Graph links the events to their parents, in order of their timestamp. An ancestor that is not among the events, e.g.
+// it was not persisted, is skipped for the next one up the chain. It returns nil without events.
+func Graph(rows []*Row) *Lineage {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	rows = slices.Clone(rows)
+	slices.SortStableFunc(rows, func(a, b *Row) int { return a.Timestamp.Compare(b.Timestamp) })
+
+	seen := make(map[uuid.UUID]*Row, len(rows))
+	for _, r := range rows {
+		seen[r.ID] = r
+	}
+
+	lineage := &Lineage{Roots: make([]uuid.UUID, 0), Hops: make([]*Hop, 0, len(rows))}
+	roots := make(map[uuid.UUID]time.Time)
+
+	for _, r := range rows {
+		hop := &Hop{Event: r}
+
+		for i := len(r.Parents) - 1; i >= 0; i-- {
+			if parent, ok := seen[r.Parents[i]]; ok {
+				duration := r.Timestamp.Sub(parent.Timestamp)
+				hop.Parent, hop.Duration = parent.ID, &duration
+
+				break
+			}
+		}
+
+		root := r.Root()
+		if _, ok := roots[root]; !ok {
+			at := r.Timestamp
+			if ancestor, ok := seen[root]; ok {
+				at = ancestor.Timestamp
+			}
+
+			roots[root] = at
+			lineage.Roots = append(lineage.Roots, root)
+		}
+
+		hop.Elapsed = r.Timestamp.Sub(roots[root])
+		lineage.Hops = append(lineage.Hops, hop)
+	}
+
+	return lineage
+}
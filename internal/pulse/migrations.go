@@ -0,0 +1,37 @@
+package pulse
+
+import (
+	"context"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/pulse/migrations"
+)
+
+// MigrateEventsTable creates the events table of the org, or brings it to the latest version.
+func MigrateEventsTable(ctx context.Context, slug string) error {
+	return GetSink().Provision(ctx, slug)
+}
+
+// MigrateEventsTables runs the command on the events tables of all the orgs, and returns the status of each. Only the
+// clickhouse sink has tables of its own to migrate.
+func MigrateEventsTables(ctx context.Context, command migrations.Command) ([]*migrations.Status, error) {
+	slugs, err := db.Queries().ListOrgSlugs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	all := make([]*migrations.Status, 0, len(slugs))
+
+	for _, slug := range slugs {
+		status, err := GetSink().Migrate(ctx, command, slug)
+		if err != nil {
+			return all, err
+		}
+
+		if status != nil {
+			all = append(all, status)
+		}
+	}
+
+	return all, nil
+}
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS {table} (
+  version String,
+  id UUID,
+  parents Array(UUID),
+  hook Int32,
+  scope String,
+  action String,
+  source String,
+  subject_id UUID,
+  subject_name String,
+  user_id UUID,
+  team_id UUID,
+  org_id UUID,
+  timestamp DateTime
+)
+ENGINE = MergeTree()
+PARTITION BY toYYYYMM(timestamp)
+ORDER BY (toStartOfWeek(timestamp), toStartOfMonth(timestamp), timestamp, id);
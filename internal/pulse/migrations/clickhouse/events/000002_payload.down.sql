@@ -0,0 +1,9 @@
+ALTER TABLE {table}
+  DROP COLUMN IF EXISTS files,
+  DROP COLUMN IF EXISTS lines_removed,
+  DROP COLUMN IF EXISTS lines_added,
+  DROP COLUMN IF EXISTS sha,
+  DROP COLUMN IF EXISTS base_branch,
+  DROP COLUMN IF EXISTS branch,
+  DROP COLUMN IF EXISTS pr_number,
+  DROP COLUMN IF EXISTS payload;
@@ -0,0 +1,13 @@
+-- the payload is the JSON of the proto, with its int64 fields as strings. The fields queried the most are extracted
+-- from it on insert.
+ALTER TABLE {table}
+  ADD COLUMN IF NOT EXISTS payload String CODEC(ZSTD(3)),
+  ADD COLUMN IF NOT EXISTS pr_number Int64 MATERIALIZED toInt64OrZero(JSONExtractString(payload, 'number')),
+  ADD COLUMN IF NOT EXISTS branch String MATERIALIZED
+    if(JSONHas(payload, 'head_branch'), JSONExtractString(payload, 'head_branch'), JSONExtractString(payload, 'branch')),
+  ADD COLUMN IF NOT EXISTS base_branch String MATERIALIZED JSONExtractString(payload, 'base_branch'),
+  ADD COLUMN IF NOT EXISTS sha String MATERIALIZED
+    if(JSONHas(payload, 'after'), JSONExtractString(payload, 'after'), JSONExtractString(payload, 'head_commit', 'sha')),
+  ADD COLUMN IF NOT EXISTS lines_added Int32 MATERIALIZED JSONExtractInt(payload, 'lines', 'added'),
+  ADD COLUMN IF NOT EXISTS lines_removed Int32 MATERIALIZED JSONExtractInt(payload, 'lines', 'removed'),
+  ADD COLUMN IF NOT EXISTS files Array(String) MATERIALIZED JSONExtract(payload, 'files', 'Array(String)');
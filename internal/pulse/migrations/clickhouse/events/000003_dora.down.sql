@@ -0,0 +1,7 @@
+DROP VIEW IF EXISTS {table}_pipelines_mv;
+DROP VIEW IF EXISTS {table}_deployments_mv;
+DROP VIEW IF EXISTS {table}_branches_mv;
+
+DROP TABLE IF EXISTS {table}_pipelines;
+DROP TABLE IF EXISTS {table}_deployments;
+DROP TABLE IF EXISTS {table}_branches;
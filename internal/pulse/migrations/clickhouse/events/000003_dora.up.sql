@@ -0,0 +1,132 @@
+-- the facts of the dora metrics, kept by materialized views on the events of the org, and filled from the events
+-- recorded so far. Only the events of the repo hooks count, since the chat hooks echo some of them. The facts are
+-- deduplicated on the id of the event, so the backfill is safe to run again.
+
+-- the first commit pushed to each branch, where the lead time of its changes starts.
+CREATE TABLE IF NOT EXISTS {table}_branches (
+  org_id UUID,
+  team_id UUID,
+  subject_id UUID,
+  branch String,
+  first_commit_at SimpleAggregateFunction(min, DateTime)
+)
+ENGINE = AggregatingMergeTree()
+ORDER BY (subject_id, branch);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {table}_branches_mv TO {table}_branches AS
+SELECT org_id, team_id, subject_id, branch, min(first_commit_at) AS first_commit_at
+FROM (
+  SELECT
+    org_id,
+    team_id,
+    subject_id,
+    replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+    arrayFilter(
+      t -> t > toDateTime(0),
+      arrayMap(c -> parseDateTimeBestEffortOrZero(JSONExtractString(c, 'timestamp')), JSONExtractArrayRaw(payload, 'commits'))
+    ) AS commits,
+    if(empty(commits), timestamp, least(timestamp, arrayMin(commits))) AS first_commit_at
+  FROM {table}
+  WHERE scope = 'push' AND {repo_hooks}
+)
+WHERE branch != ''
+GROUP BY org_id, team_id, subject_id, branch;
+
+-- the pull requests merged, i.e. the changes deployed to the trunk.
+CREATE TABLE IF NOT EXISTS {table}_deployments (
+  id UUID,
+  org_id UUID,
+  team_id UUID,
+  subject_id UUID,
+  number Int64,
+  branch String,
+  base_branch String,
+  merged_at DateTime
+)
+ENGINE = ReplacingMergeTree()
+ORDER BY (subject_id, id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {table}_deployments_mv TO {table}_deployments AS
+SELECT
+  id,
+  org_id,
+  team_id,
+  subject_id,
+  toInt64OrZero(JSONExtractString(payload, 'number')) AS number,
+  JSONExtractString(payload, 'head_branch') AS branch,
+  JSONExtractString(payload, 'base_branch') AS base_branch,
+  timestamp AS merged_at
+FROM {table}
+WHERE scope = 'pr' AND action = 'closed' AND {repo_hooks} AND JSONExtractBool(payload, 'merged');
+
+-- the pipelines done, successful or failed. The ones on the trunk tell the failures of the deployments.
+CREATE TABLE IF NOT EXISTS {table}_pipelines (
+  id UUID,
+  org_id UUID,
+  team_id UUID,
+  subject_id UUID,
+  branch String,
+  sha String,
+  failed UInt8,
+  finished_at DateTime
+)
+ENGINE = ReplacingMergeTree()
+ORDER BY (subject_id, branch, finished_at, id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {table}_pipelines_mv TO {table}_pipelines AS
+SELECT
+  id,
+  org_id,
+  team_id,
+  subject_id,
+  replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+  JSONExtractString(payload, 'sha') AS sha,
+  action = 'failure' AS failed,
+  timestamp AS finished_at
+FROM {table}
+WHERE scope = 'pipeline' AND action IN ('completed', 'failure') AND {repo_hooks};
+
+INSERT INTO {table}_branches
+SELECT org_id, team_id, subject_id, branch, min(first_commit_at) AS first_commit_at
+FROM (
+  SELECT
+    org_id,
+    team_id,
+    subject_id,
+    replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+    arrayFilter(
+      t -> t > toDateTime(0),
+      arrayMap(c -> parseDateTimeBestEffortOrZero(JSONExtractString(c, 'timestamp')), JSONExtractArrayRaw(payload, 'commits'))
+    ) AS commits,
+    if(empty(commits), timestamp, least(timestamp, arrayMin(commits))) AS first_commit_at
+  FROM {table}
+  WHERE scope = 'push' AND {repo_hooks}
+)
+WHERE branch != ''
+GROUP BY org_id, team_id, subject_id, branch;
+
+INSERT INTO {table}_deployments
+SELECT
+  id,
+  org_id,
+  team_id,
+  subject_id,
+  toInt64OrZero(JSONExtractString(payload, 'number')) AS number,
+  JSONExtractString(payload, 'head_branch') AS branch,
+  JSONExtractString(payload, 'base_branch') AS base_branch,
+  timestamp AS merged_at
+FROM {table}
+WHERE scope = 'pr' AND action = 'closed' AND {repo_hooks} AND JSONExtractBool(payload, 'merged');
+
+INSERT INTO {table}_pipelines
+SELECT
+  id,
+  org_id,
+  team_id,
+  subject_id,
+  replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+  JSONExtractString(payload, 'sha') AS sha,
+  action = 'failure' AS failed,
+  timestamp AS finished_at
+FROM {table}
+WHERE scope = 'pipeline' AND action IN ('completed', 'failure') AND {repo_hooks};
@@ -0,0 +1,54 @@
+DROP VIEW IF EXISTS {table}_branch_resets_mv;
+DROP VIEW IF EXISTS {table}_branch_pushes_mv;
+
+DROP TABLE IF EXISTS {table}_branch_resets;
+DROP TABLE IF EXISTS {table}_branch_pushes;
+
+-- the first commit pushed to each branch, where the lead time of its changes starts.
+CREATE TABLE IF NOT EXISTS {table}_branches (
+  org_id UUID,
+  team_id UUID,
+  subject_id UUID,
+  branch String,
+  first_commit_at SimpleAggregateFunction(min, DateTime)
+)
+ENGINE = AggregatingMergeTree()
+ORDER BY (subject_id, branch);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {table}_branches_mv TO {table}_branches AS
+SELECT org_id, team_id, subject_id, branch, min(first_commit_at) AS first_commit_at
+FROM (
+  SELECT
+    org_id,
+    team_id,
+    subject_id,
+    replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+    arrayFilter(
+      t -> t > toDateTime(0),
+      arrayMap(c -> parseDateTimeBestEffortOrZero(JSONExtractString(c, 'timestamp')), JSONExtractArrayRaw(payload, 'commits'))
+    ) AS commits,
+    if(empty(commits), timestamp, least(timestamp, arrayMin(commits))) AS first_commit_at
+  FROM {table}
+  WHERE scope = 'push' AND {repo_hooks}
+)
+WHERE branch != ''
+GROUP BY org_id, team_id, subject_id, branch;
+
+INSERT INTO {table}_branches
+SELECT org_id, team_id, subject_id, branch, min(first_commit_at) AS first_commit_at
+FROM (
+  SELECT
+    org_id,
+    team_id,
+    subject_id,
+    replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+    arrayFilter(
+      t -> t > toDateTime(0),
+      arrayMap(c -> parseDateTimeBestEffortOrZero(JSONExtractString(c, 'timestamp')), JSONExtractArrayRaw(payload, 'commits'))
+    ) AS commits,
+    if(empty(commits), timestamp, least(timestamp, arrayMin(commits))) AS first_commit_at
+  FROM {table}
+  WHERE scope = 'push' AND {repo_hooks}
+)
+WHERE branch != ''
+GROUP BY org_id, team_id, subject_id, branch;
@@ -0,0 +1,93 @@
+-- the first commit of a branch was kept for all the time of its name, so a branch deleted and created again, or merged
+-- again, carried the commits of the earlier ones into the lead time. The pushes are kept one by one instead, along with
+-- the creations and the deletions of the branches, so that the lead time of a deployment only counts the pushes since
+-- the branch was last created, deleted or merged.
+DROP VIEW IF EXISTS {table}_branches_mv;
+DROP TABLE IF EXISTS {table}_branches;
+
+-- the pushes to each branch, with their first commit, where the lead time of their changes starts.
+CREATE TABLE IF NOT EXISTS {table}_branch_pushes (
+  id UUID,
+  org_id UUID,
+  team_id UUID,
+  subject_id UUID,
+  branch String,
+  pushed_at DateTime,
+  first_commit_at DateTime
+)
+ENGINE = ReplacingMergeTree()
+ORDER BY (subject_id, branch, pushed_at, id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {table}_branch_pushes_mv TO {table}_branch_pushes AS
+SELECT id, org_id, team_id, subject_id, branch, pushed_at, first_commit_at
+FROM (
+  SELECT
+    id,
+    org_id,
+    team_id,
+    subject_id,
+    replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+    timestamp AS pushed_at,
+    arrayFilter(
+      t -> t > toDateTime(0),
+      arrayMap(c -> parseDateTimeBestEffortOrZero(JSONExtractString(c, 'timestamp')), JSONExtractArrayRaw(payload, 'commits'))
+    ) AS commits,
+    if(empty(commits), timestamp, least(timestamp, arrayMin(commits))) AS first_commit_at
+  FROM {table}
+  WHERE scope = 'push' AND {repo_hooks}
+)
+WHERE branch != '';
+
+-- the creations and the deletions of the branches, where a new lifecycle of the name of a branch starts.
+CREATE TABLE IF NOT EXISTS {table}_branch_resets (
+  id UUID,
+  org_id UUID,
+  team_id UUID,
+  subject_id UUID,
+  branch String,
+  reset_at DateTime
+)
+ENGINE = ReplacingMergeTree()
+ORDER BY (subject_id, branch, reset_at, id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS {table}_branch_resets_mv TO {table}_branch_resets AS
+SELECT
+  id,
+  org_id,
+  team_id,
+  subject_id,
+  replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+  timestamp AS reset_at
+FROM {table}
+WHERE scope = 'branch' AND action IN ('created', 'deleted') AND {repo_hooks};
+
+INSERT INTO {table}_branch_pushes
+SELECT id, org_id, team_id, subject_id, branch, pushed_at, first_commit_at
+FROM (
+  SELECT
+    id,
+    org_id,
+    team_id,
+    subject_id,
+    replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+    timestamp AS pushed_at,
+    arrayFilter(
+      t -> t > toDateTime(0),
+      arrayMap(c -> parseDateTimeBestEffortOrZero(JSONExtractString(c, 'timestamp')), JSONExtractArrayRaw(payload, 'commits'))
+    ) AS commits,
+    if(empty(commits), timestamp, least(timestamp, arrayMin(commits))) AS first_commit_at
+  FROM {table}
+  WHERE scope = 'push' AND {repo_hooks}
+)
+WHERE branch != '';
+
+INSERT INTO {table}_branch_resets
+SELECT
+  id,
+  org_id,
+  team_id,
+  subject_id,
+  replaceRegexpOne(JSONExtractString(payload, 'ref'), '^refs/heads/', '') AS branch,
+  timestamp AS reset_at
+FROM {table}
+WHERE scope = 'branch' AND action IN ('created', 'deleted') AND {repo_hooks};
@@ -0,0 +1,275 @@
+// Package migrations evolves the schema of the clickhouse tables of the orgs. Each kind of table, e.g. events, has its
+// own versions under clickhouse/<kind>, named like the postgres migrations, i.e. 000001_create.up.sql and
+// 000001_create.down.sql. A file may hold several statements, each ending with a semicolon at the end of a line. The
+// statements are formatted with the name of the table of the org in place of {table}, and with events.RepoHookFilter in
+// place of {repo_hooks}.
+//
+// The version of each table is tracked on its own, so that the tables of the orgs created later start at the first
+// version and catch up, while the existing ones only run the newer versions.
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
+
+	"go.breu.io/quantm/internal/events"
+)
+
+const (
+	KindEvents = "events" // KindEvents are the events tables, events_<slug>.
+)
+
+const (
+	CommandUp     Command = "up"     // CommandUp migrates the tables to the latest version.
+	CommandDown   Command = "down"   // CommandDown reverts the latest version of the tables.
+	CommandStatus Command = "status" // CommandStatus reports the version of the tables.
+)
+
+const (
+	// statement__migrations__create creates the table recording the versions applied to each table of the orgs.
+	statement__migrations__create = `
+CREATE TABLE IF NOT EXISTS pulse_migrations (
+  name String,
+  version UInt32,
+  applied_at DateTime
+)
+ENGINE = ReplacingMergeTree(applied_at)
+ORDER BY (name, version);
+`
+
+	statement__migrations__version = `SELECT max(version) FROM pulse_migrations WHERE name = ?`
+
+	statement__migrations__record = `INSERT INTO pulse_migrations (name, version, applied_at) VALUES (?, ?, ?)`
+
+	statement__migrations__forget = `DELETE FROM pulse_migrations WHERE name = ? AND version = ?`
+)
+
+type (
+	// Command is the migration to run on the tables.
+	Command string
+
+	// Status is the version of a table, against the latest version of its kind.
+	Status struct {
+		Table   string `json:"table"`
+		Version uint32 `json:"version"`
+		Latest  uint32 `json:"latest"`
+	}
+
+	// migration is a version of the schema of a kind of table. The statements must be safe to run again, since the
+	// version is recorded once the statement is done.
+	migration struct {
+		version uint32
+		up      string
+		down    string
+	}
+)
+
+var (
+	//go:embed clickhouse/*/*.sql
+	sql embed.FS
+
+	split     = regexp.MustCompile(`;[ \t]*(\n|$)`) // a statement ends with a semicolon at the end of a line.
+	uncomment = regexp.MustCompile(`(?m)^\s*--.*$`)
+)
+
+// Pending reports whether the table is behind the latest version of its kind.
+func (s *Status) Pending() bool {
+	return s.Version < s.Latest
+}
+
+// Run runs the command on the table of the given kind, and returns its status once done.
+func Run(ctx context.Context, conn driver.Conn, command Command, kind, table string) (*Status, error) {
+	switch command {
+	case CommandUp:
+		if err := Up(ctx, conn, kind, table); err != nil {
+			return nil, err
+		}
+	case CommandDown:
+		if err := Down(ctx, conn, kind, table); err != nil {
+			return nil, err
+		}
+	case CommandStatus:
+	default:
+		return nil, fmt.Errorf("migrations: unknown command %q", command)
+	}
+
+	return Version(ctx, conn, kind, table)
+}
+
+// Up migrates the table to the latest version of its kind.
+func Up(ctx context.Context, conn driver.Conn, kind, table string) error {
+	migrations, err := read(kind)
+	if err != nil {
+		return err
+	}
+
+	current, err := version(ctx, conn, table)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+
+		slog.Info("migrations: migrating ...", "table", table, "version", m.version)
+
+		if err := exec(ctx, conn, m.up, table); err != nil {
+			return fmt.Errorf("migrations: unable to migrate %s to version %d: %w", table, m.version, err)
+		}
+
+		if err := conn.Exec(ctx, statement__migrations__record, table, m.version, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Down reverts the latest version applied to the table. Reverting the first version drops the table.
+func Down(ctx context.Context, conn driver.Conn, kind, table string) error {
+	migrations, err := read(kind)
+	if err != nil {
+		return err
+	}
+
+	current, err := version(ctx, conn, table)
+	if err != nil {
+		return err
+	}
+
+	if current == 0 {
+		slog.Info("migrations: nothing to revert ...", "table", table)
+		return nil
+	}
+
+	idx := slices.IndexFunc(migrations, func(m migration) bool { return m.version == current })
+	if idx < 0 {
+		return fmt.Errorf("migrations: unknown version %d of %s", current, table)
+	}
+
+	slog.Info("migrations: reverting ...", "table", table, "version", current)
+
+	if err := exec(ctx, conn, migrations[idx].down, table); err != nil {
+		return fmt.Errorf("migrations: unable to revert %s from version %d: %w", table, current, err)
+	}
+
+	return conn.Exec(ctx, statement__migrations__forget, table, current)
+}
+
+// Version returns the version of the table, against the latest version of its kind.
+func Version(ctx context.Context, conn driver.Conn, kind, table string) (*Status, error) {
+	migrations, err := read(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := version(ctx, conn, table)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{Table: table, Version: current}
+	if len(migrations) > 0 {
+		status.Latest = migrations[len(migrations)-1].version
+	}
+
+	return status, nil
+}
+
+// version returns the latest version applied to the table, 0 if none.
+func version(ctx context.Context, conn driver.Conn, table string) (uint32, error) {
+	if err := conn.Exec(ctx, statement__migrations__create); err != nil {
+		return 0, err
+	}
+
+	var current uint32
+	if err := conn.QueryRow(ctx, statement__migrations__version, table).Scan(&current); err != nil {
+		return 0, err
+	}
+
+	return current, nil
+}
+
+// read returns the migrations of the kind, ordered by version.
+func read(kind string) ([]migration, error) {
+	dir := path.Join("clickhouse", kind)
+
+	entries, err := fs.ReadDir(sql, dir)
+	if err != nil {
+		return nil, fmt.Errorf("migrations: unknown kind %q: %w", kind, err)
+	}
+
+	versions := make(map[uint32]*migration)
+
+	for _, entry := range entries {
+		number, rest, _ := strings.Cut(entry.Name(), "_")
+
+		v, err := strconv.ParseUint(number, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("migrations: invalid name %s: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(sql, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := versions[uint32(v)]
+		if !ok {
+			m = &migration{version: uint32(v)}
+			versions[uint32(v)] = m
+		}
+
+		switch {
+		case strings.HasSuffix(rest, ".up.sql"):
+			m.up = string(content)
+		case strings.HasSuffix(rest, ".down.sql"):
+			m.down = string(content)
+		default:
+			return nil, fmt.Errorf("migrations: invalid name %s", entry.Name())
+		}
+	}
+
+	migrations := make([]migration, 0, len(versions))
+	for _, m := range versions {
+		if m.up == "" || m.down == "" {
+			return nil, fmt.Errorf("migrations: version %d of %s must have both up and down", m.version, kind)
+		}
+
+		migrations = append(migrations, *m)
+	}
+
+	slices.SortFunc(migrations, func(a, b migration) int { return int(a.version) - int(b.version) })
+
+	return migrations, nil
+}
+
+// exec runs the statements of the file one by one, with the name of the table in place of {table}.
+func exec(ctx context.Context, conn driver.Conn, content, table string) error {
+	content = strings.NewReplacer("{table}", table, "{repo_hooks}", events.RepoHookFilter).Replace(content)
+
+	for _, statement := range split.Split(content, -1) {
+		if strings.TrimSpace(uncomment.ReplaceAllString(statement, "")) == "" {
+			continue
+		}
+
+		if err := conn.Exec(ctx, statement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
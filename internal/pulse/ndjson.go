@@ -0,0 +1,246 @@
+package pulse
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/pulse/migrations"
+)
+
+type (
+	// ndjson writes the events of each org to its own file in dir, events_<slug>.ndjson, one json per line. The reports
+	// scan the whole file, which is fine for local development and the tests, and nothing else.
+	ndjson struct {
+		dir string
+		mu  sync.Mutex
+	}
+)
+
+func (s *ndjson) Provision(_ context.Context, slug string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path(slug), os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+func (s *ndjson) Migrate(_ context.Context, _ migrations.Command, _ string) (*migrations.Status, error) {
+	return nil, nil
+}
+
+func (s *ndjson) Write(_ context.Context, slug string, rows []*Row) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path(slug), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	buf := bufio.NewWriter(file)
+	encoder := json.NewEncoder(buf)
+
+	for _, r := range rows {
+		if err := encoder.Encode(r); err != nil {
+			_ = file.Close()
+			return err
+		}
+	}
+
+	return errors.Join(buf.Flush(), file.Close())
+}
+
+func (s *ndjson) Report(
+	_ context.Context, slug string, by ReportBy, id uuid.UUID, from, to time.Time,
+) (*ReportCounts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := &ReportCounts{}
+
+	rows, err := s.read(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := make(map[uuid.UUID]map[int64]*ReportPR)
+
+	for _, r := range rows {
+		match := r.SubjectID
+
+		switch by {
+		case ReportByTeam:
+			match = r.TeamID
+		case ReportByOrg:
+			match = r.OrgID
+		}
+
+		if match != id || r.Timestamp.Before(from) || !r.Timestamp.Before(to) {
+			continue
+		}
+
+		scope, action := events.Scope(r.Scope), events.Action(r.Action)
+
+		switch {
+		case scope == events.ScopePr && action == events.ActionClosed && events.IsRepoHook(r.Hook) && r.Merged():
+			if merged[r.SubjectID] == nil {
+				merged[r.SubjectID] = make(map[int64]*ReportPR)
+			}
+
+			if pr, ok := merged[r.SubjectID][r.PRNumber()]; !ok || r.Timestamp.Before(pr.MergedAt) {
+				merged[r.SubjectID][r.PRNumber()] = &ReportPR{
+					SubjectID: r.SubjectID, Number: r.PRNumber(), Branch: r.Branch(), MergedAt: r.Timestamp,
+				}
+			}
+		case scope == events.ScopeMerge && action == events.ActionFailure:
+			counts.ConflictsDetected++
+		case scope == events.ScopeMerge && action == events.ActionCompleted:
+			counts.ConflictsResolved++
+		case scope == events.ScopeDrift && action == events.ActionRequested:
+			counts.StaleBranches++
+		}
+	}
+
+	prs := make([]*ReportPR, 0)
+
+	for _, numbers := range merged {
+		for _, pr := range numbers {
+			report_pr(pr, rows)
+			prs = append(prs, pr)
+		}
+	}
+
+	slices.SortFunc(prs, func(a, b *ReportPR) int { return a.MergedAt.Compare(b.MergedAt) })
+	counts.Summarize(prs)
+
+	return counts, nil
+}
+
+// report_pr sets the time the pull request was opened at and last queued at before its merge, and the lines of the
+// latest diff of its branch, as the report statement of clickhouse.
+func report_pr(pr *ReportPR, rows []*Row) {
+	var diffed time.Time
+
+	for _, r := range rows {
+		if r.SubjectID != pr.SubjectID || r.Branch() != pr.Branch {
+			continue
+		}
+
+		scope, action := events.Scope(r.Scope), events.Action(r.Action)
+
+		switch {
+		case scope == events.ScopePr && action == events.ActionOpened && r.PRNumber() == pr.Number:
+			if pr.OpenedAt.IsZero() || r.Timestamp.Before(pr.OpenedAt) {
+				pr.OpenedAt = r.Timestamp
+			}
+		case r.Timestamp.After(pr.MergedAt):
+			continue
+		case scope == events.ScopeMergeQueue && action == events.EventActionAdded && r.PRNumber() == pr.Number:
+			if r.Timestamp.After(pr.QueuedAt) {
+				pr.QueuedAt = r.Timestamp
+			}
+		case scope == events.ScopeDiff && !r.Timestamp.Before(diffed):
+			diffed = r.Timestamp
+			pr.Lines = r.Lines()
+		}
+	}
+}
+
+func (s *ndjson) Lineage(_ context.Context, slug string, query *LineageQuery) ([]*Row, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows, err := s.read(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := make(map[uuid.UUID]bool)
+
+	for _, r := range rows {
+		if query.Match(r) {
+			roots[r.Root()] = true
+		}
+	}
+
+	result := make([]*Row, 0)
+
+	for _, r := range rows {
+		if roots[r.ID] || slices.ContainsFunc(r.Parents, func(id uuid.UUID) bool { return roots[id] }) {
+			result = append(result, r)
+		}
+
+		if len(result) == LineageLimit {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (s *ndjson) Events(_ context.Context, slug string, query *EventsQuery) ([]*Row, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rows, err := s.read(slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return query.Select(rows), nil
+}
+
+// read returns the events of the org, none if the file is missing.
+func (s *ndjson) read(slug string) ([]*Row, error) {
+	rows := make([]*Row, 0)
+
+	file, err := os.Open(s.path(slug))
+	if errors.Is(err, os.ErrNotExist) {
+		return rows, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	decoder := json.NewDecoder(file)
+
+	for decoder.More() {
+		r := &Row{}
+		if err := decoder.Decode(r); err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, r)
+	}
+
+	return rows, nil
+}
+
+func (s *ndjson) path(slug string) string {
+	return filepath.Join(s.dir, table_name("events", slug)+".ndjson")
+}
@@ -0,0 +1,202 @@
+package pulse_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/pulse"
+)
+
+// TestMain configures pulse, once for all the tests, to write to ndjson files in a temporary directory.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "pulse")
+	if err != nil {
+		panic(err)
+	}
+
+	cfg := pulse.DefaultConfig
+	cfg.Sink = pulse.SinkNDJSON
+	cfg.Dir = dir
+	cfg.Spool = filepath.Join(dir, "spool", "pulse.spool")
+
+	pulse.Get(pulse.WithConfig(&cfg))
+
+	code := m.Run()
+
+	_ = os.RemoveAll(dir)
+
+	os.Exit(code)
+}
+
+func TestNDJSONSink(t *testing.T) {
+	ctx := context.Background()
+	sink := pulse.GetSink()
+	repo, team := uuid.New(), uuid.New()
+	at := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, sink.Provision(ctx, "breu-io"))
+	require.NoError(t, sink.Write(ctx, "breu-io", []*pulse.Row{
+		{
+			ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "pr", Action: "opened", Hook: 1001, Timestamp: at.Add(-4 * time.Hour),
+			Payload: []byte(`{"number":"7","head_branch":"feat"}`),
+		},
+		{
+			ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "diff", Action: "created", Hook: 1001, Timestamp: at.Add(-3 * time.Hour),
+			Payload: []byte(`{"branch":"feat","lines":{"added":40,"removed":2}}`),
+		},
+		{
+			ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "merge_queue", Action: "added", Hook: 1001,
+			Timestamp: at.Add(-time.Hour), Payload: []byte(`{"number":"7","branch":"feat"}`),
+		},
+		{
+			ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "pr", Action: "closed", Hook: 2001, Timestamp: at,
+			Payload: []byte(`{"number":"7","head_branch":"feat","merged":true}`),
+		},
+		{
+			ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "pr", Action: "closed", Hook: 1001, Timestamp: at,
+			Payload: []byte(`{"number":"7","head_branch":"feat","merged":true}`),
+		},
+		{
+			ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "pr", Action: "closed", Hook: 1001, Timestamp: at,
+			Payload: []byte(`{"number":"8","head_branch":"abandoned"}`),
+		},
+		{ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "merge", Action: "failure", Timestamp: at},
+		{ID: uuid.New(), SubjectID: uuid.New(), TeamID: team, Scope: "drift", Action: "requested", Timestamp: at},
+		{ID: uuid.New(), SubjectID: repo, TeamID: team, Scope: "merge", Action: "completed", Timestamp: at.Add(time.Hour)},
+	}))
+
+	merged := &pulse.ReportPR{
+		SubjectID: repo,
+		Number:    7,
+		Branch:    "feat",
+		OpenedAt:  at.Add(-4 * time.Hour),
+		QueuedAt:  at.Add(-time.Hour),
+		MergedAt:  at,
+		Lines:     42,
+	}
+
+	counts, err := sink.Report(ctx, "breu-io", pulse.ReportByRepo, repo, at, at.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, &pulse.ReportCounts{
+		MergedPRs:         1,
+		CycleTime:         4 * time.Hour,
+		QueueWait:         time.Hour,
+		ConflictsDetected: 1,
+		Largest:           []*pulse.ReportPR{merged},
+	}, counts)
+
+	counts, err = sink.Report(ctx, "breu-io", pulse.ReportByTeam, team, at, at.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, &pulse.ReportCounts{
+		MergedPRs:         1,
+		CycleTime:         4 * time.Hour,
+		QueueWait:         time.Hour,
+		ConflictsDetected: 1,
+		ConflictsResolved: 1,
+		StaleBranches:     1,
+		Largest:           []*pulse.ReportPR{merged},
+	}, counts)
+
+	status, err := sink.Migrate(ctx, "status", "breu-io")
+	require.NoError(t, err)
+	assert.Nil(t, status)
+}
+
+func TestNDJSONLineage(t *testing.T) {
+	ctx := context.Background()
+	repo := uuid.New()
+	at := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	push, diff, conflict, notify, other := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	require.NoError(t, pulse.GetSink().Write(ctx, "quantm", []*pulse.Row{
+		{ID: push, SubjectID: repo, Hook: 1001, Scope: "push", Action: "created", Timestamp: at},
+		{
+			ID: diff, Parents: []uuid.UUID{push}, SubjectID: repo, Hook: 1001, Scope: "diff", Action: "created",
+			Timestamp: at.Add(time.Second), Payload: []byte(`{"branch":"feat"}`),
+		},
+		{
+			ID: conflict, Parents: []uuid.UUID{push, diff}, SubjectID: repo, Hook: 1001, Scope: "merge", Action: "failure",
+			Timestamp: at.Add(3 * time.Second), Payload: []byte(`{"head_branch":"feat","number":"7"}`),
+		},
+		{
+			// the rebase request between the conflict and the notification is not persisted.
+			ID: notify, Parents: []uuid.UUID{push, diff, conflict, uuid.New()}, SubjectID: repo, Hook: 2001,
+			Scope: "merge", Action: "failure", Timestamp: at.Add(4 * time.Second),
+		},
+		{ID: other, SubjectID: repo, Hook: 1001, Scope: "push", Action: "created", Timestamp: at},
+	}))
+
+	for _, query := range []*pulse.LineageQuery{
+		{EventID: notify},
+		{RepoID: repo, Branch: "feat"},
+		{RepoID: repo, PRNumber: 7},
+	} {
+		lineage, err := pulse.TraceLineage(ctx, "quantm", query)
+		require.NoError(t, err)
+		require.NotNil(t, lineage)
+
+		assert.Equal(t, []uuid.UUID{push}, lineage.Roots)
+		require.Len(t, lineage.Hops, 4)
+
+		assert.Nil(t, lineage.Hops[0].Duration, "root")
+		assert.Equal(t, diff, lineage.Hops[2].Parent)
+		assert.Equal(t, 2*time.Second, *lineage.Hops[2].Duration)
+		assert.Equal(t, conflict, lineage.Hops[3].Parent, "closest persisted ancestor")
+		assert.Equal(t, 4*time.Second, lineage.Hops[3].Elapsed)
+	}
+
+	lineage, err := pulse.TraceLineage(ctx, "quantm", &pulse.LineageQuery{RepoID: repo, Branch: "main"})
+	require.NoError(t, err)
+	assert.Nil(t, lineage)
+}
+
+func TestNDJSONEvents(t *testing.T) {
+	ctx := context.Background()
+	sink := pulse.GetSink()
+	repo := uuid.New()
+	at := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	push, rebase, derived, pr := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	require.NoError(t, sink.Provision(ctx, "events"))
+	require.NoError(t, sink.Write(ctx, "events", []*pulse.Row{
+		{ID: pr, SubjectID: repo, Scope: "pr", Timestamp: at.Add(time.Minute)},
+		{ID: push, SubjectID: repo, Scope: "push", Timestamp: at},
+		{ID: rebase, Parents: []uuid.UUID{push}, SubjectID: repo, Scope: "rebase", Timestamp: at.Add(2 * time.Minute)},
+		{ID: derived, Parents: []uuid.UUID{push, rebase}, SubjectID: repo, Scope: "push", Timestamp: at.Add(3 * time.Minute)},
+		{ID: uuid.New(), SubjectID: uuid.New(), Scope: "push", Timestamp: at},
+		{ID: uuid.New(), SubjectID: repo, Scope: "push", Timestamp: at.Add(time.Hour)},
+	}))
+
+	query := &pulse.EventsQuery{
+		RepoID:  repo,
+		From:    at,
+		To:      at.Add(time.Hour),
+		Scopes:  []string{"push", "pr"},
+		Derived: []string{"rebase"},
+		Limit:   10,
+	}
+
+	rows, err := pulse.RepoEvents(ctx, "events", query)
+	require.NoError(t, err)
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, r := range rows {
+		ids = append(ids, r.ID)
+	}
+
+	assert.Equal(t, []uuid.UUID{push, pr}, ids)
+
+	query.Offset = 1
+
+	rows, err = pulse.RepoEvents(ctx, "events", query)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, pr, rows[0].ID)
+}
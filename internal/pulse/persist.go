@@ -2,34 +2,20 @@ package pulse
 
 import (
 	"context"
-	"fmt"
 
 	"go.breu.io/durex/dispatch"
 	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"go.breu.io/quantm/internal/db"
 	"go.breu.io/quantm/internal/events"
 	eventsv1 "go.breu.io/quantm/internal/proto/ctrlplane/events/v1"
 )
 
-const (
-	statement__events__persist = `
-INSERT INTO %s (
-	version,
-	id,
-	parents,
-	hook,
-	scope,
-	action,
-	source,
-	subject_id,
-	subject_name,
-	user_id,
-	team_id,
-	org_id,
-	timestamp
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-`
+var (
+	// marshaler renders the payload with the field names of the proto, the ones the materialized columns extract.
+	marshaler = protojson.MarshalOptions{UseProtoNames: true}
 )
 
 // Persist persists an event to clickhouse, routing it to the appropriate activity handler based on the
@@ -38,6 +24,13 @@ func Persist[H events.Hook, P events.Payload](ctx workflow.Context, event *event
 	ctx = dispatch.WithDefaultActivityContext(ctx)
 	flat := event.Flatten()
 
+	payload, err := marshaler.Marshal(any(event.Payload).(proto.Message))
+	if err != nil {
+		return err
+	}
+
+	flat.Payload = payload
+
 	var future workflow.Future
 
 	switch any(flat.Hook).(type) {
@@ -50,64 +43,22 @@ func Persist[H events.Hook, P events.Payload](ctx workflow.Context, event *event
 	return future.Get(ctx, nil)
 }
 
-// PersistRepoEvent persists a repo event to the database.
+// PersistRepoEvent persists a repo event to the database, in the next batch written by the worker.
 func PersistRepoEvent(ctx context.Context, flat events.Flat[eventsv1.RepoHook]) error {
 	slug, err := db.Queries().GetOrgSlugByID(ctx, flat.OrgID)
 	if err != nil {
 		return nil
 	}
 
-	table := table_name("events", slug)
-	stmt := fmt.Sprintf(statement__events__persist, table)
-
-	return Get().
-		Connection().
-		Exec(
-			ctx,
-			stmt,
-			flat.Version,
-			flat.ID,
-			flat.Parents,
-			flat.Hook.Number(),
-			flat.Scope,
-			flat.Action,
-			flat.Source,
-			flat.SubjectID,
-			flat.SubjectName,
-			flat.UserID,
-			flat.TeamID,
-			flat.OrgID,
-			flat.Timestamp,
-		)
+	return Writer().write(to_row(slug, int32(flat.Hook.Number()), flat))
 }
 
-// PersistChatEvent persists a chat event to the database.
+// PersistChatEvent persists a chat event to the database, in the next batch written by the worker.
 func PersistChatEvent(ctx context.Context, flat events.Flat[eventsv1.ChatHook]) error {
 	slug, err := db.Queries().GetOrgSlugByID(ctx, flat.OrgID)
 	if err != nil {
 		return nil
 	}
 
-	table := table_name("events", slug)
-	stmt := fmt.Sprintf(statement__events__persist, table)
-
-	return Get().
-		Connection().
-		Exec(
-			ctx,
-			stmt,
-			flat.Version,
-			flat.ID,
-			flat.Parents,
-			flat.Hook.Number(),
-			flat.Scope,
-			flat.Action,
-			flat.Source,
-			flat.SubjectID,
-			flat.SubjectName,
-			flat.UserID,
-			flat.TeamID,
-			flat.OrgID,
-			flat.Timestamp,
-		)
+	return Writer().write(to_row(slug, int32(flat.Hook.Number()), flat))
 }
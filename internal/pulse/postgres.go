@@ -0,0 +1,157 @@
+package pulse
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/db"
+	"go.breu.io/quantm/internal/db/entities"
+	"go.breu.io/quantm/internal/events"
+	"go.breu.io/quantm/internal/pulse/migrations"
+)
+
+type (
+	// postgres writes the events of all the orgs to the pulse_events table, created by the migrations of the database.
+	// Unlike clickhouse, the branch and the pull request number of the events are read from their payload, without an
+	// index, so tracing a lineage or replaying a repo scans its events.
+	postgres struct{}
+)
+
+func (s *postgres) Provision(_ context.Context, _ string) error {
+	return nil
+}
+
+func (s *postgres) Migrate(_ context.Context, _ migrations.Command, _ string) (*migrations.Status, error) {
+	return nil, nil
+}
+
+// Write writes the batch in a single statement. An event written before, e.g. by a batch replayed from the spool, is
+// skipped.
+func (s *postgres) Write(ctx context.Context, _ string, rows []*Row) error {
+	batch := make([]*Row, len(rows))
+
+	for idx, r := range rows {
+		batch[idx] = r
+
+		if len(r.Payload) == 0 {
+			clone := *r
+			clone.Payload = json.RawMessage("{}")
+			batch[idx] = &clone
+		}
+	}
+
+	encoded, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	return db.Queries().CreatePulseEvents(ctx, encoded)
+}
+
+func (s *postgres) Report(
+	ctx context.Context, _ string, by ReportBy, id uuid.UUID, from, to time.Time,
+) (*ReportCounts, error) {
+	params := entities.CountPulseEventsForReportParams{By: string(by), ID: id, FromAt: from, ToAt: to}
+
+	row, err := db.Queries().CountPulseEventsForReport(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := db.Queries().ListPulsePullRequestsForReport(ctx, entities.ListPulsePullRequestsForReportParams{
+		By:          string(by),
+		ID:          id,
+		FromAt:      from,
+		ToAt:        to,
+		RepoHookMin: events.RepoHookMin,
+		RepoHookMax: events.RepoHookMax,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*ReportPR, len(merged))
+
+	for idx, pr := range merged {
+		prs[idx] = &ReportPR{
+			SubjectID: pr.SubjectID,
+			Number:    pr.Number,
+			Branch:    pr.Branch,
+			OpenedAt:  unset_epoch(pr.OpenedAt),
+			QueuedAt:  unset_epoch(pr.QueuedAt),
+			MergedAt:  pr.MergedAt,
+			Lines:     pr.Lines,
+		}
+	}
+
+	counts := &ReportCounts{
+		ConflictsDetected: uint64(row.ConflictsDetected),
+		ConflictsResolved: uint64(row.ConflictsResolved),
+		StaleBranches:     uint64(row.StaleBranches),
+	}
+	counts.Summarize(prs)
+
+	return counts, nil
+}
+
+func (s *postgres) Events(ctx context.Context, slug string, query *EventsQuery) ([]*Row, error) {
+	events, err := db.Queries().ListPulseEventsForRepo(ctx, entities.ListPulseEventsForRepoParams{
+		RepoID:    query.RepoID,
+		FromAt:    query.From,
+		ToAt:      query.To,
+		Scopes:    query.Scopes,
+		Derived:   query.Derived,
+		RowOffset: int32(query.Offset), // nolint: gosec
+		RowLimit:  int32(query.Limit),  // nolint: gosec
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return from_pulse_events(events, slug), nil
+}
+
+func (s *postgres) Lineage(ctx context.Context, slug string, query *LineageQuery) ([]*Row, error) {
+	events, err := db.Queries().ListPulseEventsLineage(ctx, entities.ListPulseEventsLineageParams{
+		EventID:  query.EventID,
+		RepoID:   query.RepoID,
+		PrNumber: query.PRNumber,
+		Branch:   query.Branch,
+		RowLimit: LineageLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return from_pulse_events(events, slug), nil
+}
+
+// from_pulse_events returns the rows of the events of the org read from the pulse_events table.
+func from_pulse_events(events []entities.PulseEvent, slug string) []*Row {
+	rows := make([]*Row, len(events))
+
+	for idx, e := range events {
+		rows[idx] = &Row{
+			Slug:        slug,
+			Version:     e.Version,
+			ID:          e.ID,
+			Parents:     e.Parents,
+			Hook:        e.Hook,
+			Scope:       e.Scope,
+			Action:      e.Action,
+			Source:      e.Source,
+			SubjectID:   e.SubjectID,
+			SubjectName: e.SubjectName,
+			UserID:      e.UserID,
+			TeamID:      e.TeamID,
+			OrgID:       e.OrgID,
+			Timestamp:   e.Timestamp,
+			Payload:     json.RawMessage(e.Payload),
+		}
+	}
+
+	return rows
+}
@@ -0,0 +1,133 @@
+package pulse
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ReportByRepo ReportBy = "subject_id" // reports the events of a repo.
+	ReportByTeam ReportBy = "team_id"    // reports the events of the repos of a team.
+)
+
+const (
+	ReportLargest = 3 // ReportLargest is the number of the largest pull requests of a report.
+)
+
+type (
+	// ReportBy is the column the events of a report are filtered on.
+	ReportBy string
+
+	// ReportCounts are the counts of the events of a repo or a team over a period. The median times are zero when no
+	// pull request tells them.
+	ReportCounts struct {
+		MergedPRs         uint64        `json:"merged_prs"`
+		CycleTime         time.Duration `json:"cycle_time"`
+		QueueWait         time.Duration `json:"queue_wait"`
+		ConflictsDetected uint64        `json:"conflicts_detected"`
+		ConflictsResolved uint64        `json:"conflicts_resolved"`
+		StaleBranches     uint64        `json:"stale_branches"`
+		Largest           []*ReportPR   `json:"largest"`
+	}
+
+	// ReportPR is a pull request merged over the period of a report. OpenedAt and QueuedAt are zero when the events do
+	// not tell them, e.g. the pull request was opened before quantm was installed, or was never queued. Lines are the
+	// lines changed by the latest diff of the branch before the merge.
+	ReportPR struct {
+		SubjectID uuid.UUID `json:"subject_id"`
+		Number    int64     `json:"number"`
+		Branch    string    `json:"branch"`
+		OpenedAt  time.Time `json:"opened_at"`
+		QueuedAt  time.Time `json:"queued_at"`
+		MergedAt  time.Time `json:"merged_at"`
+		Lines     int64     `json:"lines"`
+	}
+)
+
+// Summarize sets the number of the pull requests merged, the median time from their opening and from their queueing to
+// their merge, and the largest of them.
+func (c *ReportCounts) Summarize(prs []*ReportPR) {
+	cycle := make([]time.Duration, 0, len(prs))
+	wait := make([]time.Duration, 0, len(prs))
+
+	for _, pr := range prs {
+		if !pr.OpenedAt.IsZero() && !pr.OpenedAt.After(pr.MergedAt) {
+			cycle = append(cycle, pr.MergedAt.Sub(pr.OpenedAt))
+		}
+
+		if !pr.QueuedAt.IsZero() && !pr.QueuedAt.After(pr.MergedAt) {
+			wait = append(wait, pr.MergedAt.Sub(pr.QueuedAt))
+		}
+	}
+
+	c.MergedPRs = uint64(len(prs))
+	c.CycleTime = median(cycle)
+	c.QueueWait = median(wait)
+
+	largest := slices.DeleteFunc(slices.Clone(prs), func(pr *ReportPR) bool { return pr.Lines <= 0 })
+	slices.SortStableFunc(largest, func(a, b *ReportPR) int {
+		if a.Lines != b.Lines {
+			return int(b.Lines - a.Lines)
+		}
+
+		return int(a.Number - b.Number)
+	})
+
+	c.Largest = largest[:min(len(largest), ReportLargest)]
+}
+
+// Merged reports whether the event is a pull request closed on merge.
+func (r *Row) Merged() bool {
+	payload := struct {
+		Merged bool `json:"merged"`
+	}{}
+
+	if err := json.Unmarshal(r.Payload, &payload); err != nil {
+		return false
+	}
+
+	return payload.Merged
+}
+
+// Lines returns the lines added and removed of a diff event, as the lines_added and lines_removed columns of the
+// events table.
+func (r *Row) Lines() int64 {
+	payload := struct {
+		Lines struct {
+			Added   int64 `json:"added"`
+			Removed int64 `json:"removed"`
+		} `json:"lines"`
+	}{}
+
+	if err := json.Unmarshal(r.Payload, &payload); err != nil {
+		return 0
+	}
+
+	return payload.Lines.Added + payload.Lines.Removed
+}
+
+// Report counts the events of the repo or the team with the given id in the events of the org, from the start of the
+// period, inclusive, to its end, exclusive.
+func Report(ctx context.Context, slug string, by ReportBy, id uuid.UUID, from, to time.Time) (*ReportCounts, error) {
+	return GetSink().Report(ctx, slug, by, id, from, to)
+}
+
+// median returns the median of the durations, zero if there are none.
+func median(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+
+	slices.Sort(durations)
+
+	mid := len(durations) / 2
+	if len(durations)%2 == 0 {
+		return (durations[mid-1] + durations[mid]) / 2
+	}
+
+	return durations[mid]
+}
@@ -0,0 +1,38 @@
+package pulse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.breu.io/quantm/internal/pulse"
+)
+
+func TestReportSummarize(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2024, 5, 2, 12, 0, 0, 0, time.UTC)
+	prs := []*pulse.ReportPR{
+		{Number: 1, MergedAt: at, OpenedAt: at.Add(-time.Hour), Lines: 10},
+		{Number: 2, MergedAt: at, OpenedAt: at.Add(-3 * time.Hour), QueuedAt: at.Add(-time.Minute), Lines: 300},
+		{Number: 3, MergedAt: at, Lines: 20},
+		{Number: 4, MergedAt: at, OpenedAt: at.Add(-2 * time.Hour), QueuedAt: at.Add(time.Minute)}, // queued after the merge.
+		{Number: 5, MergedAt: at, Lines: 20},
+	}
+
+	counts := &pulse.ReportCounts{}
+	counts.Summarize(prs)
+
+	assert.Equal(t, uint64(5), counts.MergedPRs)
+	assert.Equal(t, 2*time.Hour, counts.CycleTime)
+	assert.Equal(t, time.Minute, counts.QueueWait)
+	assert.Equal(t, []*pulse.ReportPR{prs[1], prs[2], prs[4]}, counts.Largest)
+
+	empty := &pulse.ReportCounts{}
+	empty.Summarize(nil)
+
+	assert.Zero(t, empty.MergedPRs)
+	assert.Zero(t, empty.CycleTime)
+	assert.Empty(t, empty.Largest)
+}
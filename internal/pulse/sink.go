@@ -0,0 +1,76 @@
+package pulse
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/pulse/config"
+	"go.breu.io/quantm/internal/pulse/migrations"
+)
+
+const (
+	SinkClickhouse = config.SinkClickhouse
+	SinkPostgres   = config.SinkPostgres
+	SinkNDJSON     = config.SinkNDJSON
+)
+
+type (
+	// Sink is the backend the events of the orgs are written to, and the reports are read from.
+	Sink interface {
+		// Provision prepares the sink for the events of the org, e.g. creates its table.
+		Provision(ctx context.Context, slug string) error
+
+		// Migrate runs the migration on the events of the org. A sink without a schema of its own returns no status.
+		Migrate(ctx context.Context, command migrations.Command, slug string) (*migrations.Status, error)
+
+		// Write writes a batch of events of the org.
+		Write(ctx context.Context, slug string, rows []*Row) error
+
+		// Report counts the events of the repo or the team over a period, from inclusive, to exclusive.
+		Report(ctx context.Context, slug string, by ReportBy, id uuid.UUID, from, to time.Time) (*ReportCounts, error)
+	}
+
+	// Row is an event as written to the sink, and to the spool of the batch writer.
+	Row struct {
+		Slug        string          `json:"slug"`
+		Version     string          `json:"version"`
+		ID          uuid.UUID       `json:"id"`
+		Parents     []uuid.UUID     `json:"parents"`
+		Hook        int32           `json:"hook"`
+		Scope       string          `json:"scope"`
+		Action      string          `json:"action"`
+		Source      string          `json:"source"`
+		SubjectID   uuid.UUID       `json:"subject_id"`
+		SubjectName string          `json:"subject_name"`
+		UserID      uuid.UUID       `json:"user_id"`
+		TeamID      uuid.UUID       `json:"team_id"`
+		OrgID       uuid.UUID       `json:"org_id"`
+		Timestamp   time.Time       `json:"timestamp"`
+		Payload     json.RawMessage `json:"payload"`
+	}
+)
+
+var (
+	_s    Sink
+	sonce sync.Once
+)
+
+// GetSink returns the sink selected by the configuration.
+func GetSink() Sink {
+	sonce.Do(func() {
+		switch Get().Sink {
+		case SinkPostgres:
+			_s = &postgres{}
+		case SinkNDJSON:
+			_s = &ndjson{dir: Get().Dir}
+		default:
+			_s = &clickhouse{}
+		}
+	})
+
+	return _s
+}
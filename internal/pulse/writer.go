@@ -0,0 +1,607 @@
+package pulse
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+
+	"go.breu.io/quantm/internal/events"
+)
+
+type (
+	// BatchWriter buffers the events persisted by the activities of the worker, and writes them to the sink in batches.
+	// An event is appended to the current segment of the spool before it is buffered, so that the events buffered when
+	// the worker crashes are written on its next start. Each flush starts a new segment, and the ids of the events of a
+	// segment written to the sink are appended to its ack file. A segment is removed once all its events are acked, so
+	// the spool is only ever appended to.
+	//
+	// An org failing to write is backed off, doubling the wait on every failure, and its events are moved to the dead
+	// letter file of the spool after WriterMaxAttempts failures, so that a batch the sink keeps rejecting is not retried
+	// forever.
+	BatchWriter struct {
+		mu          sync.Mutex // guards entries, segment, seq and outstanding.
+		flushing    sync.Mutex // serializes the flushes, and guards backoff.
+		entries     []*entry
+		segment     *os.File    // current segment of the spool.
+		seq         int         // sequence of the current segment.
+		outstanding map[int]int // events not yet acked, by segment.
+		backoff     map[string]*backoff
+
+		full chan struct{} // signals a full batch.
+		stop chan struct{}
+		done chan struct{}
+		once *sync.Once // guards stop.
+		lock *os.File   // holds the exclusive lock of the spool.
+	}
+
+	// entry is an event buffered by the writer, along with the segment of the spool holding it.
+	entry struct {
+		row      *Row
+		segment  int
+		attempts int
+	}
+
+	// backoff is the wait of an org before its events are written again.
+	backoff struct {
+		failures int
+		until    time.Time
+	}
+)
+
+const (
+	WriterMaxAttempts = 10              // WriterMaxAttempts is the number of failed writes before an event is dead lettered.
+	WriterMaxBackoff  = 5 * time.Minute // WriterMaxBackoff caps the wait of an org failing to write.
+)
+
+var (
+	ErrWriterStopped = errors.New("pulse: writer is not started")
+	ErrSpoolRequired = errors.New("pulse: the spool is required to run the workers, set PULSE__SPOOL")
+	ErrSpoolLocked   = errors.New("pulse: the spool is in use by another worker")
+
+	_w    *BatchWriter
+	wonce sync.Once
+)
+
+// Writer returns the batch writer of the worker.
+func Writer() *BatchWriter {
+	wonce.Do(func() {
+		_w = &BatchWriter{full: make(chan struct{}, 1)}
+	})
+
+	return _w
+}
+
+// write buffers the event. The event is durable once write returns.
+func (w *BatchWriter) write(r *Row) error {
+	line, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.segment == nil {
+		return ErrWriterStopped
+	}
+
+	if _, err := w.segment.Write(append(line, '\n')); err != nil {
+		return err
+	}
+
+	if err := w.segment.Sync(); err != nil {
+		return err
+	}
+
+	w.entries = append(w.entries, &entry{row: r, segment: w.seq})
+	w.outstanding[w.seq]++
+
+	if len(w.entries) >= Get().BatchSize {
+		select {
+		case w.full <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// Start locks the spool, recovers the events that were not acked by the previous run, opens a new segment, and starts
+// flushing. It fails fast when the spool is locked by another worker, since the workers would ack and remove the
+// segments of each other.
+func (w *BatchWriter) Start(ctx context.Context) error {
+	path := Get().Spool
+	if path == "" {
+		return ErrSpoolRequired
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+
+	lock, err := lock_spool(path)
+	if err != nil {
+		return err
+	}
+
+	entries, outstanding, last, err := recover_segments(path)
+	if err != nil {
+		_ = lock.Close()
+		return err
+	}
+
+	if len(entries) > 0 {
+		slog.Info("pulse: recovered events from the spool", "count", len(entries), "spool", path)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.entries = entries
+	w.outstanding = outstanding
+	w.backoff = make(map[string]*backoff)
+	w.seq = last
+	w.segment = nil
+
+	if err := w.rotate(); err != nil {
+		_ = lock.Close()
+		return err
+	}
+
+	w.lock = lock
+	w.stop = make(chan struct{})
+	w.done = make(chan struct{})
+	w.once = &sync.Once{}
+
+	go w.run(context.WithoutCancel(ctx))
+
+	return nil
+}
+
+// Stop stops buffering, and writes the events buffered so far, regardless of the backoff of their org. The events that
+// could not be written are left in the spool. Stopping again is a no-op.
+func (w *BatchWriter) Stop(ctx context.Context) error {
+	w.mu.Lock()
+	once := w.once
+	w.mu.Unlock()
+
+	if once == nil {
+		return nil
+	}
+
+	var err error
+
+	once.Do(func() {
+		close(w.stop)
+		<-w.done
+
+		err = w.flush(ctx, true)
+
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		if w.segment != nil {
+			err = errors.Join(err, w.segment.Close())
+			w.segment = nil
+		}
+
+		// closing the lock file releases the lock of the spool.
+		err = errors.Join(err, w.lock.Close())
+		w.lock = nil
+	})
+
+	return err
+}
+
+// Flush writes the buffered events to the sink, one batch per org, skipping the orgs backing off. The events of an org
+// failing to write are kept for the next flush, until they are dead lettered.
+func (w *BatchWriter) Flush(ctx context.Context) error {
+	return w.flush(ctx, false)
+}
+
+func (w *BatchWriter) flush(ctx context.Context, force bool) error {
+	w.flushing.Lock()
+	defer w.flushing.Unlock()
+
+	w.mu.Lock()
+	entries := slices.Clone(w.entries)
+	rotated := w.rotate()
+	w.mu.Unlock()
+
+	if len(entries) == 0 {
+		return rotated
+	}
+
+	orgs := make(map[string][]*entry)
+	for _, e := range entries {
+		orgs[e.row.Slug] = append(orgs[e.row.Slug], e)
+	}
+
+	now := time.Now()
+	acked := make([]*entry, 0, len(entries))
+	dead := make([]*entry, 0)
+	errs := []error{rotated}
+
+	for slug, batch := range orgs {
+		if b, ok := w.backoff[slug]; ok && !force && now.Before(b.until) {
+			continue
+		}
+
+		rows := make([]*Row, len(batch))
+		for idx, e := range batch {
+			rows[idx] = e.row
+		}
+
+		if err := GetSink().Write(ctx, slug, rows); err != nil {
+			wait := w.fail(slug, now)
+			slog.Warn("pulse: unable to write batch", "slug", slug, "count", len(batch), "retry_in", wait, "error", err.Error())
+
+			for _, e := range batch {
+				if e.attempts++; e.attempts >= WriterMaxAttempts {
+					dead = append(dead, e)
+				}
+			}
+
+			errs = append(errs, err)
+
+			continue
+		}
+
+		delete(w.backoff, slug)
+
+		acked = append(acked, batch...)
+	}
+
+	if len(dead) > 0 {
+		if err := dead_letter(Get().Spool, dead); err != nil {
+			errs = append(errs, err)
+		} else {
+			slog.Error("pulse: events dead lettered", "count", len(dead), "spool", Get().Spool)
+
+			acked = append(acked, dead...)
+		}
+	}
+
+	errs = append(errs, ack(Get().Spool, acked))
+	errs = append(errs, w.forget(acked)...)
+
+	return errors.Join(errs...)
+}
+
+// fail backs off the org, and returns the time it waits for. Must be called with flushing held.
+func (w *BatchWriter) fail(slug string, now time.Time) time.Duration {
+	b, ok := w.backoff[slug]
+	if !ok {
+		b = &backoff{}
+		w.backoff[slug] = b
+	}
+
+	b.failures++
+
+	wait := min(Get().FlushInterval<<min(b.failures-1, 16), WriterMaxBackoff)
+	b.until = now.Add(wait)
+
+	return wait
+}
+
+// forget drops the acked events from the buffer, and removes the segments without events left to ack. Must be called
+// with flushing held.
+func (w *BatchWriter) forget(acked []*entry) []error {
+	if len(acked) == 0 {
+		return nil
+	}
+
+	gone := make(map[*entry]bool, len(acked))
+	for _, e := range acked {
+		gone[e] = true
+	}
+
+	w.mu.Lock()
+
+	w.entries = slices.DeleteFunc(w.entries, func(e *entry) bool { return gone[e] })
+
+	finished := make([]int, 0)
+
+	for _, e := range acked {
+		w.outstanding[e.segment]--
+
+		if w.outstanding[e.segment] == 0 && e.segment != w.seq {
+			delete(w.outstanding, e.segment)
+			finished = append(finished, e.segment)
+		}
+	}
+
+	w.mu.Unlock()
+
+	errs := make([]error, 0)
+
+	for _, seq := range finished {
+		errs = append(errs, remove_segment(Get().Spool, seq))
+	}
+
+	return errs
+}
+
+// rotate starts a new segment, unless the current one is empty, so that the events buffered from now on are acked
+// apart from the ones being flushed. Must be called with mu held.
+func (w *BatchWriter) rotate() error {
+	if w.segment != nil && w.outstanding[w.seq] == 0 {
+		return nil
+	}
+
+	next := w.seq + 1
+
+	segment, err := os.OpenFile(segment_path(Get().Spool, next), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	if w.segment != nil {
+		_ = w.segment.Close()
+
+		if w.outstanding[w.seq] == 0 {
+			delete(w.outstanding, w.seq)
+		}
+	}
+
+	w.segment = segment
+	w.seq = next
+
+	return nil
+}
+
+// run flushes every interval, or as soon as a batch is full, until stopped.
+func (w *BatchWriter) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(Get().FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-ticker.C:
+		case <-w.full:
+		}
+
+		_ = w.Flush(ctx)
+	}
+}
+
+// lock_spool takes the exclusive lock of the spool, held as long as the returned file is open.
+func lock_spool(spool string) (*os.File, error) {
+	file, err := os.OpenFile(spool+".lock", os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		_ = file.Close()
+
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, ErrSpoolLocked
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// segment_path returns the path of the segment of the spool with the given sequence.
+func segment_path(spool string, seq int) string {
+	return fmt.Sprintf("%s-%06d.ndjson", spool, seq)
+}
+
+// ack_path returns the path of the ids acked of the segment of the spool with the given sequence.
+func ack_path(spool string, seq int) string {
+	return fmt.Sprintf("%s-%06d.ack", spool, seq)
+}
+
+// ack appends the ids of the events to the ack files of their segments.
+func ack(spool string, entries []*entry) error {
+	segments := make(map[int][]*entry)
+	for _, e := range entries {
+		segments[e.segment] = append(segments[e.segment], e)
+	}
+
+	var errs []error
+
+	for seq, acked := range segments {
+		lines := make([]byte, 0, len(acked)*37)
+		for _, e := range acked {
+			lines = append(append(lines, e.row.ID.String()...), '\n')
+		}
+
+		errs = append(errs, append_sync(ack_path(spool, seq), lines))
+	}
+
+	return errors.Join(errs...)
+}
+
+// dead_letter appends the events to the dead letter file of the spool, to be inspected and written by hand.
+func dead_letter(spool string, entries []*entry) error {
+	lines := make([]byte, 0)
+
+	for _, e := range entries {
+		line, err := json.Marshal(e.row)
+		if err != nil {
+			return err
+		}
+
+		lines = append(append(lines, line...), '\n')
+	}
+
+	return append_sync(spool+".dead", lines)
+}
+
+// append_sync appends the bytes to the file, and syncs it.
+func append_sync(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+
+	return errors.Join(err, file.Sync(), file.Close())
+}
+
+// remove_segment removes the segment and its ack file.
+func remove_segment(spool string, seq int) error {
+	err := os.Remove(segment_path(spool, seq))
+	if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+
+	ackerr := os.Remove(ack_path(spool, seq))
+	if errors.Is(ackerr, os.ErrNotExist) {
+		ackerr = nil
+	}
+
+	return errors.Join(err, ackerr)
+}
+
+// recover_segments reads the events of the segments of the spool that are not acked, along with the count by segment,
+// and the sequence of the last segment. The segments without events left are removed.
+func recover_segments(spool string) ([]*entry, map[int]int, int, error) {
+	entries := make([]*entry, 0)
+	outstanding := make(map[int]int)
+
+	paths, err := filepath.Glob(spool + "-*.ndjson")
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	seqs := make([]int, 0, len(paths))
+
+	for _, path := range paths {
+		seq, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path, spool+"-"), ".ndjson"))
+		if err != nil {
+			continue
+		}
+
+		seqs = append(seqs, seq)
+	}
+
+	slices.Sort(seqs)
+
+	last := 0
+	if len(seqs) > 0 {
+		last = seqs[len(seqs)-1]
+	}
+
+	for _, seq := range seqs {
+		rows, err := read_spool(segment_path(spool, seq))
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		acked, err := read_acks(ack_path(spool, seq))
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		for _, r := range rows {
+			if !acked[r.ID] {
+				entries = append(entries, &entry{row: r, segment: seq})
+				outstanding[seq]++
+			}
+		}
+
+		if outstanding[seq] == 0 {
+			if err := remove_segment(spool, seq); err != nil {
+				return nil, nil, 0, err
+			}
+		}
+	}
+
+	return entries, outstanding, last, nil
+}
+
+// read_acks reads the ids acked of a segment. A line cut short by a crash is skipped, its event is written again.
+func read_acks(path string) (map[uuid.UUID]bool, error) {
+	acked := make(map[uuid.UUID]bool)
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return acked, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if id, err := uuid.Parse(line); err == nil {
+			acked[id] = true
+		}
+	}
+
+	return acked, nil
+}
+
+// read_spool reads the events of a segment of the spool. A line cut short by a crash is skipped.
+func read_spool(path string) ([]*Row, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = file.Close() }()
+
+	rows := make([]*Row, 0)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for scanner.Scan() {
+		r := &Row{}
+		if err := json.Unmarshal(scanner.Bytes(), r); err != nil {
+			slog.Warn("pulse: skipping a corrupt event in the spool", "spool", path, "error", err.Error())
+			continue
+		}
+
+		rows = append(rows, r)
+	}
+
+	return rows, scanner.Err()
+}
+
+// to_row returns the row of the flat event of the org.
+func to_row[H events.Hook](slug string, hook int32, flat events.Flat[H]) *Row {
+	return &Row{
+		Slug:        slug,
+		Version:     flat.Version.String(),
+		ID:          flat.ID,
+		Parents:     flat.Parents,
+		Hook:        hook,
+		Scope:       string(flat.Scope),
+		Action:      flat.Action.String(),
+		Source:      flat.Source,
+		SubjectID:   flat.SubjectID,
+		SubjectName: flat.SubjectName,
+		UserID:      flat.UserID,
+		TeamID:      flat.TeamID,
+		OrgID:       flat.OrgID,
+		Timestamp:   flat.Timestamp,
+		Payload:     flat.Payload,
+	}
+}
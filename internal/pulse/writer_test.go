@@ -0,0 +1,74 @@
+package pulse_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.breu.io/quantm/internal/pulse"
+)
+
+func TestWriterRecoversUnackedEvents(t *testing.T) {
+	ctx := context.Background()
+	spool := pulse.Get().Spool
+	repo := uuid.New()
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(spool), 0o700))
+
+	acked := &pulse.Row{Slug: "spooled", ID: uuid.New(), SubjectID: repo, Scope: "push", Hook: 1001, Timestamp: at}
+	pending := &pulse.Row{Slug: "spooled", ID: uuid.New(), SubjectID: repo, Scope: "push", Hook: 1001, Timestamp: at.Add(time.Minute)}
+
+	lines := make([]byte, 0)
+
+	for _, r := range []*pulse.Row{acked, pending} {
+		line, err := json.Marshal(r)
+		require.NoError(t, err)
+
+		lines = append(append(lines, line...), '\n')
+	}
+
+	require.NoError(t, os.WriteFile(spool+"-000007.ndjson", lines, 0o600))
+	require.NoError(t, os.WriteFile(spool+"-000007.ack", []byte(acked.ID.String()+"\n"), 0o600))
+
+	require.NoError(t, pulse.GetSink().Provision(ctx, "spooled"))
+	require.NoError(t, pulse.Writer().Start(ctx))
+	require.NoError(t, pulse.Writer().Stop(ctx))
+
+	rows, err := pulse.GetSink().(pulse.EventSink).Events(ctx, "spooled", &pulse.EventsQuery{
+		RepoID: repo, From: at, To: at.Add(time.Hour), Scopes: []string{"push"}, Limit: 10,
+	})
+	require.NoError(t, err)
+
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, pending.ID, rows[0].ID)
+	}
+
+	assert.NoFileExists(t, spool+"-000007.ndjson")
+	assert.NoFileExists(t, spool+"-000007.ack")
+}
+
+func TestWriterStopTwice(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, pulse.Writer().Start(ctx))
+	require.NoError(t, pulse.Writer().Stop(ctx))
+
+	assert.NotPanics(t, func() { _ = pulse.Writer().Stop(ctx) })
+}
+
+func TestWriterLocksSpool(t *testing.T) {
+	ctx := context.Background()
+
+	require.NoError(t, pulse.Writer().Start(ctx))
+
+	assert.ErrorIs(t, pulse.Writer().Start(ctx), pulse.ErrSpoolLocked)
+	require.NoError(t, pulse.Writer().Stop(ctx))
+}